@@ -9,6 +9,7 @@ package v1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/google/gnostic/openapiv3"
+	_ "github.com/sober-studio/bubble-admin-go-kratos/api/bubble"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -34,7 +35,9 @@ type LoginByPasswordRequest struct {
 	// 图形验证码ID
 	CaptchaId string `protobuf:"bytes,3,opt,name=captcha_id,proto3" json:"captcha_id,omitempty"`
 	// 图形验证码
-	Captcha       string `protobuf:"bytes,4,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	Captcha string `protobuf:"bytes,4,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	// 租户编码（多租户模式下，未通过请求头或子域名指定租户时使用）
+	TenantCode    string `protobuf:"bytes,5,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,13 +100,22 @@ func (x *LoginByPasswordRequest) GetCaptcha() string {
 	return ""
 }
 
+func (x *LoginByPasswordRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
 // ========== 验证码登录 ==========
 type LoginByOtpRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 手机号，规则：11位数字
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 验证码
-	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 租户编码（多租户模式下，未通过请求头或子域名指定租户时使用）
+	TenantCode    string `protobuf:"bytes,4,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,6 +164,13 @@ func (x *LoginByOtpRequest) GetCode() string {
 	return ""
 }
 
+func (x *LoginByOtpRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
 // ========== 登录响应 ==========
 type LoginReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -691,8 +710,10 @@ type ResetPasswordRequest struct {
 	NewPassword string `protobuf:"bytes,3,opt,name=new_password,proto3" json:"new_password,omitempty"`
 	// 确认新密码，规则：6-20位字符
 	ConfirmPassword string `protobuf:"bytes,4,opt,name=confirm_password,proto3" json:"confirm_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 租户编码（多租户模式下，未通过请求头或子域名指定租户时使用）
+	TenantCode    string `protobuf:"bytes,5,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResetPasswordRequest) Reset() {
@@ -753,6 +774,13 @@ func (x *ResetPasswordRequest) GetConfirmPassword() string {
 	return ""
 }
 
+func (x *ResetPasswordRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
 type ResetPasswordReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -793,17 +821,19 @@ var File_api_passport_v1_passport_proto protoreflect.FileDescriptor
 
 const file_api_passport_v1_passport_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/passport/v1/passport.proto\x12\x0fapi.passport.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\xa1\x02\n" +
+	"\x1eapi/passport/v1/passport.proto\x12\x0fapi.passport.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x11bubble/auth.proto\"\xf2\x02\n" +
 	"\x16LoginByPasswordRequest\x12H\n" +
 	"\busername\x18\x01 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x03\x18\x14\xbaG\x1c\x92\x02\x19用户名，3-20位字符R\busername\x12E\n" +
 	"\bpassword\x18\x02 \x01(\tB)\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x19\x92\x02\x16密码，6-20位字符R\bpassword\x12;\n" +
 	"\n" +
 	"captcha_id\x18\x03 \x01(\tB\x1b\xe2A\x01\x02\xbaG\x14\x92\x02\x11图形验证码IDR\n" +
 	"captcha_id\x129\n" +
-	"\acaptcha\x18\x04 \x01(\tB\x1f\xe2A\x01\x02\xbaG\x18\x92\x02\x15图形验证码内容R\acaptcha\"\x9f\x01\n" +
+	"\acaptcha\x18\x04 \x01(\tB\x1f\xe2A\x01\x02\xbaG\x18\x92\x02\x15图形验证码内容R\acaptcha\x12O\n" +
+	"\vtenant_code\x18\x05 \x01(\tB-\xbaG*\x92\x02'租户编码，多租户模式下可选R\vtenant_code\"\xf0\x01\n" +
 	"\x11LoginByOtpRequest\x12I\n" +
 	"\x06mobile\x18\x01 \x01(\tB1\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12?\n" +
-	"\x04code\x18\x03 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\"6\n" +
+	"\x04code\x18\x03 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\x12O\n" +
+	"\vtenant_code\x18\x04 \x01(\tB-\xbaG*\x92\x02'租户编码，多租户模式下可选R\vtenant_code\"6\n" +
 	"\n" +
 	"LoginReply\x12(\n" +
 	"\x05token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f登录凭证R\x05token\"\x0f\n" +
@@ -829,24 +859,25 @@ const file_api_passport_v1_passport_proto_rawDesc = "" +
 	"\x13UpdateMobileRequest\x12P\n" +
 	"\x06mobile\x18\x01 \x01(\tB8\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1d\x92\x02\x1a新手机号，11位数字R\x06mobile\x12?\n" +
 	"\x04code\x18\x02 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\"\x13\n" +
-	"\x11UpdateMobileReply\"\xe6\x02\n" +
+	"\x11UpdateMobileReply\"\xb7\x03\n" +
 	"\x14ResetPasswordRequest\x12M\n" +
 	"\x06mobile\x18\x01 \x01(\tB5\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12M\n" +
 	"\bsms_code\x18\x02 \x01(\tB1\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG!\x92\x02\x1e短信验证码，4-6位字符R\bsms_code\x12P\n" +
 	"\fnew_password\x18\x03 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1c\x92\x02\x19新密码，6-20位字符R\fnew_password\x12^\n" +
-	"\x10confirm_password\x18\x04 \x01(\tB2\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\"\x92\x02\x1f确认新密码，6-20位字符R\x10confirm_password\"\x14\n" +
-	"\x12ResetPasswordReply2\xe7\b\n" +
-	"\bPassport\x12\x8d\x01\n" +
-	"\x0fLoginByPassword\x12'.api.passport.v1.LoginByPasswordRequest\x1a\x1b.api.passport.v1.LoginReply\"4\xbaG\x0e\x12\f密码登录\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/login/password\x12\x81\x01\n" +
+	"\x10confirm_password\x18\x04 \x01(\tB2\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\"\x92\x02\x1f确认新密码，6-20位字符R\x10confirm_password\x12O\n" +
+	"\vtenant_code\x18\x05 \x01(\tB-\xbaG*\x92\x02'租户编码，多租户模式下可选R\vtenant_code\"\x14\n" +
+	"\x12ResetPasswordReply2\x97\t\n" +
+	"\bPassport\x12\x93\x01\n" +
+	"\x0fLoginByPassword\x12'.api.passport.v1.LoginByPasswordRequest\x1a\x1b.api.passport.v1.LoginReply\":\xbaG\x0e\x12\f密码登录\xca\xf3\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/login/password\x12\x87\x01\n" +
 	"\n" +
-	"LoginByOtp\x12\".api.passport.v1.LoginByOtpRequest\x1a\x1b.api.passport.v1.LoginReply\"2\xbaG\x11\x12\x0f验证码登录\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/passport/login/otp\x12t\n" +
-	"\x06Logout\x12\x1e.api.passport.v1.LogoutRequest\x1a\x1c.api.passport.v1.LogoutReply\",\xbaG\x0e\x12\f用户退出\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/passport/logout\x12\x80\x01\n" +
-	"\bUserInfo\x12 .api.passport.v1.UserInfoRequest\x1a\x1e.api.passport.v1.UserInfoReply\"2\xbaG\x14\x12\x12获取用户信息\x82\xd3\xe4\x93\x02\x15\x12\x13/passport/user-info\x12\x95\x01\n" +
-	"\x0eUpdatePassword\x12&.api.passport.v1.UpdatePasswordRequest\x1a$.api.passport.v1.UpdatePasswordReply\"5\xbaG\x0e\x12\f修改密码\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/passport/update-password\x12\x88\x01\n" +
+	"LoginByOtp\x12\".api.passport.v1.LoginByOtpRequest\x1a\x1b.api.passport.v1.LoginReply\"8\xbaG\x11\x12\x0f验证码登录\xca\xf3\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/passport/login/otp\x12z\n" +
+	"\x06Logout\x12\x1e.api.passport.v1.LogoutRequest\x1a\x1c.api.passport.v1.LogoutReply\"2\xbaG\x0e\x12\f用户退出\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/passport/logout\x12\x86\x01\n" +
+	"\bUserInfo\x12 .api.passport.v1.UserInfoRequest\x1a\x1e.api.passport.v1.UserInfoReply\"8\xbaG\x14\x12\x12获取用户信息\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\x15\x12\x13/passport/user-info\x12\x9b\x01\n" +
+	"\x0eUpdatePassword\x12&.api.passport.v1.UpdatePasswordRequest\x1a$.api.passport.v1.UpdatePasswordReply\";\xbaG\x0e\x12\f修改密码\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/passport/update-password\x12\x8e\x01\n" +
 	"\n" +
-	"BindMobile\x12\".api.passport.v1.BindMobileRequest\x1a .api.passport.v1.BindMobileReply\"4\xbaG\x11\x12\x0f绑定手机号\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/passport/bind-mobile\x12\x96\x01\n" +
-	"\fUpdateMobile\x12$.api.passport.v1.UpdateMobileRequest\x1a\".api.passport.v1.UpdateMobileReply\"<\xbaG\x17\x12\x15修改绑定手机号\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/passport/update-mobile\x12\x91\x01\n" +
-	"\rResetPassword\x12%.api.passport.v1.ResetPasswordRequest\x1a#.api.passport.v1.ResetPasswordReply\"4\xbaG\x0e\x12\f找回密码\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/reset-passwordBV\n" +
+	"BindMobile\x12\".api.passport.v1.BindMobileRequest\x1a .api.passport.v1.BindMobileReply\":\xbaG\x11\x12\x0f绑定手机号\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/passport/bind-mobile\x12\x9c\x01\n" +
+	"\fUpdateMobile\x12$.api.passport.v1.UpdateMobileRequest\x1a\".api.passport.v1.UpdateMobileReply\"B\xbaG\x17\x12\x15修改绑定手机号\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/passport/update-mobile\x12\x97\x01\n" +
+	"\rResetPassword\x12%.api.passport.v1.ResetPasswordRequest\x1a#.api.passport.v1.ResetPasswordReply\":\xbaG\x0e\x12\f找回密码\xca\xf3\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/reset-passwordBV\n" +
 	"\x0fapi.passport.v1P\x01ZAgithub.com/sober-studio/bubble-admin-go-kratos/api/passport/v1;v1b\x06proto3"
 
 var (
@@ -83,6 +83,8 @@ func (m *LoginByPasswordRequest) validate(all bool) error {
 
 	// no validation rules for Captcha
 
+	// no validation rules for TenantCode
+
 	if len(errors) > 0 {
 		return LoginByPasswordRequestMultiError(errors)
 	}
@@ -207,6 +209,8 @@ func (m *LoginByOtpRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TenantCode
+
 	if len(errors) > 0 {
 		return LoginByOtpRequestMultiError(errors)
 	}
@@ -1558,6 +1562,8 @@ func (m *ResetPasswordRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TenantCode
+
 	if len(errors) > 0 {
 		return ResetPasswordRequestMultiError(errors)
 	}
@@ -9,6 +9,7 @@ package v1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/google/gnostic/openapiv3"
+	_ "github.com/sober-studio/bubble-admin-go-kratos/api/bubble"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -88,7 +89,9 @@ func (SmsOtpScene) EnumDescriptor() ([]byte, []int) {
 
 // ========== 获取图形验证码 ==========
 type GetCaptchaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户编码（多租户模式下，未通过请求头或子域名指定租户时使用）
+	TenantCode    string `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,6 +126,13 @@ func (*GetCaptchaRequest) Descriptor() ([]byte, []int) {
 	return file_api_public_v1_public_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetCaptchaRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
 type GetCaptchaReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 验证码ID
@@ -186,7 +196,9 @@ type SendSmsOtpRequest struct {
 	// 图形验证码
 	Captcha string `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha,omitempty"`
 	// 验证码场景
-	Scene         SmsOtpScene `protobuf:"varint,4,opt,name=scene,proto3,enum=api.public.v1.SmsOtpScene" json:"scene,omitempty"`
+	Scene SmsOtpScene `protobuf:"varint,4,opt,name=scene,proto3,enum=api.public.v1.SmsOtpScene" json:"scene,omitempty"`
+	// 租户编码（多租户模式下，未通过请求头或子域名指定租户时使用）
+	TenantCode    string `protobuf:"bytes,5,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,6 +261,13 @@ func (x *SendSmsOtpRequest) GetScene() SmsOtpScene {
 	return SmsOtpScene_UNSPECIFIED
 }
 
+func (x *SendSmsOtpRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
 type SendSmsOtpReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 验证码过期时间戳（秒）
@@ -298,20 +317,22 @@ var File_api_public_v1_public_proto protoreflect.FileDescriptor
 
 const file_api_public_v1_public_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/public/v1/public.proto\x12\rapi.public.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\x13\n" +
-	"\x11GetCaptchaRequest\"y\n" +
+	"\x1aapi/public/v1/public.proto\x12\rapi.public.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x11bubble/auth.proto\"d\n" +
+	"\x11GetCaptchaRequest\x12O\n" +
+	"\vtenant_code\x18\x01 \x01(\tB-\xbaG*\x92\x02'租户编码，多租户模式下可选R\vtenant_code\"y\n" +
 	"\x0fGetCaptchaReply\x121\n" +
 	"\n" +
 	"captcha_id\x18\x01 \x01(\tB\x11\xbaG\x0e\x92\x02\v验证码idR\n" +
 	"captcha_id\x123\n" +
-	"\timage_b64\x18\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f验证码内容R\timage_b64\"\xd9\x02\n" +
+	"\timage_b64\x18\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f验证码内容R\timage_b64\"\xaa\x03\n" +
 	"\x11SendSmsOtpRequest\x12M\n" +
 	"\x06mobile\x18\x01 \x01(\tB5\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12;\n" +
 	"\n" +
 	"captcha_id\x18\x02 \x01(\tB\x1b\xe2A\x01\x02\xbaG\x14\x92\x02\x11图形验证码IDR\n" +
 	"captcha_id\x129\n" +
 	"\acaptcha\x18\x03 \x01(\tB\x1f\xe2A\x01\x02\xbaG\x18\x92\x02\x15图形验证码内容R\acaptcha\x12}\n" +
-	"\x05scene\x18\x04 \x01(\x0e2\x1a.api.public.v1.SmsOtpSceneBK\xe2A\x01\x02\xfaB\a\x82\x01\x04\x10\x01 \x00\xbaG:\x92\x027短信验证码业务场景：REGISTER/LOGIN/BIND/RESETR\x05scene\"[\n" +
+	"\x05scene\x18\x04 \x01(\x0e2\x1a.api.public.v1.SmsOtpSceneBK\xe2A\x01\x02\xfaB\a\x82\x01\x04\x10\x01 \x00\xbaG:\x92\x027短信验证码业务场景：REGISTER/LOGIN/BIND/RESETR\x05scene\x12O\n" +
+	"\vtenant_code\x18\x05 \x01(\tB-\xbaG*\x92\x02'租户编码，多租户模式下可选R\vtenant_code\"[\n" +
 	"\x0fSendSmsOtpReply\x12H\n" +
 	"\texpire_at\x18\x01 \x01(\x03B*\xbaG'\x92\x02$验证码过期时间戳，单位秒R\texpire_at*L\n" +
 	"\vSmsOtpScene\x12\x0f\n" +
@@ -319,12 +340,12 @@ const file_api_public_v1_public_proto_rawDesc = "" +
 	"\bREGISTER\x10\x01\x12\t\n" +
 	"\x05LOGIN\x10\x02\x12\b\n" +
 	"\x04BIND\x10\x03\x12\t\n" +
-	"\x05RESET\x10\x042\x93\x02\n" +
-	"\x06Public\x12\x81\x01\n" +
+	"\x05RESET\x10\x042\x9f\x02\n" +
+	"\x06Public\x12\x87\x01\n" +
 	"\n" +
-	"GetCaptcha\x12 .api.public.v1.GetCaptchaRequest\x1a\x1e.api.public.v1.GetCaptchaReply\"1\xbaG\x17\x12\x15获取图形验证码\x82\xd3\xe4\x93\x02\x11\x12\x0f/public/captcha\x12\x84\x01\n" +
+	"GetCaptcha\x12 .api.public.v1.GetCaptchaRequest\x1a\x1e.api.public.v1.GetCaptchaReply\"7\xbaG\x17\x12\x15获取图形验证码\xca\xf3\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x11\x12\x0f/public/captcha\x12\x8a\x01\n" +
 	"\n" +
-	"SendSmsOtp\x12 .api.public.v1.SendSmsOtpRequest\x1a\x1e.api.public.v1.SendSmsOtpReply\"4\xbaG\x17\x12\x15获取短信验证码\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/public/otp/smsBR\n" +
+	"SendSmsOtp\x12 .api.public.v1.SendSmsOtpRequest\x1a\x1e.api.public.v1.SendSmsOtpReply\":\xbaG\x17\x12\x15获取短信验证码\xca\xf3\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/public/otp/smsBR\n" +
 	"\rapi.public.v1P\x01Z?github.com/sober-studio/bubble-admin-go-kratos/api/public/v1;v1b\x06proto3"
 
 var (
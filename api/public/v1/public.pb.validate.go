@@ -57,6 +57,8 @@ func (m *GetCaptchaRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for TenantCode
+
 	if len(errors) > 0 {
 		return GetCaptchaRequestMultiError(errors)
 	}
@@ -300,6 +302,8 @@ func (m *SendSmsOtpRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TenantCode
+
 	if len(errors) > 0 {
 		return SendSmsOtpRequestMultiError(errors)
 	}
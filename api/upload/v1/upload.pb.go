@@ -9,6 +9,7 @@ package v1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/google/gnostic/openapiv3"
+	_ "github.com/sober-studio/bubble-admin-go-kratos/api/bubble"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	_ "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -223,7 +224,7 @@ var File_api_upload_v1_upload_proto protoreflect.FileDescriptor
 
 const file_api_upload_v1_upload_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/upload/v1/upload.proto\x12\rapi.upload.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1copenapi/v3/annotations.proto\"\xf9\x02\n" +
+	"\x1aapi/upload/v1/upload.proto\x12\rapi.upload.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x11bubble/auth.proto\"\xf9\x02\n" +
 	"\x11UploadFileRequest\x12\x95\x01\n" +
 	"\x05scene\x18\x01 \x01(\x0e2\x1a.api.upload.v1.UploadSceneBc\xe2A\x01\x02\xfaB\x05\x82\x01\x02\x10\x01\xbaGT\x92\x02Q上传场景类型，必须为已定义的枚举值：UPLOAD_COMMON/UPLOAD_AVATARR\x05scene\x12f\n" +
 	"\x04file\x18\x02 \x01(\fBR\xe2A\x01\x02\xbaGK\x92\x02?文件二进制数据，使用 multipart/form-data 格式上传\x9a\x02\x06binaryR\x04file\x12d\n" +
@@ -238,10 +239,10 @@ const file_api_upload_v1_upload_proto_rawDesc = "" +
 	"\vuploaded_at\x18\x05 \x01(\x03B0\xbaG-\x92\x02*文件上传完成的时间戳，单位秒R\vuploaded_at*3\n" +
 	"\vUploadScene\x12\x11\n" +
 	"\rUPLOAD_COMMON\x10\x00\x12\x11\n" +
-	"\rUPLOAD_AVATAR\x10\x012\xde\x01\n" +
-	"\x06Upload\x12\xd3\x01\n" +
+	"\rUPLOAD_AVATAR\x10\x012\xe4\x01\n" +
+	"\x06Upload\x12\xd9\x01\n" +
 	"\n" +
-	"UploadFile\x12 .api.upload.v1.UploadFileRequest\x1a\x1e.api.upload.v1.UploadFileReply\"\x82\x01\xbaGm\x12\f上传文件\x1a]支持多种场景的文件上传，根据场景类型自动配置存储路径和访问权限\x82\xd3\xe4\x93\x02\f:\x01*\"\a/uploadBR\n" +
+	"UploadFile\x12 .api.upload.v1.UploadFileRequest\x1a\x1e.api.upload.v1.UploadFileReply\"\x88\x01\xbaGm\x12\f上传文件\x1a]支持多种场景的文件上传，根据场景类型自动配置存储路径和访问权限\xca\xf3\x18\x02\x10\x01\x82\xd3\xe4\x93\x02\f:\x01*\"\a/uploadBR\n" +
 	"\rapi.upload.v1P\x01Z?github.com/sober-studio/bubble-admin-go-kratos/api/upload/v1;v1b\x06proto3"
 
 var (
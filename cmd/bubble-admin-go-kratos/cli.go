@@ -0,0 +1,283 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/config"
+	kenv "github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/env"
+	"google.golang.org/protobuf/proto"
+)
+
+// 退出码
+const (
+	exitOK       = 0
+	exitError    = 1 // 执行失败
+	exitUsage    = 2 // 命令或参数错误
+	exitNotFound = 3 // 租户、用户等不存在
+	exitConflict = 4 // 数据已存在
+)
+
+// command 子命令，除 serve 外均向标准输出写入一行 JSON 结果，日志写入标准错误
+type command struct {
+	name  string // 如 "user create"
+	args  string // 参数说明
+	short string
+	run   func(c *cli, args []string) (any, error)
+}
+
+var commands = []*command{
+	{name: "serve", short: "启动服务（默认）", run: runServe},
+	{name: "migrate", args: "up [version] | down [steps] | status | baseline <version>", short: "执行、回滚或查看版本化迁移", run: runMigrate},
+	{name: "seed", args: "[-file seed.yaml]", short: "初始化系统租户、超级管理员及权限，可重复执行", run: runSeed},
+	{name: "user create", args: "-user <username> [-tenant code] [-password p] [-name n] [-mobile m] [-email e] [-dept name] [-roles a,b]", short: "创建用户，未指定密码时生成随机密码", run: runUserCreate},
+	{name: "user reset-password", args: "-user <username> [-tenant code] [-password p]", short: "重置密码并吊销全部 Token", run: runUserResetPassword},
+	{name: "user unlock", args: "-user <username> [-tenant code]", short: "清除登录失败次数并启用用户", run: runUserUnlock},
+	{name: "user block", args: "-user <username> [-tenant code]", short: "禁用用户并吊销全部 Token", run: runUserBlock},
+	{name: "token revoke", args: "-user <username> [-tenant code]", short: "吊销用户的全部 Token", run: runTokenRevoke},
+	{name: "perm sync", short: "按 proto 注解同步接口权限", run: runPermSync},
+	{name: "casbin reload", short: "通知全部节点重新加载 Casbin 策略及权限缓存", run: runCasbinReload},
+	{name: "config validate", short: "校验配置文件", run: runConfigValidate},
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: %s [-conf path] <command> [args]\n\nCommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(out, "  %-20s %s\n", cmd.name, cmd.short)
+		if cmd.args != "" {
+			fmt.Fprintf(out, "  %-20s   %s\n", "", cmd.args)
+		}
+	}
+	fmt.Fprintf(out, "\nExit codes: 0 成功, 1 执行失败, 2 命令或参数错误, 3 数据不存在, 4 数据已存在\n\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// lookup 按最长匹配查找子命令，未指定时为 serve
+func lookup(args []string) (*command, []string) {
+	if len(args) == 0 {
+		return commands[0], nil
+	}
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == cmd.name {
+			return cmd, args[len(words):]
+		}
+	}
+	return nil, args
+}
+
+// cli 子命令的执行环境
+type cli struct {
+	cmd    *command
+	bc     *conf.Bootstrap
+	logger log.Logger
+}
+
+// envSource 将全部环境变量合并为一个 JSON 文档加载，避免逐个变量输出加载日志
+type envSource struct {
+	config.Source
+}
+
+func (s envSource) Load() ([]*config.KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		vars[kv.Key] = string(kv.Value)
+	}
+	b, err := json.Marshal(vars)
+	if err != nil {
+		return nil, err
+	}
+	return []*config.KeyValue{{Key: "environment", Value: b, Format: "json"}}, nil
+}
+
+// config 加载配置，按配置中的环境初始化
+func (c *cli) config() (*conf.Bootstrap, error) {
+	if c.bc != nil {
+		return c.bc, nil
+	}
+	cfg := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+			// 环境变量仅用于解析配置中的 ${NAME:default} 占位符
+			envSource{kenv.NewSource()},
+		),
+	)
+	defer cfg.Close()
+
+	if err := cfg.Load(); err != nil {
+		return nil, err
+	}
+	var bc conf.Bootstrap
+	if err := cfg.Scan(&bc); err != nil {
+		return nil, err
+	}
+	if err := resolveConfigPaths(&bc, flagconf); err != nil {
+		return nil, err
+	}
+	env.Init(bc.App.GetEnv())
+	c.bc = &bc
+	return c.bc, nil
+}
+
+// resolveConfigPaths 配置中的相对文件路径以配置文件所在目录为准，与启动时的工作目录无关
+func resolveConfigPaths(bc *conf.Bootstrap, source string) error {
+	dir := source
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		dir = filepath.Dir(source)
+	}
+	if c := bc.GetData().GetCasbin(); c != nil && c.ModelPath != "" && !filepath.IsAbs(c.ModelPath) {
+		c.ModelPath = filepath.Join(dir, c.ModelPath)
+	}
+	return nil
+}
+
+// adminData 运维命令使用的数据配置，不在连接数据库时维护表结构
+func (c *cli) adminData(bc *conf.Bootstrap) *conf.Data {
+	d := proto.Clone(bc.Data).(*conf.Data)
+	d.Database.Migration = data.MigrationNone
+	// 运维命令多为先查询后写入，不使用存在复制延迟的副本
+	d.Database.Replicas = nil
+	return d
+}
+
+// run 执行子命令并返回退出码
+func run(args []string) int {
+	cmd, rest := lookup(args)
+	if cmd == nil {
+		usage()
+		return report(strings.Join(args, " "), nil, usageErrorf("unknown command: %s", strings.Join(args, " ")))
+	}
+	c := &cli{
+		cmd: cmd,
+		// 标准输出只用于 JSON 结果，日志仅输出警告及以上级别到标准错误
+		logger: &fatalLogger{
+			Logger: log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelWarn)),
+			cmd:    cmd.name,
+		},
+	}
+	result, err := cmd.run(c, rest)
+	if cmd.name == "serve" && err == nil {
+		return exitOK
+	}
+	return report(cmd.name, result, err)
+}
+
+// fatalLogger 数据层初始化失败时以 Fatal 级别记录日志并退出进程，退出前输出 JSON 错误
+type fatalLogger struct {
+	log.Logger
+	cmd string
+}
+
+func (l *fatalLogger) Log(level log.Level, keyvals ...any) error {
+	err := l.Logger.Log(level, keyvals...)
+	if level == log.LevelFatal {
+		msg := fmt.Sprint(keyvals...)
+		for i := 0; i+1 < len(keyvals); i += 2 {
+			if keyvals[i] == log.DefaultMessageKey {
+				msg = fmt.Sprint(keyvals[i+1])
+			}
+		}
+		report(l.cmd, nil, errors.New(msg))
+	}
+	return err
+}
+
+// output 子命令的 JSON 输出
+type output struct {
+	Command string       `json:"command"`
+	OK      bool         `json:"ok"`
+	Result  any          `json:"result,omitempty"`
+	Error   *outputError `json:"error,omitempty"`
+}
+
+type outputError struct {
+	Reason   string            `json:"reason,omitempty"`
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// report 输出结果并返回退出码，执行失败时 result 可携带失败详情
+func report(name string, result any, err error) int {
+	out := &output{Command: name, OK: err == nil, Result: result}
+	code := exitOK
+	if err != nil {
+		code = exitCode(err)
+		out.Error = &outputError{Message: err.Error()}
+		var ue *usageError
+		if !errors.As(err, &ue) {
+			if se := new(kerrors.Error); errors.As(err, &se) {
+				out.Error = &outputError{Reason: se.Reason, Message: se.Message, Metadata: se.Metadata}
+			}
+		}
+	}
+	b, _ := json.Marshal(out)
+	fmt.Println(string(b))
+	return code
+}
+
+func exitCode(err error) int {
+	var ue *usageError
+	if errors.As(err, &ue) {
+		return exitUsage
+	}
+	se := new(kerrors.Error)
+	if !errors.As(err, &se) {
+		return exitError
+	}
+	switch se.Code {
+	case http.StatusBadRequest:
+		return exitUsage
+	case http.StatusNotFound:
+		return exitNotFound
+	case http.StatusConflict:
+		return exitConflict
+	default:
+		return exitError
+	}
+}
+
+// usageError 命令或参数错误
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// parseFlags 解析子命令参数，参数错误不退出进程，由调用方输出 JSON 错误
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(os.Stderr)
+	if err := fs.Parse(args); err != nil {
+		return usageErrorf("%s", err)
+	}
+	if fs.NArg() > 0 {
+		return usageErrorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	return nil
+}
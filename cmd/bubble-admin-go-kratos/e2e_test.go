@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testConf 测试使用的配置：SQLite + 进程内 Redis，无需外部服务
+const testConf = "../../configs/test.yaml"
+
+// newTestCli 使用 configs/test.yaml 及指定的 SQLite 文件，seed 与 serve 共享同一数据库
+func newTestCli(t *testing.T, source string) *cli {
+	t.Helper()
+	old := flagconf
+	flagconf = testConf
+	t.Cleanup(func() { flagconf = old })
+	t.Setenv("SQLITE_SOURCE", source)
+	return &cli{logger: log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelWarn))}
+}
+
+func TestConfigEnv(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "bubble.db")
+	c := newTestCli(t, source)
+	bc, err := c.config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bc.Data.Database.GetSource(); got != source {
+		t.Fatalf("source = %q, want %q from SQLITE_SOURCE", got, source)
+	}
+	if got := bc.Data.Database.GetDriver(); got != "sqlite" {
+		t.Fatalf("driver = %q, want sqlite", got)
+	}
+	if !bc.Data.Redis.GetEmbedded() {
+		t.Fatal("redis should be embedded")
+	}
+	// 模型文件相对于配置文件所在目录
+	if got, want := bc.Data.Casbin.GetModelPath(), filepath.Join(filepath.Dir(testConf), "casbin_model.conf"); got != want {
+		t.Fatalf("model path = %q, want %q", got, want)
+	}
+}
+
+// freeAddr 返回一个空闲的本地地址
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// apiClient 按统一响应格式调用 HTTP 接口
+type apiClient struct {
+	t     *testing.T
+	base  string
+	token string
+}
+
+type apiReply struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Debug   map[string]any  `json:"debug"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (c *apiClient) do(method, path string, body any, data any) *apiReply {
+	c.t.Helper()
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.base+path, r)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var reply apiReply
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		c.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	if reply.Code == 0 && data != nil {
+		if err := json.Unmarshal(reply.Data, data); err != nil {
+			c.t.Fatalf("%s %s: %v", method, path, err)
+		}
+	}
+	return &reply
+}
+
+// ok 调用接口并要求成功
+func (c *apiClient) ok(method, path string, body any, data any) *apiReply {
+	c.t.Helper()
+	reply := c.do(method, path, body, data)
+	if reply.Code != 0 {
+		c.t.Fatalf("%s %s: code %d, %s", method, path, reply.Code, reply.Message)
+	}
+	return reply
+}
+
+// login 获取图形验证码（调试模式下答案随响应返回）后使用密码登录
+func (c *apiClient) login(username, password string) *apiReply {
+	c.t.Helper()
+	var captcha struct {
+		CaptchaID string `json:"captcha_id"`
+	}
+	reply := c.ok(http.MethodGet, "/public/captcha", nil, &captcha)
+	var login struct {
+		Token string `json:"token"`
+	}
+	reply = c.do(http.MethodPost, "/passport/login/password", map[string]any{
+		"username":   username,
+		"password":   password,
+		"captcha_id": captcha.CaptchaID,
+		"captcha":    fmt.Sprint(reply.Debug["captcha_answer"]),
+	}, &login)
+	if reply.Code == 0 {
+		c.token = login.Token
+	}
+	return reply
+}
+
+// TestPassportE2E 按 configs/test.yaml 执行 seed 并启动服务，经 HTTP 走通密码登录、修改密码及登出
+func TestPassportE2E(t *testing.T) {
+	c := newTestCli(t, filepath.Join(t.TempDir(), "bubble.db"))
+	t.Setenv("BUBBLE_ADMIN_USERNAME", "root")
+	t.Setenv("BUBBLE_ADMIN_PASSWORD", "Secret123")
+	if _, err := runSeed(c, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	bc, err := c.config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := freeAddr(t)
+	bc.Server.Http.Addr = addr
+	bc.Server.Grpc.Addr = freeAddr(t)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.App, c.logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	done := make(chan error, 1)
+	go func() { done <- app.Run() }()
+	defer func() {
+		if err := app.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	api := &apiClient{t: t, base: "http://" + addr}
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(50 * time.Millisecond) {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server not started: %v", err)
+		}
+	}
+
+	if reply := api.login("root", "wrong-password"); reply.Code == 0 {
+		t.Fatal("login with wrong password should fail")
+	}
+	api.token = ""
+	api.login("root", "Secret123")
+	if api.token == "" {
+		t.Fatal("login should return a token")
+	}
+
+	var info struct {
+		Username string `json:"username"`
+	}
+	api.ok(http.MethodGet, "/passport/user-info", nil, &info)
+	if info.Username != "root" {
+		t.Fatalf("username = %q, want root", info.Username)
+	}
+
+	api.ok(http.MethodPost, "/passport/update-password", map[string]any{
+		"old_password":     "Secret123",
+		"new_password":     "Secret456",
+		"confirm_password": "Secret456",
+	}, nil)
+	// 修改密码后吊销全部 Token
+	if reply := api.do(http.MethodGet, "/passport/user-info", nil, nil); reply.Code != http.StatusUnauthorized {
+		t.Fatalf("user-info after password change: code %d, want 401", reply.Code)
+	}
+
+	api.token = ""
+	if reply := api.login("root", "Secret123"); reply.Code == 0 {
+		t.Fatal("login with old password should fail")
+	}
+	api.login("root", "Secret456")
+	if api.token == "" {
+		t.Fatal("login with new password should return a token")
+	}
+	api.ok(http.MethodPost, "/passport/logout", map[string]any{}, nil)
+	if reply := api.do(http.MethodGet, "/passport/user-info", nil, nil); reply.Code != http.StatusUnauthorized {
+		t.Fatalf("user-info after logout: code %d, want 401", reply.Code)
+	}
+}
@@ -5,15 +5,11 @@ import (
 	"os"
 
 	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
-	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
-	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/env"
 
 	_ "go.uber.org/automaxprocs"
 )
@@ -50,7 +46,20 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, cron *cron.Serv
 }
 
 func main() {
+	flag.Usage = usage
 	flag.Parse()
+	os.Exit(run(flag.Args()))
+}
+
+// runServe 启动服务并等待退出信号
+func runServe(c *cli, args []string) (any, error) {
+	if err := parseFlags(flag.NewFlagSet("serve", flag.ContinueOnError), args); err != nil {
+		return nil, err
+	}
+	bc, err := c.config()
+	if err != nil {
+		return nil, err
+	}
 	logger := log.With(log.NewStdLogger(os.Stdout),
 		"ts", log.DefaultTimestamp,
 		"caller", log.DefaultCaller,
@@ -60,32 +69,13 @@ func main() {
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
 	)
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagconf),
-		),
-	)
-	defer c.Close()
-
-	if err := c.Load(); err != nil {
-		panic(err)
-	}
-
-	var bc conf.Bootstrap
-	if err := c.Scan(&bc); err != nil {
-		panic(err)
-	}
-
-	env.Init(bc.App.Env)
 
 	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.App, logger)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	defer cleanup()
 
 	// start and wait for a stop signal
-	if err := app.Run(); err != nil {
-		panic(err)
-	}
+	return nil, app.Run()
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/migrate"
+)
+
+type migrationInfo struct {
+	Version int64  `json:"version"`
+	Name    string `json:"name"`
+}
+
+type migrationStatus struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	Modified  bool       `json:"modified,omitempty"`
+	Missing   bool       `json:"missing,omitempty"`
+}
+
+// runMigrate 版本化迁移：up [version] | down [steps] | status | baseline <version>
+func runMigrate(c *cli, args []string) (any, error) {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return nil, usageErrorf("%s", err)
+	}
+	if fs.NArg() == 0 || fs.NArg() > 2 {
+		return nil, usageErrorf("usage: migrate up [version] | down [steps] | status | baseline <version>")
+	}
+	action, arg := fs.Arg(0), fs.Arg(1)
+
+	var n int64
+	switch action {
+	case "up", "down", "baseline":
+		if arg == "" && action == "baseline" {
+			return nil, usageErrorf("baseline requires a version")
+		}
+		if arg != "" {
+			v, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil || v <= 0 {
+				return nil, usageErrorf("invalid argument: %s", arg)
+			}
+			n = v
+		}
+	case "status":
+		if arg != "" {
+			return nil, usageErrorf("unexpected argument: %s", arg)
+		}
+	default:
+		return nil, usageErrorf("unknown migrate command: %s", action)
+	}
+
+	bc, err := c.config()
+	if err != nil {
+		return nil, err
+	}
+	db := data.OpenDB(c.adminData(bc), c.logger)
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+	m, err := data.NewMigrator(db, c.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	var done []*migrate.Migration
+	switch action {
+	case "up":
+		done, err = m.Up(ctx, n)
+	case "down":
+		if n == 0 {
+			n = 1
+		}
+		done, err = m.Down(ctx, int(n))
+	case "baseline":
+		done, err = m.Baseline(ctx, n)
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]*migrationStatus, 0, len(list))
+		for _, s := range list {
+			item := &migrationStatus{Version: s.Version, Name: s.Name, Applied: s.Applied, Modified: s.Modified, Missing: s.Missing}
+			if s.Applied {
+				item.AppliedAt = &s.AppliedAt
+			}
+			result = append(result, item)
+		}
+		return map[string]any{"migrations": result}, nil
+	}
+
+	// 执行失败时同样输出已完成的迁移
+	result := make([]*migrationInfo, 0, len(done))
+	for _, mig := range done {
+		result = append(result, &migrationInfo{Version: mig.Version, Name: mig.Name})
+	}
+	return map[string]any{action: result}, err
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/casbin/casbin/v3/model"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/env"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/tenant"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/server"
+)
+
+// JWT 密钥的建议最小长度
+const minJWTSecretLen = 32
+
+// snowflake 默认 10 位节点 ID
+const maxWorkerID = 1<<10 - 1
+
+var errConfigInvalid = kerrors.New(http.StatusUnprocessableEntity, "CONFIG_INVALID", "config is invalid")
+
+func runTokenRevoke(c *cli, args []string) (any, error) {
+	f := newUserFlags("token revoke")
+	if err := f.parse(args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	user, revoked, err := uc.RevokeTokens(context.Background(), *f.tenant, *f.username)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"user_id": user.ID, "revoked": revoked}, nil
+}
+
+func runPermSync(c *cli, args []string) (any, error) {
+	if err := parseFlags(flag.NewFlagSet("perm sync", flag.ContinueOnError), args); err != nil {
+		return nil, err
+	}
+	perms, err := server.ApiPermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	if err := uc.SyncPermissions(context.Background(), perms); err != nil {
+		return nil, err
+	}
+	return map[string]any{"permissions": len(perms)}, nil
+}
+
+func runCasbinReload(c *cli, args []string) (any, error) {
+	if err := parseFlags(flag.NewFlagSet("casbin reload", flag.ContinueOnError), args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	topics, err := uc.ReloadAuthz(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"topics": topics}, nil
+}
+
+// configReport 配置校验结果
+type configReport struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+func (r *configReport) errorf(format string, args ...any) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+func (r *configReport) warnf(format string, args ...any) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// runConfigValidate 静态校验配置，不连接数据库及 Redis
+func runConfigValidate(c *cli, args []string) (any, error) {
+	if err := parseFlags(flag.NewFlagSet("config validate", flag.ContinueOnError), args); err != nil {
+		return nil, err
+	}
+	bc, err := c.config()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &configReport{Errors: []string{}, Warnings: []string{}}
+	validateConfig(bc, r)
+	if _, err := server.ApiPermissions(); err != nil {
+		r.errorf("api permissions: %s", err)
+	}
+	r.Valid = len(r.Errors) == 0
+	if !r.Valid {
+		return r, errConfigInvalid.WithMetadata(map[string]string{"errors": fmt.Sprint(len(r.Errors))})
+	}
+	return r, nil
+}
+
+// validateDatabase 校验数据源的驱动、DSN 及只读副本
+func validateDatabase(path string, db *conf.Data_Database, r *configReport) {
+	switch db.GetDriver() {
+	case "postgres", "mysql":
+	case "sqlite":
+		r.warnf("%s.driver sqlite is meant for local development and tests only", path)
+		if len(db.GetReplicas()) > 0 {
+			r.errorf("%s.replicas is not supported by sqlite", path)
+		}
+	default:
+		r.errorf("%s.driver %q is not supported, expected postgres, mysql or sqlite", path, db.GetDriver())
+	}
+	if db.GetSource() == "" {
+		r.errorf("%s.source is required", path)
+	}
+	for i, replica := range db.GetReplicas() {
+		if replica == "" {
+			r.errorf("%s.replicas[%d] is empty", path, i)
+		}
+	}
+}
+
+func validateConfig(bc *conf.Bootstrap, r *configReport) {
+	if bc.GetServer().GetHttp().GetAddr() == "" {
+		r.errorf("server.http.addr is required")
+	}
+	if _, err := pkgCasbin.ParseTrustedProxies(bc.GetServer().GetHttp().GetTrustedProxies()); err != nil {
+		r.errorf("server.http.trusted_proxies: %v", err)
+	}
+
+	db := bc.GetData().GetDatabase()
+	validateDatabase("data.database", db, r)
+	if db.GetDriver() == "sqlite" && db.GetMigration() == data.MigrationVersioned {
+		r.errorf("data.database.migration versioned is not supported by sqlite, use auto")
+	}
+	switch db.GetMigration() {
+	case "", data.MigrationAuto, data.MigrationVersioned, data.MigrationNone:
+	default:
+		r.errorf("data.database.migration %q is invalid, expected auto, versioned or none", db.GetMigration())
+	}
+	for _, name := range sortedKeys(bc.GetData().GetSources()) {
+		if name == data.DefaultSource {
+			r.errorf("data.sources.%s: the name is reserved for data.database", name)
+			continue
+		}
+		validateDatabase("data.sources."+name, bc.GetData().GetSources()[name], r)
+	}
+	switch redis := bc.GetData().GetRedis(); {
+	case redis.GetEmbedded():
+		r.warnf("data.redis.embedded is meant for local development and tests only")
+	case redis.GetAddr() == "":
+		r.errorf("data.redis.addr is required")
+	}
+
+	if path := bc.GetData().GetCasbin().GetModelPath(); path != "" {
+		if _, err := model.NewModelFromFile(path); err != nil {
+			r.errorf("data.casbin.model_path: %v", err)
+		}
+	}
+
+	app := bc.GetApp()
+	switch secret := app.GetAuth().GetJwt().GetSecret(); {
+	case secret == "":
+		r.errorf("app.auth.jwt.secret is required")
+	case len(secret) < minJWTSecretLen:
+		r.warnf("app.auth.jwt.secret is shorter than %d bytes", minJWTSecretLen)
+	}
+	switch env.Type(app.GetEnv()) {
+	case env.Dev, env.Test, env.Prod:
+	default:
+		r.errorf("app.env %q is invalid, expected dev, test or prod", app.GetEnv())
+	}
+	if id := app.GetWorkerId(); id < 0 || id > maxWorkerID {
+		r.errorf("app.worker_id %d is out of range [0, %d]", id, maxWorkerID)
+	}
+	for _, rule := range app.GetTenant().GetResolver().GetOrder() {
+		switch rule {
+		case tenant.RuleHeader, tenant.RuleSubdomain, tenant.RuleField:
+		default:
+			r.errorf("app.tenant.resolver.order %q is invalid, expected header, subdomain or field", rule)
+		}
+	}
+
+	// OTP 场景使用的模板需在短信模板或邮件主题中配置
+	templates := bc.GetData().GetSms().GetTemplateMapping()
+	for _, name := range sortedKeys(app.GetOtp().GetPhoneScenes()) {
+		tpl := app.GetOtp().GetPhoneScenes()[name].GetTemplateName()
+		if _, ok := templates[tpl]; !ok {
+			r.errorf("app.otp.phone_scenes.%s: template %q not found in data.sms.template_mapping", name, tpl)
+		}
+	}
+	subjects := bc.GetData().GetEmail().GetSubjectMapping()
+	for _, name := range sortedKeys(app.GetOtp().GetEmailScenes()) {
+		tpl := app.GetOtp().GetEmailScenes()[name].GetTemplateName()
+		if _, ok := subjects[tpl]; !ok {
+			r.errorf("app.otp.email_scenes.%s: template %q not found in data.email.subject_mapping", name, tpl)
+		}
+	}
+}
+
+// sortedKeys map 的有序键，用于稳定的输出
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
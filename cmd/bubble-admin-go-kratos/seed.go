@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/server"
+)
+
+// runSeed 初始化数据库，可重复执行：seed [-file seed.yaml]
+// 超级管理员的用户名及密码取自环境变量 BUBBLE_ADMIN_USERNAME / BUBBLE_ADMIN_PASSWORD，未设置密码时生成随机密码并仅输出一次
+// 表结构按配置 data.database.migration 维护，none 时需先执行 migrate up
+func runSeed(c *cli, args []string) (any, error) {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	file := fs.String("file", "", "extra seed data in YAML, eg: -file scripts/db/seed.example.yaml")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+	bc, err := c.config()
+	if err != nil {
+		return nil, err
+	}
+
+	seedData := biz.DefaultSeedData()
+	if *file != "" {
+		extra, err := biz.LoadSeedFile(*file)
+		if err != nil {
+			return nil, err
+		}
+		seedData.Merge(extra)
+	}
+	perms, err := server.ApiPermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := wireSeed(bc.Data, bc.App, c.logger)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	result, err := uc.Run(context.Background(), perms, seedData)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"created":   result.Created,
+		"passwords": result.Passwords,
+	}, nil
+}
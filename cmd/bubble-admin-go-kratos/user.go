@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+// 未指定 -tenant 时使用系统租户
+const defaultTenant = "system"
+
+// userOutput 用户维护命令输出的用户信息
+type userOutput struct {
+	ID       int64  `json:"id"`
+	TenantID int64  `json:"tenant_id"`
+	Username string `json:"username"`
+	Name     string `json:"name,omitempty"`
+	DeptID   int64  `json:"dept_id,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	Password string `json:"password,omitempty"` // 仅在生成随机密码时输出
+}
+
+func toUserOutput(u *biz.SysUser, password string) *userOutput {
+	return &userOutput{
+		ID:       u.ID,
+		TenantID: u.TenantID,
+		Username: u.Username,
+		Name:     u.Nickname,
+		DeptID:   u.DeptID,
+		Enabled:  u.IsAvailable,
+		Password: password,
+	}
+}
+
+// userFlags 按租户编码及用户名定位用户的公共参数
+type userFlags struct {
+	fs       *flag.FlagSet
+	tenant   *string
+	username *string
+}
+
+func newUserFlags(name string) *userFlags {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	return &userFlags{
+		fs:       fs,
+		tenant:   fs.String("tenant", defaultTenant, "tenant code"),
+		username: fs.String("user", "", "username"),
+	}
+}
+
+func (f *userFlags) parse(args []string) error {
+	if err := parseFlags(f.fs, args); err != nil {
+		return err
+	}
+	if *f.username == "" {
+		return usageErrorf("-user is required")
+	}
+	return nil
+}
+
+// admin 构建运维命令使用的 AdminUseCase
+func (c *cli) admin() (*biz.AdminUseCase, func(), error) {
+	bc, err := c.config()
+	if err != nil {
+		return nil, nil, err
+	}
+	return wireAdmin(c.adminData(bc), bc.App, c.logger)
+}
+
+func runUserCreate(c *cli, args []string) (any, error) {
+	f := newUserFlags("user create")
+	password := f.fs.String("password", "", "password, generated when empty")
+	name := f.fs.String("name", "", "display name")
+	mobile := f.fs.String("mobile", "", "mobile")
+	email := f.fs.String("email", "", "email")
+	dept := f.fs.String("dept", "", "dept name")
+	roles := f.fs.String("roles", "", "role codes separated by comma")
+	if err := f.parse(args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	user, generated, err := uc.CreateUser(context.Background(), &biz.AdminUser{
+		Tenant:   *f.tenant,
+		Username: *f.username,
+		Password: *password,
+		Name:     *name,
+		Mobile:   *mobile,
+		Email:    *email,
+		Dept:     *dept,
+		Roles:    splitList(*roles),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUserOutput(user, generated), nil
+}
+
+func runUserResetPassword(c *cli, args []string) (any, error) {
+	f := newUserFlags("user reset-password")
+	password := f.fs.String("password", "", "new password, generated when empty")
+	if err := f.parse(args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	user, generated, err := uc.ResetPassword(context.Background(), *f.tenant, *f.username, *password)
+	if err != nil {
+		return nil, err
+	}
+	return toUserOutput(user, generated), nil
+}
+
+func runUserUnlock(c *cli, args []string) (any, error) {
+	f := newUserFlags("user unlock")
+	if err := f.parse(args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	user, err := uc.Unlock(context.Background(), *f.tenant, *f.username)
+	if err != nil {
+		return nil, err
+	}
+	return toUserOutput(user, ""), nil
+}
+
+func runUserBlock(c *cli, args []string) (any, error) {
+	f := newUserFlags("user block")
+	if err := f.parse(args); err != nil {
+		return nil, err
+	}
+
+	uc, cleanup, err := c.admin()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	user, err := uc.Block(context.Background(), *f.tenant, *f.username)
+	if err != nil {
+		return nil, err
+	}
+	return toUserOutput(user, ""), nil
+}
+
+// splitList 拆分逗号分隔的参数，忽略空项
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
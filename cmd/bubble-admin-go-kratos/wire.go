@@ -29,6 +29,24 @@ func wireApp(*conf.Server, *conf.Data, *conf.App, log.Logger) (*kratos.App, func
 		data.ProviderSet,
 		auth.ProviderSet,
 		ws.NewHub,
+		wire.Bind(new(biz.NoticePusher), new(*ws.Hub)),
 		newApp,
 	))
 }
+
+// wireSeed init the use case that seeds a fresh database.
+func wireSeed(*conf.Data, *conf.App, log.Logger) (*biz.SeedUseCase, func(), error) {
+	panic(wire.Build(
+		biz.ProviderSet,
+		data.ProviderSet,
+	))
+}
+
+// wireAdmin init the use case behind the admin subcommands.
+func wireAdmin(*conf.Data, *conf.App, log.Logger) (*biz.AdminUseCase, func(), error) {
+	panic(wire.Build(
+		biz.ProviderSet,
+		data.ProviderSet,
+		auth.ProviderSet,
+	))
+}
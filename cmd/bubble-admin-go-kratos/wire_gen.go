@@ -16,6 +16,7 @@ import (
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/job"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/oss"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/sms"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/ws"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/server"
@@ -32,10 +33,11 @@ import (
 func wireApp(confServer *conf.Server, confData *conf.Data, app *conf.App, logger log.Logger) (*kratos.App, func(), error) {
 	grpcServer := server.NewGRPCServer(confServer, logger)
 	db := data.NewDB(confData, logger)
-	client := data.NewRedis(confData, logger)
+	client, cleanup := data.NewRedis(confData, logger)
 	idGenerator := data.NewIDGenerator(app)
-	dataData, cleanup, err := data.NewData(confData, logger, db, client, idGenerator)
+	dataData, cleanup2, err := data.NewData(confData, logger, db, client, idGenerator)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	store := data.NewRedisCaptchaStore(dataData)
@@ -43,11 +45,15 @@ func wireApp(confServer *conf.Server, confData *conf.Data, app *conf.App, logger
 	sender := sms.NewSmsSender(confData, logger)
 	emailSender := email.NewEmailSender(confData, logger)
 	otpCache := data.NewRedisOtpCache(dataData)
-	otpUseCase := biz.NewOtpUseCase(sender, emailSender, otpCache, app, logger)
+	configLoader := data.NewConfigLoader(dataData, logger)
+	configProvider := provider.NewConfigProvider(configLoader)
+	otpUseCase := biz.NewOtpUseCase(sender, emailSender, otpCache, app, configProvider, logger)
 	tokenStore := auth.NewTokenStore(app, client)
 	tokenService := auth.NewTokenService(app, tokenStore)
 	sysUserRepo := data.NewSysUserRepo(dataData, logger)
-	passportUseCase := biz.NewPassportUseCase(tokenService, sysUserRepo, app, logger)
+	tenantLoader := data.NewTenantLoader(dataData, logger)
+	tenantProvider := provider.NewTenantProvider(tenantLoader, app)
+	passportUseCase := biz.NewPassportUseCase(tokenService, sysUserRepo, tenantProvider, app, logger)
 	publicService := service.NewPublicService(captchaUseCase, otpUseCase, passportUseCase, logger)
 	passportService := service.NewPassportService(passportUseCase, otpUseCase, captchaUseCase)
 	hub := ws.NewHub(logger)
@@ -55,26 +61,194 @@ func wireApp(confServer *conf.Server, confData *conf.Data, app *conf.App, logger
 	chatUseCase := biz.NewChatUseCase(chatRepo, logger)
 	chatService := service.NewChatService(hub, chatUseCase)
 	websocketService := service.NewWebsocketService(hub, chatService, tokenService, logger)
-	model, err := data.NewCasbinModel()
+	model, err := data.NewCasbinModel(confData, logger)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	adapter := data.NewSysPermissionAdapter(db)
 	syncedEnforcer, err := data.NewCasbinEnforcer(model, adapter)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	permissionLoader := data.NewPermissionRepo(dataData, logger)
+	permissionLoader := data.NewPermissionLoader(dataData, logger)
 	permissionProvider := provider.NewPermissionProvider(permissionLoader)
-	packageLoader := data.NewTenantRepo(dataData, logger)
+	packageLoader := data.NewPackageLoader(dataData, logger)
 	packageProvider := provider.NewPackageProvider(packageLoader)
-	httpServer := server.NewHTTPServer(confServer, app, publicService, passportService, tokenService, websocketService, syncedEnforcer, permissionProvider, packageProvider, logger)
+	tenantRepo := data.NewTenantRepo(dataData, logger)
+	authzWatcher, cleanup3, err := data.NewAuthzWatcher(dataData, syncedEnforcer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policyRepo := data.NewPolicyRepo(dataData, syncedEnforcer, authzWatcher, logger)
+	dataScopeLoader := data.NewDataScopeLoader(dataData, logger)
+	dataScopeProvider := provider.NewDataScopeProvider(dataScopeLoader)
+	authzUseCase := biz.NewAuthzUseCase(policyRepo, authzWatcher, permissionProvider, packageProvider, tenantProvider, dataScopeProvider, app, logger)
+	tenantUseCase := biz.NewTenantUseCase(tenantRepo, tenantProvider, packageProvider, authzUseCase, emailSender, app, logger)
+	tenantService := service.NewTenantService(tenantUseCase)
+	roleRepo := data.NewRoleRepo(dataData, logger)
+	roleUseCase := biz.NewRoleUseCase(roleRepo, dataData, policyRepo, authzUseCase, packageProvider, app, logger)
+	roleService := service.NewRoleService(roleUseCase)
+	authzService := service.NewAuthzService(authzUseCase)
+	permissionRepo := data.NewPermissionRepo(dataData, logger)
+	permissionUseCase := biz.NewPermissionUseCase(permissionRepo, authzUseCase, logger)
+	operLogRepo := data.NewOperLogRepo(dataData, logger)
+	storage := oss.NewOSS(confData, logger)
+	operLogUseCase := biz.NewOperLogUseCase(operLogRepo, storage, app, logger)
+	operLogService := service.NewOperLogService(operLogUseCase)
+	userJobRepo := data.NewUserJobRepo(dataData, logger)
+	deptRepo := data.NewDeptRepo(dataData, logger)
+	uploadUseCase := biz.NewUploadUseCase(storage, app, configProvider, logger)
+	userJobUseCase := biz.NewUserJobUseCase(userJobRepo, sysUserRepo, deptRepo, roleRepo, policyRepo, uploadUseCase, storage, app, logger)
+	userService := service.NewUserService(userJobUseCase)
+	recycleRepo := data.NewRecycleRepo(dataData, logger)
+	recycleUseCase := biz.NewRecycleUseCase(recycleRepo, dataData, authzUseCase, app, logger)
+	recycleService := service.NewRecycleService(recycleUseCase)
+	dictRepo := data.NewDictRepo(dataData, logger)
+	dictCache := data.NewRedisDictCache(dataData)
+	dictUseCase := biz.NewDictUseCase(dictRepo, dictCache, logger)
+	dictService := service.NewDictService(dictUseCase)
+	configRepo := data.NewConfigRepo(dataData, logger)
+	configUseCase := biz.NewConfigUseCase(configRepo, dataData, configProvider, authzWatcher, logger)
+	configService := service.NewConfigService(configUseCase)
+	noticeRepo := data.NewNoticeRepo(dataData, logger)
+	noticeUseCase := biz.NewNoticeUseCase(noticeRepo, dataData, hub, sender, emailSender, logger)
+	noticeService := service.NewNoticeService(noticeUseCase)
+	operLogWriter, cleanup4, err := data.NewOperLogWriter(dataData, app, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer, err := server.NewHTTPServer(confServer, app, publicService, passportService, tokenService, websocketService, syncedEnforcer, permissionProvider, packageProvider, tenantProvider, tenantService, dataScopeProvider, roleService, authzService, permissionUseCase, operLogService, userService, recycleService, dictService, configService, noticeService, operLogWriter, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	helloJob := job.NewHelloJob(logger)
-	cronServer := server.NewCronServer(confServer, logger, helloJob)
+	tenantRefreshJob := job.NewTenantRefreshJob(tenantUseCase, logger)
+	tenantExpireNoticeJob := job.NewTenantExpireNoticeJob(tenantUseCase, logger)
+	userRoleExpireJob := job.NewUserRoleExpireJob(roleUseCase, logger)
+	operLogCleanupJob := job.NewOperLogCleanupJob(operLogUseCase, logger)
+	userJobTimeoutJob := job.NewUserJobTimeoutJob(userJobUseCase, logger)
+	recycleCleanupJob := job.NewRecycleCleanupJob(recycleUseCase, logger)
+	cronServer := server.NewCronServer(confServer, logger, helloJob, tenantRefreshJob, tenantExpireNoticeJob, userRoleExpireJob, operLogCleanupJob, userJobTimeoutJob, recycleCleanupJob)
 	kratosApp := newApp(logger, grpcServer, httpServer, cronServer)
 	return kratosApp, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireSeed init the use case that seeds a fresh database.
+func wireSeed(confData *conf.Data, app *conf.App, logger log.Logger) (*biz.SeedUseCase, func(), error) {
+	db := data.NewDB(confData, logger)
+	client, cleanup := data.NewRedis(confData, logger)
+	idGenerator := data.NewIDGenerator(app)
+	dataData, cleanup2, err := data.NewData(confData, logger, db, client, idGenerator)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	seedRepo := data.NewSeedRepo(dataData, logger)
+	permissionRepo := data.NewPermissionRepo(dataData, logger)
+	model, err := data.NewCasbinModel(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	adapter := data.NewSysPermissionAdapter(db)
+	syncedEnforcer, err := data.NewCasbinEnforcer(model, adapter)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authzWatcher, cleanup3, err := data.NewAuthzWatcher(dataData, syncedEnforcer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policyRepo := data.NewPolicyRepo(dataData, syncedEnforcer, authzWatcher, logger)
+	permissionLoader := data.NewPermissionLoader(dataData, logger)
+	permissionProvider := provider.NewPermissionProvider(permissionLoader)
+	packageLoader := data.NewPackageLoader(dataData, logger)
+	packageProvider := provider.NewPackageProvider(packageLoader)
+	tenantLoader := data.NewTenantLoader(dataData, logger)
+	tenantProvider := provider.NewTenantProvider(tenantLoader, app)
+	dataScopeLoader := data.NewDataScopeLoader(dataData, logger)
+	dataScopeProvider := provider.NewDataScopeProvider(dataScopeLoader)
+	authzUseCase := biz.NewAuthzUseCase(policyRepo, authzWatcher, permissionProvider, packageProvider, tenantProvider, dataScopeProvider, app, logger)
+	permissionUseCase := biz.NewPermissionUseCase(permissionRepo, authzUseCase, logger)
+	seedUseCase := biz.NewSeedUseCase(seedRepo, dataData, permissionUseCase, authzUseCase, logger)
+	return seedUseCase, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireAdmin init the use case behind the admin subcommands.
+func wireAdmin(confData *conf.Data, app *conf.App, logger log.Logger) (*biz.AdminUseCase, func(), error) {
+	db := data.NewDB(confData, logger)
+	client, cleanup := data.NewRedis(confData, logger)
+	idGenerator := data.NewIDGenerator(app)
+	dataData, cleanup2, err := data.NewData(confData, logger, db, client, idGenerator)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	adminRepo := data.NewAdminRepo(dataData, logger)
+	tokenStore := auth.NewTokenStore(app, client)
+	tokenService := auth.NewTokenService(app, tokenStore)
+	permissionRepo := data.NewPermissionRepo(dataData, logger)
+	model, err := data.NewCasbinModel(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	adapter := data.NewSysPermissionAdapter(db)
+	syncedEnforcer, err := data.NewCasbinEnforcer(model, adapter)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authzWatcher, cleanup3, err := data.NewAuthzWatcher(dataData, syncedEnforcer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policyRepo := data.NewPolicyRepo(dataData, syncedEnforcer, authzWatcher, logger)
+	permissionLoader := data.NewPermissionLoader(dataData, logger)
+	permissionProvider := provider.NewPermissionProvider(permissionLoader)
+	packageLoader := data.NewPackageLoader(dataData, logger)
+	packageProvider := provider.NewPackageProvider(packageLoader)
+	tenantLoader := data.NewTenantLoader(dataData, logger)
+	tenantProvider := provider.NewTenantProvider(tenantLoader, app)
+	dataScopeLoader := data.NewDataScopeLoader(dataData, logger)
+	dataScopeProvider := provider.NewDataScopeProvider(dataScopeLoader)
+	authzUseCase := biz.NewAuthzUseCase(policyRepo, authzWatcher, permissionProvider, packageProvider, tenantProvider, dataScopeProvider, app, logger)
+	permissionUseCase := biz.NewPermissionUseCase(permissionRepo, authzUseCase, logger)
+	adminUseCase := biz.NewAdminUseCase(adminRepo, dataData, tokenService, permissionUseCase, authzUseCase, logger)
+	return adminUseCase, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
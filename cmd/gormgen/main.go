@@ -119,14 +119,25 @@ func main() {
 
 	// 对 sys_ 开头的 Code-First 模型生成 Query 代码
 	g.ApplyBasic(
+		model.SysConfig{},
+		model.SysConfigLog{},
 		model.SysDept{},
+		model.SysDictItem{},
+		model.SysDictType{},
+		model.SysNotice{},
+		model.SysNoticeInbox{},
+		model.SysOperLog{},
+		model.SysOperLogChain{},
 		model.SysPackage{},
 		model.SysPackagePermission{},
 		model.SysPermission{},
 		model.SysRole{},
 		model.SysRolePermission{},
+		model.SysRoleDept{},
+		model.SysRoleInherit{},
 		model.SysTenant{},
 		model.SysUser{},
+		model.SysUserJob{},
 		model.SysUserRole{},
 	)
 
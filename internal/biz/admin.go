@@ -0,0 +1,226 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 运维封禁用户时记录的 Token 吊销原因
+const adminBlockReason = "blocked by administrator"
+
+// AdminUser 运维命令创建的用户
+type AdminUser struct {
+	Tenant   string // 租户编码
+	Username string
+	Password string // 为空时生成随机密码
+	Name     string
+	Mobile   string
+	Email    string
+	Dept     string   // 部门名称
+	Roles    []string // 角色编码
+}
+
+// AdminRepo 运维命令按租户编码及用户名定位数据，不受租户及数据范围限制
+type AdminRepo interface {
+	FindTenantID(ctx context.Context, code string) (int64, error)
+	FindUser(ctx context.Context, tenantID int64, username string) (*SysUser, error)
+	FindDeptID(ctx context.Context, tenantID int64, name string) (int64, error)
+	// FindRoleIDs 按角色编码查询角色 ID，任一编码不存在时返回 ErrRoleNotFound
+	FindRoleIDs(ctx context.Context, tenantID int64, codes []string) ([]int64, error)
+	CreateUser(ctx context.Context, user *SysUser) error
+	AssignUserRoles(ctx context.Context, tenantID, userID int64, roleIDs []int64) error
+	UpdatePassword(ctx context.Context, userID int64, passwordHash string) error
+	UpdateStatus(ctx context.Context, userID int64, status int16) error
+	// ResetLoginFailed 清除登录失败次数
+	ResetLoginFailed(ctx context.Context, userID int64) error
+}
+
+// AdminUseCase 运维命令：用户维护、Token 吊销、权限同步及权限缓存刷新
+type AdminUseCase struct {
+	repo   AdminRepo
+	tx     Transaction
+	tokens auth.TokenService
+	perms  *PermissionUseCase
+	authz  *AuthzUseCase
+	log    *log.Helper
+}
+
+func NewAdminUseCase(repo AdminRepo, tx Transaction, tokens auth.TokenService, perms *PermissionUseCase, authz *AuthzUseCase, logger log.Logger) *AdminUseCase {
+	return &AdminUseCase{
+		repo:   repo,
+		tx:     tx,
+		tokens: tokens,
+		perms:  perms,
+		authz:  authz,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// CreateUser 创建启用状态的用户并分配角色，返回用户及生成的随机密码（指定密码时为空）
+func (uc *AdminUseCase) CreateUser(ctx context.Context, u *AdminUser) (*SysUser, string, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	tenantID, err := uc.repo.FindTenantID(ctx, u.Tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := uc.repo.FindUser(ctx, tenantID, u.Username); err == nil {
+		return nil, "", ErrUserAlreadyExists
+	} else if !kerrors.Is(err, ErrUserNotFound) {
+		return nil, "", err
+	}
+
+	user := &SysUser{
+		Username:    u.Username,
+		Nickname:    u.Name,
+		Phone:       u.Mobile,
+		Email:       u.Email,
+		TenantID:    tenantID,
+		IsAvailable: true,
+	}
+	if u.Dept != "" {
+		if user.DeptID, err = uc.repo.FindDeptID(ctx, tenantID, u.Dept); err != nil {
+			return nil, "", err
+		}
+	}
+	var roleIDs []int64
+	if len(u.Roles) > 0 {
+		if roleIDs, err = uc.repo.FindRoleIDs(ctx, tenantID, u.Roles); err != nil {
+			return nil, "", err
+		}
+	}
+	generated, err := uc.setPassword(user, u.Password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		return uc.repo.AssignUserRoles(ctx, tenantID, user.ID, roleIDs)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(roleIDs) > 0 {
+		if err := uc.authz.Invalidate(ctx, TopicPolicy); err != nil {
+			return nil, "", err
+		}
+	}
+	return user, generated, nil
+}
+
+// ResetPassword 重置密码并吊销用户的全部 Token，返回生成的随机密码（指定密码时为空）
+func (uc *AdminUseCase) ResetPassword(ctx context.Context, tenant, username, password string) (*SysUser, string, error) {
+	user, err := uc.findUser(ctx, tenant, username)
+	if err != nil {
+		return nil, "", err
+	}
+	generated, err := uc.setPassword(user, password)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := uc.repo.UpdatePassword(auth.WithSkipDataScope(ctx), user.ID, user.PasswordHash); err != nil {
+		return nil, "", err
+	}
+	if err := uc.tokens.RevokeAllTokensByUserID(ctx, user.ID); err != nil {
+		return nil, "", err
+	}
+	return user, generated, nil
+}
+
+// Unlock 清除登录失败次数并启用用户
+func (uc *AdminUseCase) Unlock(ctx context.Context, tenant, username string) (*SysUser, error) {
+	user, err := uc.findUser(ctx, tenant, username)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.tx.InTx(auth.WithSkipDataScope(ctx), func(ctx context.Context) error {
+		if err := uc.repo.ResetLoginFailed(ctx, user.ID); err != nil {
+			return err
+		}
+		return uc.repo.UpdateStatus(ctx, user.ID, UserStatusEnabled)
+	})
+	if err != nil {
+		return nil, err
+	}
+	user.IsAvailable = true
+	return user, nil
+}
+
+// Block 禁用用户并吊销其全部 Token
+func (uc *AdminUseCase) Block(ctx context.Context, tenant, username string) (*SysUser, error) {
+	user, err := uc.findUser(ctx, tenant, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateStatus(auth.WithSkipDataScope(ctx), user.ID, UserStatusDisabled); err != nil {
+		return nil, err
+	}
+	if err := uc.tokens.BlockUser(ctx, strconv.FormatInt(user.ID, 10), adminBlockReason); err != nil {
+		return nil, err
+	}
+	user.IsAvailable = false
+	return user, nil
+}
+
+// RevokeTokens 吊销用户的全部 Token，返回吊销的数量
+func (uc *AdminUseCase) RevokeTokens(ctx context.Context, tenant, username string) (*SysUser, int, error) {
+	user, err := uc.findUser(ctx, tenant, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	tokens, err := uc.tokens.GetUserTokens(ctx, strconv.FormatInt(user.ID, 10))
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.tokens.RevokeAllTokensByUserID(ctx, user.ID); err != nil {
+		return nil, 0, err
+	}
+	return user, len(*tokens), nil
+}
+
+// SyncPermissions 按接口声明同步 API 权限
+func (uc *AdminUseCase) SyncPermissions(ctx context.Context, perms []*ApiPermission) error {
+	return uc.perms.SyncApiPermissions(auth.WithSkipDataScope(ctx), perms)
+}
+
+// ReloadAuthz 通知全部节点重新加载 Casbin 策略及权限缓存，返回刷新的缓存主题
+func (uc *AdminUseCase) ReloadAuthz(ctx context.Context) ([]string, error) {
+	if err := uc.authz.ReloadAll(ctx); err != nil {
+		return nil, err
+	}
+	return AllTopics, nil
+}
+
+func (uc *AdminUseCase) findUser(ctx context.Context, tenant, username string) (*SysUser, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	tenantID, err := uc.repo.FindTenantID(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.FindUser(ctx, tenantID, username)
+}
+
+// setPassword 设置用户的密码哈希，password 为空时生成随机密码并返回
+func (uc *AdminUseCase) setPassword(user *SysUser, password string) (string, error) {
+	var generated string
+	if password == "" {
+		var err error
+		if generated, err = randomPassword(); err != nil {
+			return "", err
+		}
+		password = generated
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	user.PasswordHash = string(hash)
+	return generated, nil
+}
@@ -0,0 +1,271 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+// 集群缓存失效主题
+const (
+	TopicPolicy     = "policy"     // Casbin 策略（角色授权、用户角色）
+	TopicPermission = "permission" // 接口权限映射 PermissionProvider
+	TopicPackage    = "package"    // 租户套餐权限 PackageProvider
+	TopicTenant     = "tenant"     // 租户状态 TenantProvider
+	TopicDataScope  = "datascope"  // 数据范围 DataScopeProvider
+	TopicConfig     = "config"     // 系统参数 ConfigProvider，由 ConfigUseCase 处理
+)
+
+// AllTopics 全部权限缓存主题
+var AllTopics = []string{TopicPolicy, TopicPermission, TopicPackage, TopicTenant, TopicDataScope}
+
+// AuthzNotifier 集群广播，由 Data 层基于 Redis Pub/Sub 实现
+type AuthzNotifier interface {
+	// Publish 通知其他节点刷新指定主题的缓存
+	Publish(ctx context.Context, topics ...string) error
+	// Subscribe 注册收到其他节点通知时的处理函数（TopicPolicy 由 Casbin Watcher 自行处理）
+	Subscribe(handler func(ctx context.Context, topics []string))
+}
+
+// PolicyRepo Casbin 策略，由 Data 层实现
+// 变更经 Adapter 写入业务表（覆盖类操作在同一事务中完成），并通过 Watcher 通知其他节点
+type PolicyRepo interface {
+	// ReloadPolicy 从数据库全量重新加载策略
+	ReloadPolicy(ctx context.Context) error
+	// SetUserRoles 覆盖用户在租户下的角色，expireAt 为空表示永久
+	SetUserRoles(ctx context.Context, tenantID, userID int64, roleCodes []string, expireAt *time.Time) error
+	// SetRoleParents 覆盖角色在租户下继承的父角色
+	SetRoleParents(ctx context.Context, tenantID int64, roleCode string, parentCodes []string) error
+	// SetRolePermissions 覆盖角色在租户下的授权
+	SetRolePermissions(ctx context.Context, tenantID int64, roleCode string, grants []*RoleGrant) error
+	// RemoveExpiredUserRoles 移除已到期的用户角色，返回移除数量
+	RemoveExpiredUserRoles(ctx context.Context) (int, error)
+	// GetUserRoles 用户在租户下的全部角色（含继承的角色）
+	GetUserRoles(ctx context.Context, tenantID, userID int64) ([]string, error)
+	// IsAdmin 用户是否为超级管理员
+	IsAdmin(ctx context.Context, userID int64) bool
+	// Enforce 判定用户能否使用权限码，返回决定结果的策略（未命中任何策略时为空）
+	Enforce(ctx context.Context, tenantID, userID int64, permCode string, attr *pkgCasbin.Attributes) (bool, []string, error)
+	// ScopeGrants 用户各角色在权限码上被授予的数据范围
+	ScopeGrants(ctx context.Context, tenantID, userID int64, permCodes []string, attr *pkgCasbin.Attributes) []provider.ScopeGrant
+}
+
+// RoleGrant 角色授权：权限码、数据范围、生效条件及效果
+type RoleGrant struct {
+	PermCode  string
+	DataScope string
+	Condition string // Casbin 条件表达式，空为无条件
+	Effect    string // allow 或 deny，空为 allow；deny 优先于 allow
+}
+
+// 平台租户：多租户模式下为 system 租户，可查看其他租户的授权
+const systemTenantID = int64(1)
+
+var ErrExplainCrossTenant = kerrors.Forbidden("EXPLAIN_CROSS_TENANT", "只能查看本租户用户的授权")
+
+// 拒绝原因
+const (
+	DenyPackage = "PACKAGE_LIMIT" // 租户套餐不包含该权限
+	DenyCasbin  = "CASBIN"        // 没有角色授权或被拒绝策略排除
+)
+
+// PermissionDecision 单个权限码的鉴权结果
+type PermissionDecision struct {
+	PermCode  string
+	Allowed   bool
+	Reason    string                 // 拒绝原因，允许时为空
+	DataScope provider.ResolvedScope // 允许时生效的数据范围
+}
+
+// CodeExplain 单个权限码的 Casbin 判定过程
+type CodeExplain struct {
+	PermCode string
+	Allowed  bool
+	Policy   []string              // 决定结果的策略，超级管理员或未命中任何策略时为空
+	Grants   []provider.ScopeGrant // 各角色被授予的数据范围
+}
+
+// PackageExplain 租户套餐判定
+type PackageExplain struct {
+	Checked      bool     // 仅多租户模式校验套餐
+	Allowed      bool     // 套餐包含任一权限码
+	AllowedCodes []string // 套餐包含的权限码
+}
+
+// AuthzExplain 接口鉴权过程，与 HTTP 中间件的判定顺序一致
+type AuthzExplain struct {
+	Allowed   bool
+	PermCodes []string // 接口关联的权限码
+	Roles     []string // 参与判定的角色（含继承的角色）
+	Admin     bool     // 是否为超级管理员
+	Package   PackageExplain
+	Codes     []*CodeExplain
+}
+
+type AuthzUseCase struct {
+	policy      PolicyRepo
+	notifier    AuthzNotifier
+	permissions *provider.PermissionProvider
+	packages    *provider.PackageProvider
+	tenants     *provider.TenantProvider
+	scopes      *provider.DataScopeProvider
+	multiTenant bool
+	log         *log.Helper
+}
+
+func NewAuthzUseCase(
+	policy PolicyRepo,
+	notifier AuthzNotifier,
+	permissions *provider.PermissionProvider,
+	packages *provider.PackageProvider,
+	tenants *provider.TenantProvider,
+	scopes *provider.DataScopeProvider,
+	c *conf.App,
+	logger log.Logger,
+) *AuthzUseCase {
+	uc := &AuthzUseCase{
+		policy:      policy,
+		notifier:    notifier,
+		permissions: permissions,
+		packages:    packages,
+		tenants:     tenants,
+		scopes:      scopes,
+		multiTenant: c.EnableMultiTenant,
+		log:         log.NewHelper(logger),
+	}
+	notifier.Subscribe(func(ctx context.Context, topics []string) {
+		if err := uc.reload(ctx, topics); err != nil {
+			uc.log.Errorf("reload %v failed: %v", topics, err)
+		}
+	})
+	return uc
+}
+
+// Invalidate 刷新本节点缓存并通知其他节点
+func (uc *AuthzUseCase) Invalidate(ctx context.Context, topics ...string) error {
+	if err := uc.reload(ctx, topics); err != nil {
+		return err
+	}
+	return uc.notifier.Publish(ctx, topics...)
+}
+
+// ReloadAll 刷新全部节点的全部权限缓存
+func (uc *AuthzUseCase) ReloadAll(ctx context.Context) error {
+	return uc.Invalidate(ctx, AllTopics...)
+}
+
+// Check 批量判定当前用户能否使用权限码，并返回各权限码生效的数据范围
+func (uc *AuthzUseCase) Check(ctx context.Context, permCodes []string, attr *pkgCasbin.Attributes) ([]*PermissionDecision, error) {
+	info := auth.GetContextInfo(ctx)
+	admin := uc.policy.IsAdmin(ctx, info.UserID)
+
+	result := make([]*PermissionDecision, 0, len(permCodes))
+	for _, code := range permCodes {
+		d := &PermissionDecision{PermCode: code}
+		result = append(result, d)
+
+		if uc.multiTenant && !uc.packages.IsTenantPermAllowed(info.TenantID, code) {
+			d.Reason = DenyPackage
+			continue
+		}
+		ok, _, err := uc.policy.Enforce(ctx, info.TenantID, info.UserID, code, attr)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			d.Reason = DenyCasbin
+			continue
+		}
+
+		d.Allowed = true
+		if admin {
+			d.DataScope = provider.ResolvedScope{Scope: auth.ScopeAll}
+			continue
+		}
+		grants := uc.policy.ScopeGrants(ctx, info.TenantID, info.UserID, []string{code}, attr)
+		if d.DataScope, err = uc.scopes.Resolve(ctx, info, grants); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Explain 解释用户在租户下调用接口的鉴权过程，tenantID 为 0 时取当前租户
+func (uc *AuthzUseCase) Explain(ctx context.Context, tenantID, userID int64, operation string, attr *pkgCasbin.Attributes) (*AuthzExplain, error) {
+	current := auth.GetTenantID(ctx)
+	if tenantID == 0 {
+		tenantID = current
+	}
+	if tenantID != current && current != systemTenantID {
+		return nil, ErrExplainCrossTenant
+	}
+
+	roles, err := uc.policy.GetUserRoles(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := &AuthzExplain{
+		PermCodes: uc.permissions.GetCodes(operation),
+		Roles:     roles,
+		Admin:     uc.policy.IsAdmin(ctx, userID),
+		Package:   PackageExplain{Checked: uc.multiTenant, Allowed: !uc.multiTenant},
+	}
+	if uc.multiTenant {
+		for _, code := range result.PermCodes {
+			if uc.packages.IsTenantPermAllowed(tenantID, code) {
+				result.Package.AllowedCodes = append(result.Package.AllowedCodes, code)
+			}
+		}
+		result.Package.Allowed = len(result.Package.AllowedCodes) > 0
+	}
+
+	casbinAllowed := false
+	for _, code := range result.PermCodes {
+		ok, policy, err := uc.policy.Enforce(ctx, tenantID, userID, code, attr)
+		if err != nil {
+			return nil, err
+		}
+		if result.Admin {
+			// 超级管理员短路放行，不命中任何策略
+			policy = nil
+		}
+		casbinAllowed = casbinAllowed || ok
+		result.Codes = append(result.Codes, &CodeExplain{
+			PermCode: code,
+			Allowed:  ok,
+			Policy:   policy,
+			Grants:   uc.policy.ScopeGrants(ctx, tenantID, userID, []string{code}, attr),
+		})
+	}
+	result.Allowed = result.Package.Allowed && casbinAllowed
+	return result, nil
+}
+
+func (uc *AuthzUseCase) reload(ctx context.Context, topics []string) error {
+	for _, topic := range topics {
+		var err error
+		switch topic {
+		case TopicPolicy:
+			err = uc.policy.ReloadPolicy(ctx)
+		case TopicPermission:
+			// 不经 Data 层缓存，直接读取变更后的数据
+			err = uc.permissions.Load(WithPrimary(ctx))
+		case TopicPackage:
+			err = uc.packages.Load(ctx)
+		case TopicTenant:
+			err = uc.tenants.Load(ctx)
+		case TopicDataScope:
+			uc.scopes.Invalidate()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package biz
 import (
 	"context"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/wire"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/email"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/oss"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/sms"
@@ -22,13 +24,52 @@ var ProviderSet = wire.NewSet(
 	// providers
 	provider.NewPermissionProvider,
 	provider.NewPackageProvider,
+	provider.NewTenantProvider,
+	provider.NewDataScopeProvider,
+	provider.NewConfigProvider,
 	// domains
 	NewChatUseCase,
 	NewPassportUseCase,
 	NewUploadUseCase,
+	NewTenantUseCase,
+	NewRoleUseCase,
+	NewAuthzUseCase,
+	NewPermissionUseCase,
+	NewOperLogUseCase,
+	NewUserJobUseCase,
+	NewRecycleUseCase,
+	NewDictUseCase,
+	NewConfigUseCase,
+	NewNoticeUseCase,
+	NewSeedUseCase,
+	NewAdminUseCase,
 )
 
-// Transaction 事务接口
-type Transaction interface {
-	InTx(context.Context, func(ctx context.Context) error) error
+// ErrVersionConflict 乐观锁校验失败，数据已被他人修改
+var ErrVersionConflict = kerrors.Conflict("VERSION_CONFLICT", "数据已被他人修改，请刷新后重试")
+
+// globalTenantID 字典、系统参数等全局数据的租户 ID，由平台租户维护，其他租户可按需覆盖
+const globalTenantID = int64(0)
+
+// ownerTenantID 当前租户维护的可覆盖数据所属租户，平台租户维护全局数据
+func ownerTenantID(ctx context.Context) int64 {
+	tenantID := auth.GetTenantID(ctx)
+	if tenantID == systemTenantID {
+		return globalTenantID
+	}
+	return tenantID
+}
+
+type primaryKey struct{}
+
+// WithPrimary 之后的读取使用主库，用于写入后立即读取的场景（副本存在复制延迟）
+// 事务内的语句总是使用主库，无需标记
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// IsPrimary 是否强制读取主库
+func IsPrimary(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
 }
@@ -2,10 +2,12 @@ package biz
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/mojocn/base64Captcha"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/debug"
 )
 
@@ -61,7 +63,7 @@ func (uc *CaptchaUseCase) Generate(ctx context.Context) (id, b64 string, err err
 	//	nil,                               // 自定义字体路径
 	//)
 
-	cp := base64Captcha.NewCaptcha(driver, uc.store)
+	cp := base64Captcha.NewCaptcha(driver, uc.tenantStore(ctx))
 
 	id, b64, answer, err := cp.Generate()
 	if err != nil {
@@ -85,8 +87,33 @@ func (uc *CaptchaUseCase) Verify(ctx context.Context, id, answer string) error {
 	}
 
 	// 校验并自动删除（防止重放攻击）
-	if !uc.store.Verify(id, answer, true) {
+	if !uc.tenantStore(ctx).Verify(id, answer, true) {
 		return ErrorImageCaptchaVerifyFailed
 	}
 	return nil
 }
+
+// tenantStore 按租户隔离验证码，防止跨租户使用
+func (uc *CaptchaUseCase) tenantStore(ctx context.Context) base64Captcha.Store {
+	return &tenantCaptchaStore{
+		Store:  uc.store,
+		prefix: strconv.FormatInt(auth.GetTenantID(ctx), 10) + ":",
+	}
+}
+
+type tenantCaptchaStore struct {
+	base64Captcha.Store
+	prefix string
+}
+
+func (s *tenantCaptchaStore) Set(id string, value string) error {
+	return s.Store.Set(s.prefix+id, value)
+}
+
+func (s *tenantCaptchaStore) Get(id string, clear bool) string {
+	return s.Store.Get(s.prefix+id, clear)
+}
+
+func (s *tenantCaptchaStore) Verify(id, answer string, clear bool) bool {
+	return s.Store.Verify(s.prefix+id, answer, clear)
+}
@@ -0,0 +1,264 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrConfigNotFound     = kerrors.NotFound("CONFIG_NOT_FOUND", "系统参数不存在")
+	ErrConfigExists       = kerrors.BadRequest("CONFIG_EXISTS", "参数键已存在")
+	ErrConfigUndefined    = kerrors.BadRequest("CONFIG_UNDEFINED", "参数未定义，租户只能覆盖平台已定义的参数")
+	ErrConfigInUse        = kerrors.BadRequest("CONFIG_IN_USE", "参数已被租户覆盖，请先删除租户参数")
+	ErrConfigValueInvalid = kerrors.BadRequest("CONFIG_VALUE_INVALID", "参数值与类型不匹配")
+)
+
+// 可在系统参数中覆盖 config.yaml 的配置项，值为 JSON 对象（key 为场景），场景字段与 config.yaml 一致，如：
+//
+//	{"login": {"expires_in": "300s", "resend_interval": "60s", "template_name": "otp_login", "code_length": 6}}
+const (
+	ConfigKeyOtpPhoneScenes = "otp.phone_scenes"
+	ConfigKeyOtpEmailScenes = "otp.email_scenes"
+	ConfigKeyUploadScenes   = "upload.scenes"
+)
+
+// 系统参数变更操作
+const (
+	ConfigActionCreate = "create"
+	ConfigActionUpdate = "update"
+	ConfigActionDelete = "delete"
+)
+
+type SysConfig struct {
+	ID        int64
+	TenantID  int64 // 0 表示全局参数
+	Key       string
+	Name      string
+	ValueType string // provider.ConfigType*
+	Value     string
+	Remark    string
+	UpdatedBy int64
+	UpdatedAt time.Time
+	Version   int64
+}
+
+// ConfigLog 系统参数变更记录
+type ConfigLog struct {
+	ID        int64
+	TenantID  int64
+	Key       string
+	Action    string
+	OldValue  string
+	NewValue  string
+	CreatedBy int64
+	CreatedAt time.Time
+}
+
+type ConfigRepo interface {
+	// ListConfigs 分页查询指定租户（含全局）的参数，keyword 模糊匹配参数键或名称
+	ListConfigs(ctx context.Context, tenantID int64, keyword string, page, pageSize int) ([]*SysConfig, int64, error)
+	GetConfig(ctx context.Context, id int64) (*SysConfig, error)
+	// FindConfig 按租户和参数键查询，不存在时返回 ErrConfigNotFound
+	FindConfig(ctx context.Context, tenantID int64, key string) (*SysConfig, error)
+	// CountOverrides 统计覆盖全局参数的租户参数
+	CountOverrides(ctx context.Context, key string) (int64, error)
+	CreateConfig(ctx context.Context, c *SysConfig) error
+	// UpdateConfig 修改参数（参数键和类型不可修改），c.Version 不为 0 时校验版本
+	UpdateConfig(ctx context.Context, c *SysConfig) error
+	DeleteConfig(ctx context.Context, id int64) error
+
+	CreateConfigLog(ctx context.Context, l *ConfigLog) error
+	// ListConfigLogs 分页查询租户的参数变更记录，key 为空时查询全部参数
+	ListConfigLogs(ctx context.Context, tenantID int64, key string, page, pageSize int) ([]*ConfigLog, int64, error)
+}
+
+// ConfigUseCase 系统参数维护：平台租户定义全局参数，其他租户可覆盖全局参数的值
+// 变更后刷新本节点的 ConfigProvider 并广播 TopicConfig，其他节点收到后重新加载
+type ConfigUseCase struct {
+	repo     ConfigRepo
+	tx       Transaction
+	configs  *provider.ConfigProvider
+	notifier AuthzNotifier
+	log      *log.Helper
+}
+
+func NewConfigUseCase(repo ConfigRepo, tx Transaction, configs *provider.ConfigProvider, notifier AuthzNotifier, logger log.Logger) *ConfigUseCase {
+	uc := &ConfigUseCase{
+		repo:     repo,
+		tx:       tx,
+		configs:  configs,
+		notifier: notifier,
+		log:      log.NewHelper(logger),
+	}
+	notifier.Subscribe(func(ctx context.Context, topics []string) {
+		if !slices.Contains(topics, TopicConfig) {
+			return
+		}
+		if err := configs.Load(ctx); err != nil {
+			uc.log.Errorf("reload system configs failed: %v", err)
+		}
+	})
+	return uc
+}
+
+// List 分页查询当前租户可见的参数（全局参数及本租户参数）
+func (uc *ConfigUseCase) List(ctx context.Context, keyword string, page, pageSize int) ([]*SysConfig, int64, error) {
+	return uc.repo.ListConfigs(ctx, ownerTenantID(ctx), keyword, page, pageSize)
+}
+
+// Create 平台租户定义全局参数；其他租户覆盖已定义的全局参数，类型沿用全局参数
+func (uc *ConfigUseCase) Create(ctx context.Context, c *SysConfig) error {
+	c.TenantID = ownerTenantID(ctx)
+	if c.TenantID != globalTenantID {
+		global, err := uc.repo.FindConfig(ctx, globalTenantID, c.Key)
+		if err != nil {
+			if kerrors.Is(err, ErrConfigNotFound) {
+				return ErrConfigUndefined
+			}
+			return err
+		}
+		c.ValueType = global.ValueType
+		if c.Name == "" {
+			c.Name = global.Name
+		}
+	}
+	if c.Name == "" {
+		c.Name = c.Key
+	}
+	if err := provider.ValidateConfigValue(c.ValueType, c.Value); err != nil {
+		return ErrConfigValueInvalid
+	}
+	if _, err := uc.repo.FindConfig(ctx, c.TenantID, c.Key); err == nil {
+		return ErrConfigExists
+	} else if !kerrors.Is(err, ErrConfigNotFound) {
+		return err
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.CreateConfig(ctx, c); err != nil {
+			return err
+		}
+		return uc.writeLog(ctx, c.TenantID, c.Key, ConfigActionCreate, "", c.Value)
+	})
+	if err != nil {
+		return err
+	}
+	return uc.invalidate(ctx)
+}
+
+// Update 修改本租户的参数（平台租户修改全局参数），返回修改后的版本号
+func (uc *ConfigUseCase) Update(ctx context.Context, c *SysConfig) (int64, error) {
+	old, err := uc.own(ctx, c.ID)
+	if err != nil {
+		return 0, err
+	}
+	if err := provider.ValidateConfigValue(old.ValueType, c.Value); err != nil {
+		return 0, ErrConfigValueInvalid
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdateConfig(ctx, c); err != nil {
+			return err
+		}
+		if c.Value == old.Value {
+			return nil
+		}
+		return uc.writeLog(ctx, old.TenantID, old.Key, ConfigActionUpdate, old.Value, c.Value)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return c.Version, uc.invalidate(ctx)
+}
+
+// Delete 删除本租户的参数（平台租户删除全局参数），全局参数被租户覆盖时不允许删除
+func (uc *ConfigUseCase) Delete(ctx context.Context, id int64) error {
+	old, err := uc.own(ctx, id)
+	if err != nil {
+		return err
+	}
+	if old.TenantID == globalTenantID {
+		n, err := uc.repo.CountOverrides(ctx, old.Key)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return ErrConfigInUse
+		}
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.DeleteConfig(ctx, id); err != nil {
+			return err
+		}
+		return uc.writeLog(ctx, old.TenantID, old.Key, ConfigActionDelete, old.Value, "")
+	})
+	if err != nil {
+		return err
+	}
+	return uc.invalidate(ctx)
+}
+
+// ListLogs 分页查询本租户（平台租户为全局参数）的变更记录
+func (uc *ConfigUseCase) ListLogs(ctx context.Context, key string, page, pageSize int) ([]*ConfigLog, int64, error) {
+	return uc.repo.ListConfigLogs(ctx, ownerTenantID(ctx), key, page, pageSize)
+}
+
+// own 查询当前租户可维护的参数，其他租户（含全局）的参数视为不存在
+func (uc *ConfigUseCase) own(ctx context.Context, id int64) (*SysConfig, error) {
+	c, err := uc.repo.GetConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.TenantID != ownerTenantID(ctx) {
+		return nil, ErrConfigNotFound
+	}
+	return c, nil
+}
+
+func (uc *ConfigUseCase) writeLog(ctx context.Context, tenantID int64, key, action, oldValue, newValue string) error {
+	return uc.repo.CreateConfigLog(ctx, &ConfigLog{
+		TenantID:  tenantID,
+		Key:       key,
+		Action:    action,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		CreatedBy: auth.GetUserID(ctx),
+	})
+}
+
+// invalidate 刷新本节点参数快照并通知其他节点
+func (uc *ConfigUseCase) invalidate(ctx context.Context) error {
+	if err := uc.configs.Load(ctx); err != nil {
+		return err
+	}
+	return uc.notifier.Publish(ctx, TopicConfig)
+}
+
+// lookupScene 从系统参数读取场景配置，参数未配置该场景时返回 false，由调用方回退到 config.yaml
+func lookupScene[T proto.Message](ctx context.Context, configs *provider.ConfigProvider, key, scene string, newScene func() T) (T, bool, error) {
+	var zero T
+	var scenes map[string]json.RawMessage
+	ok, err := configs.JSON(ctx, key, &scenes)
+	if err != nil || !ok {
+		return zero, false, err
+	}
+	raw, ok := scenes[scene]
+	if !ok {
+		return zero, false, nil
+	}
+	v := newScene()
+	if err := protojson.Unmarshal(raw, v); err != nil {
+		return zero, false, err
+	}
+	return v, true, nil
+}
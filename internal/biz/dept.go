@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+type SysDept struct {
+	ID       int64
+	ParentID int64
+	Name     string
+}
+
+type DeptRepo interface {
+	// ListDepts 查询当前租户的全部部门（不受数据范围限制，用于拼接部门路径）
+	ListDepts(ctx context.Context) ([]*SysDept, error)
+	// ListScopedDeptIDs 查询当前用户数据范围内可访问的部门
+	ListScopedDeptIDs(ctx context.Context) ([]int64, error)
+}
+
+// deptPathSep 部门名称路径分隔符，如 “总经办/研发部/后端组”
+const deptPathSep = "/"
+
+// deptPaths 计算每个部门从根部门开始的名称路径
+func deptPaths(depts []*SysDept) map[int64]string {
+	byID := make(map[int64]*SysDept, len(depts))
+	for _, d := range depts {
+		byID[d.ID] = d
+	}
+	paths := make(map[int64]string, len(depts))
+	var resolve func(d *SysDept, depth int) string
+	resolve = func(d *SysDept, depth int) string {
+		if p, ok := paths[d.ID]; ok {
+			return p
+		}
+		p := d.Name
+		// 深度限制防止脏数据中的循环引用
+		if parent, ok := byID[d.ParentID]; ok && depth < len(depts) {
+			p = resolve(parent, depth+1) + deptPathSep + d.Name
+		}
+		paths[d.ID] = p
+		return p
+	}
+	for _, d := range depts {
+		resolve(d, 0)
+	}
+	return paths
+}
+
+// normalizeDeptPath 去除路径各级名称两端空白，兼容全角分隔符
+func normalizeDeptPath(path string) string {
+	parts := strings.Split(strings.ReplaceAll(path, "／", deptPathSep), deptPathSep)
+	names := parts[:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			names = append(names, p)
+		}
+	}
+	return strings.Join(names, deptPathSep)
+}
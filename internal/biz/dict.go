@@ -0,0 +1,336 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+var (
+	ErrDictTypeNotFound = kerrors.NotFound("DICT_TYPE_NOT_FOUND", "字典类型不存在")
+	ErrDictTypeExists   = kerrors.BadRequest("DICT_TYPE_EXISTS", "字典类型编码已存在")
+	ErrDictTypeInUse    = kerrors.BadRequest("DICT_TYPE_IN_USE", "字典类型下存在字典项，请先删除字典项")
+	ErrDictItemNotFound = kerrors.NotFound("DICT_ITEM_NOT_FOUND", "字典项不存在")
+	ErrDictItemExists   = kerrors.BadRequest("DICT_ITEM_EXISTS", "字典值已存在")
+	ErrDictForbidden    = kerrors.Forbidden("DICT_FORBIDDEN", "仅平台租户可维护字典类型")
+	ErrDictValueInvalid = kerrors.BadRequest("DICT_VALUE_INVALID", "字典值不存在或已停用")
+)
+
+type DictType struct {
+	ID        int64
+	Code      string
+	Name      string
+	Remark    string
+	Enabled   bool
+	Version   int64
+	UpdatedAt time.Time
+}
+
+type DictItem struct {
+	ID       int64
+	TenantID int64 // 0 表示全局字典项
+	TypeCode string
+	Label    string
+	Value    string
+	Sort     int32
+	Color    string
+	Enabled  bool
+	Remark   string
+	Version  int64
+}
+
+type DictRepo interface {
+	// ListDictTypes 分页查询字典类型，keyword 模糊匹配编码或名称
+	ListDictTypes(ctx context.Context, keyword string, page, pageSize int) ([]*DictType, int64, error)
+	GetDictType(ctx context.Context, id int64) (*DictType, error)
+	ExistsDictType(ctx context.Context, code string) (bool, error)
+	CreateDictType(ctx context.Context, t *DictType) error
+	// UpdateDictType 修改字典类型（编码不可修改），t.Version 不为 0 时校验版本
+	UpdateDictType(ctx context.Context, t *DictType) error
+	DeleteDictType(ctx context.Context, id int64) error
+	// CountDictItems 统计字典类型下所有租户的字典项
+	CountDictItems(ctx context.Context, typeCode string) (int64, error)
+
+	// ListDictItems 查询指定租户（含全局）的字典项，按排序号升序
+	ListDictItems(ctx context.Context, tenantID int64, typeCode string) ([]*DictItem, error)
+	// ListEnabledDictItems 查询已启用字典类型下指定租户（含全局）的字典项
+	ListEnabledDictItems(ctx context.Context, tenantID int64, typeCodes []string) ([]*DictItem, error)
+	GetDictItem(ctx context.Context, id int64) (*DictItem, error)
+	// ExistsDictItem 同一租户、同一类型下字典值是否已存在，excludeID 为修改时排除的自身 ID
+	ExistsDictItem(ctx context.Context, tenantID int64, typeCode, value string, excludeID int64) (bool, error)
+	CreateDictItem(ctx context.Context, item *DictItem) error
+	// UpdateDictItem 修改字典项，item.Version 不为 0 时校验版本
+	UpdateDictItem(ctx context.Context, item *DictItem) error
+	DeleteDictItem(ctx context.Context, id int64) error
+}
+
+// DictCache 租户生效的字典缓存，由 Data 层基于 Redis 实现
+type DictCache interface {
+	// GetDicts 批量读取缓存，未命中的类型不在返回结果中
+	GetDicts(ctx context.Context, tenantID int64, typeCodes []string) (map[string][]*DictItem, error)
+	SetDicts(ctx context.Context, tenantID int64, dicts map[string][]*DictItem) error
+	// DeleteDicts 删除所有租户中指定类型的缓存
+	DeleteDicts(ctx context.Context, typeCode string) error
+}
+
+type DictUseCase struct {
+	repo  DictRepo
+	cache DictCache
+	log   *log.Helper
+}
+
+func NewDictUseCase(repo DictRepo, cache DictCache, logger log.Logger) *DictUseCase {
+	return &DictUseCase{
+		repo:  repo,
+		cache: cache,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// ListTypes 分页查询字典类型
+func (uc *DictUseCase) ListTypes(ctx context.Context, keyword string, page, pageSize int) ([]*DictType, int64, error) {
+	return uc.repo.ListDictTypes(ctx, keyword, page, pageSize)
+}
+
+// CreateType 创建字典类型
+func (uc *DictUseCase) CreateType(ctx context.Context, t *DictType) error {
+	if auth.GetTenantID(ctx) != systemTenantID {
+		return ErrDictForbidden
+	}
+	exists, err := uc.repo.ExistsDictType(ctx, t.Code)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrDictTypeExists
+	}
+	return uc.repo.CreateDictType(ctx, t)
+}
+
+// UpdateType 修改字典类型，返回修改后的版本号
+func (uc *DictUseCase) UpdateType(ctx context.Context, t *DictType) (int64, error) {
+	if auth.GetTenantID(ctx) != systemTenantID {
+		return 0, ErrDictForbidden
+	}
+	old, err := uc.repo.GetDictType(ctx, t.ID)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.repo.UpdateDictType(ctx, t); err != nil {
+		return 0, err
+	}
+	return t.Version, uc.cache.DeleteDicts(ctx, old.Code)
+}
+
+// DeleteType 删除字典类型，类型下存在字典项时不允许删除
+func (uc *DictUseCase) DeleteType(ctx context.Context, id int64) error {
+	if auth.GetTenantID(ctx) != systemTenantID {
+		return ErrDictForbidden
+	}
+	t, err := uc.repo.GetDictType(ctx, id)
+	if err != nil {
+		return err
+	}
+	n, err := uc.repo.CountDictItems(ctx, t.Code)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return ErrDictTypeInUse
+	}
+	if err := uc.repo.DeleteDictType(ctx, id); err != nil {
+		return err
+	}
+	return uc.cache.DeleteDicts(ctx, t.Code)
+}
+
+// ListItems 查询当前租户可见的字典项（全局字典项及本租户字典项）
+func (uc *DictUseCase) ListItems(ctx context.Context, typeCode string) ([]*DictItem, error) {
+	return uc.repo.ListDictItems(ctx, ownerTenantID(ctx), typeCode)
+}
+
+// CreateItem 创建字典项：平台租户创建全局字典项，其他租户创建本租户字典项（值相同时覆盖全局字典项）
+func (uc *DictUseCase) CreateItem(ctx context.Context, item *DictItem) error {
+	exists, err := uc.repo.ExistsDictType(ctx, item.TypeCode)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrDictTypeNotFound
+	}
+	item.TenantID = ownerTenantID(ctx)
+	if err := uc.checkItemValue(ctx, item, 0); err != nil {
+		return err
+	}
+	if err := uc.repo.CreateDictItem(ctx, item); err != nil {
+		return err
+	}
+	return uc.cache.DeleteDicts(ctx, item.TypeCode)
+}
+
+// UpdateItem 修改本租户的字典项（平台租户修改全局字典项），返回修改后的版本号
+func (uc *DictUseCase) UpdateItem(ctx context.Context, item *DictItem) (int64, error) {
+	old, err := uc.ownItem(ctx, item.ID)
+	if err != nil {
+		return 0, err
+	}
+	item.TenantID = old.TenantID
+	item.TypeCode = old.TypeCode
+	if item.Value != old.Value {
+		if err := uc.checkItemValue(ctx, item, item.ID); err != nil {
+			return 0, err
+		}
+	}
+	if err := uc.repo.UpdateDictItem(ctx, item); err != nil {
+		return 0, err
+	}
+	return item.Version, uc.cache.DeleteDicts(ctx, old.TypeCode)
+}
+
+// DeleteItem 删除本租户的字典项（平台租户删除全局字典项）
+func (uc *DictUseCase) DeleteItem(ctx context.Context, id int64) error {
+	old, err := uc.ownItem(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteDictItem(ctx, id); err != nil {
+		return err
+	}
+	return uc.cache.DeleteDicts(ctx, old.TypeCode)
+}
+
+// GetDicts 批量获取当前租户生效的字典（已启用，租户字典项覆盖全局字典项），不存在的类型返回空列表
+func (uc *DictUseCase) GetDicts(ctx context.Context, typeCodes []string) (map[string][]*DictItem, error) {
+	tenantID := auth.GetTenantID(ctx)
+	dicts, err := uc.cache.GetDicts(ctx, tenantID, typeCodes)
+	if err != nil {
+		// 缓存不可用时回源数据库
+		uc.log.Warnf("get dicts from cache failed: %v", err)
+		dicts = make(map[string][]*DictItem, len(typeCodes))
+	}
+	var missing []string
+	for _, code := range typeCodes {
+		if _, ok := dicts[code]; !ok {
+			missing = append(missing, code)
+		}
+	}
+	if len(missing) == 0 {
+		return dicts, nil
+	}
+
+	items, err := uc.repo.ListEnabledDictItems(ctx, tenantID, missing)
+	if err != nil {
+		return nil, err
+	}
+	loaded := mergeDictItems(items, missing)
+	if err := uc.cache.SetDicts(ctx, tenantID, loaded); err != nil {
+		uc.log.Warnf("set dicts cache failed: %v", err)
+	}
+	for code, list := range loaded {
+		dicts[code] = list
+	}
+	return dicts, nil
+}
+
+// Contains 字典值是否存在于当前租户生效的字典中
+func (uc *DictUseCase) Contains(ctx context.Context, typeCode, value string) (bool, error) {
+	item, err := uc.find(ctx, typeCode, value)
+	return item != nil, err
+}
+
+// Check 校验字典值，供业务代码校验枚举类字段，如：
+//
+//	if err := dict.Check(ctx, "sys_user_status", status); err != nil { return err }
+func (uc *DictUseCase) Check(ctx context.Context, typeCode, value string) error {
+	ok, err := uc.Contains(ctx, typeCode, value)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrDictValueInvalid.WithMetadata(map[string]string{"type": typeCode, "value": value})
+	}
+	return nil
+}
+
+// Label 字典值的显示文本，不存在时返回字典值本身
+func (uc *DictUseCase) Label(ctx context.Context, typeCode, value string) string {
+	item, err := uc.find(ctx, typeCode, value)
+	if err != nil || item == nil {
+		return value
+	}
+	return item.Label
+}
+
+func (uc *DictUseCase) find(ctx context.Context, typeCode, value string) (*DictItem, error) {
+	dicts, err := uc.GetDicts(ctx, []string{typeCode})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range dicts[typeCode] {
+		if item.Value == value {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+// ownItem 查询当前租户可维护的字典项，其他租户（含全局）的字典项视为不存在
+func (uc *DictUseCase) ownItem(ctx context.Context, id int64) (*DictItem, error) {
+	item, err := uc.repo.GetDictItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.TenantID != ownerTenantID(ctx) {
+		return nil, ErrDictItemNotFound
+	}
+	return item, nil
+}
+
+func (uc *DictUseCase) checkItemValue(ctx context.Context, item *DictItem, excludeID int64) error {
+	exists, err := uc.repo.ExistsDictItem(ctx, item.TenantID, item.TypeCode, item.Value, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrDictItemExists
+	}
+	return nil
+}
+
+// mergeDictItems 租户字典项按值覆盖全局字典项，停用的字典项不返回
+func mergeDictItems(items []*DictItem, typeCodes []string) map[string][]*DictItem {
+	effective := make(map[string]map[string]*DictItem, len(typeCodes))
+	for _, item := range items {
+		byValue, ok := effective[item.TypeCode]
+		if !ok {
+			byValue = make(map[string]*DictItem)
+			effective[item.TypeCode] = byValue
+		}
+		if old, ok := byValue[item.Value]; ok && old.TenantID != globalTenantID {
+			continue
+		}
+		byValue[item.Value] = item
+	}
+
+	dicts := make(map[string][]*DictItem, len(typeCodes))
+	for _, code := range typeCodes {
+		list := make([]*DictItem, 0, len(effective[code]))
+		for _, item := range effective[code] {
+			if item.Enabled {
+				list = append(list, item)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Sort != list[j].Sort {
+				return list[i].Sort < list[j].Sort
+			}
+			return list[i].ID < list[j].ID
+		})
+		dicts[code] = list
+	}
+	return dicts
+}
@@ -0,0 +1,269 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/ws"
+)
+
+// 通知级别
+const (
+	NoticeLevelInfo    = "info"
+	NoticeLevelWarning = "warning"
+	NoticeLevelUrgent  = "urgent"
+)
+
+// 通知接收范围
+const (
+	NoticeTargetAll    = "all"    // 所有租户的用户，仅平台租户可发布
+	NoticeTargetTenant = "tenant" // 本租户的用户
+	NoticeTargetDept   = "dept"   // 指定部门（含下级部门）的用户
+	NoticeTargetUser   = "user"   // 本租户的指定用户
+)
+
+// 离线通知渠道
+const (
+	NotifyChannelEmail = "email"
+	NotifyChannelSms   = "sms"
+)
+
+const (
+	// 在线推送的 WebSocket 消息类型
+	noticeAction = "notify"
+	// 离线通知的邮件及短信模板，参数为 title、content、level
+	noticeTemplate = "notice"
+)
+
+var (
+	ErrNoticeForbidden     = kerrors.Forbidden("NOTICE_FORBIDDEN", "仅平台租户可发布全局公告")
+	ErrNoticeTargetInvalid = kerrors.BadRequest("NOTICE_TARGET_INVALID", "请指定接收部门或用户")
+	ErrNoticeNoRecipient   = kerrors.BadRequest("NOTICE_NO_RECIPIENT", "没有符合条件的接收人")
+)
+
+type Notice struct {
+	ID         int64
+	TenantID   int64 // 0 表示全局公告
+	Title      string
+	Content    string
+	Level      string
+	TargetType string
+	TargetIDs  []int64 // 接收部门或用户
+	CreatedBy  int64
+	CreatedAt  time.Time
+}
+
+// UserNotice 用户收件箱中的通知
+type UserNotice struct {
+	Notice
+	ReadAt *time.Time // 为空表示未读
+}
+
+// NoticeRecipient 通知接收人及离线通知方式
+type NoticeRecipient struct {
+	UserID   int64
+	TenantID int64
+	Phone    string
+	Email    string
+	Channels []string
+}
+
+type NoticeRepo interface {
+	CreateNotice(ctx context.Context, n *Notice) error
+	// ListRecipients 按接收范围查询启用状态的用户，不受数据范围限制
+	ListRecipients(ctx context.Context, n *Notice) ([]*NoticeRecipient, error)
+	// CreateInboxes 为接收人写入未读的收件箱记录
+	CreateInboxes(ctx context.Context, noticeID int64, recipients []*NoticeRecipient) error
+
+	// ListUserNotices 按发布时间倒序分页查询用户收件箱
+	ListUserNotices(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*UserNotice, int64, error)
+	// CountUnread 按通知级别统计用户的未读通知
+	CountUnread(ctx context.Context, userID int64) (map[string]int64, error)
+	// MarkRead 标记用户的通知为已读，noticeIDs 为空时标记全部
+	MarkRead(ctx context.Context, userID int64, noticeIDs []int64) error
+
+	GetNotifyChannels(ctx context.Context, userID int64) ([]string, error)
+	UpdateNotifyChannels(ctx context.Context, userID int64, channels []string) error
+}
+
+// NoticePusher 在线推送，由 WebSocket 连接中心实现
+type NoticePusher interface {
+	IsOnline(uid string) bool
+	SendToUser(uid string, msg []byte)
+}
+
+// NoticePush 推送给在线用户的通知内容
+type NoticePush struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Level     string `json:"level"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// NoticeUseCase 通知公告：发布时按接收范围写入收件箱，在线用户通过 WebSocket 推送，
+// 离线用户按个人设置改发邮件或短信
+type NoticeUseCase struct {
+	repo   NoticeRepo
+	tx     Transaction
+	pusher NoticePusher
+	sms    SmsSender
+	email  EmailSender
+	log    *log.Helper
+}
+
+func NewNoticeUseCase(repo NoticeRepo, tx Transaction, pusher NoticePusher, sms SmsSender, email EmailSender, logger log.Logger) *NoticeUseCase {
+	return &NoticeUseCase{
+		repo:   repo,
+		tx:     tx,
+		pusher: pusher,
+		sms:    sms,
+		email:  email,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Create 发布通知，返回接收人数；投递在后台进行，不影响发布结果
+func (uc *NoticeUseCase) Create(ctx context.Context, n *Notice) (int, error) {
+	n.TenantID = auth.GetTenantID(ctx)
+	if n.Level == "" {
+		n.Level = NoticeLevelInfo
+	}
+	switch n.TargetType {
+	case NoticeTargetAll:
+		if n.TenantID != systemTenantID {
+			return 0, ErrNoticeForbidden
+		}
+		n.TenantID = globalTenantID
+		n.TargetIDs = nil
+	case NoticeTargetTenant:
+		n.TargetIDs = nil
+	case NoticeTargetDept, NoticeTargetUser:
+		if len(n.TargetIDs) == 0 {
+			return 0, ErrNoticeTargetInvalid
+		}
+	}
+	n.CreatedBy = auth.GetUserID(ctx)
+
+	recipients, err := uc.repo.ListRecipients(ctx, n)
+	if err != nil {
+		return 0, err
+	}
+	if len(recipients) == 0 {
+		return 0, ErrNoticeNoRecipient
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.CreateNotice(ctx, n); err != nil {
+			return err
+		}
+		if err := uc.repo.CreateInboxes(ctx, n.ID, recipients); err != nil {
+			return err
+		}
+		// 调用方处于更外层的事务时，等该事务提交后再推送
+		AfterCommit(ctx, func(ctx context.Context) {
+			go uc.deliver(context.WithoutCancel(ctx), n, recipients)
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(recipients), nil
+}
+
+// deliver 推送给在线用户，离线用户按个人设置发送邮件或短信
+// 在线状态以本节点的连接为准，连接在其他节点的用户按离线处理
+func (uc *NoticeUseCase) deliver(ctx context.Context, n *Notice, recipients []*NoticeRecipient) {
+	defer func() {
+		if r := recover(); r != nil {
+			uc.log.Errorf("deliver notice %d panic: %v", n.ID, r)
+		}
+	}()
+
+	msg := ws.NewMessage(noticeAction, &NoticePush{
+		ID:        n.ID,
+		Title:     n.Title,
+		Content:   n.Content,
+		Level:     n.Level,
+		CreatedAt: n.CreatedAt.Unix(),
+	})
+	params := map[string]string{
+		"title":   n.Title,
+		"content": n.Content,
+		"level":   n.Level,
+	}
+	for _, r := range recipients {
+		uid := strconv.FormatInt(r.UserID, 10)
+		if uc.pusher.IsOnline(uid) {
+			uc.pusher.SendToUser(uid, msg)
+			continue
+		}
+		if slices.Contains(r.Channels, NotifyChannelEmail) && r.Email != "" {
+			if err := uc.email.Send(ctx, r.Email, noticeTemplate, params); err != nil {
+				uc.log.Errorf("send notice %d to %s failed: %v", n.ID, r.Email, err)
+			}
+		}
+		if slices.Contains(r.Channels, NotifyChannelSms) && r.Phone != "" {
+			if err := uc.sms.Send(ctx, r.Phone, noticeTemplate, params); err != nil {
+				uc.log.Errorf("send notice %d to %s failed: %v", n.ID, r.Phone, err)
+			}
+		}
+	}
+}
+
+// ListMine 分页查询当前用户的通知
+func (uc *NoticeUseCase) ListMine(ctx context.Context, unreadOnly bool, page, pageSize int) ([]*UserNotice, int64, error) {
+	return uc.repo.ListUserNotices(ctx, auth.GetUserID(ctx), unreadOnly, page, pageSize)
+}
+
+// UnreadCount 当前用户的未读通知数，返回总数及各级别的数量
+func (uc *NoticeUseCase) UnreadCount(ctx context.Context) (int64, map[string]int64, error) {
+	levels, err := uc.repo.CountUnread(ctx, auth.GetUserID(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	var total int64
+	for _, n := range levels {
+		total += n
+	}
+	return total, levels, nil
+}
+
+// MarkRead 标记当前用户的通知为已读
+func (uc *NoticeUseCase) MarkRead(ctx context.Context, noticeIDs []int64) error {
+	if len(noticeIDs) == 0 {
+		return nil
+	}
+	return uc.repo.MarkRead(ctx, auth.GetUserID(ctx), noticeIDs)
+}
+
+// MarkAllRead 标记当前用户的全部通知为已读
+func (uc *NoticeUseCase) MarkAllRead(ctx context.Context) error {
+	return uc.repo.MarkRead(ctx, auth.GetUserID(ctx), nil)
+}
+
+// GetNotifyChannels 当前用户的离线通知渠道
+func (uc *NoticeUseCase) GetNotifyChannels(ctx context.Context) ([]string, error) {
+	return uc.repo.GetNotifyChannels(ctx, auth.GetUserID(ctx))
+}
+
+// UpdateNotifyChannels 设置当前用户的离线通知渠道，为空表示仅站内通知
+func (uc *NoticeUseCase) UpdateNotifyChannels(ctx context.Context, channels []string) error {
+	result := make([]string, 0, len(channels))
+	for _, c := range channels {
+		if c != NotifyChannelEmail && c != NotifyChannelSms {
+			return kerrors.BadRequest("NOTIFY_CHANNEL_INVALID", fmt.Sprintf("不支持的通知渠道: %s", c))
+		}
+		if !slices.Contains(result, c) {
+			result = append(result, c)
+		}
+	}
+	return uc.repo.UpdateNotifyChannels(ctx, auth.GetUserID(ctx), result)
+}
@@ -0,0 +1,203 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/oss"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/sheet"
+)
+
+// 单次导出的最大条数
+const maxOperLogExport = 50000
+
+var (
+	ErrOperLogVerifyForbidden = kerrors.Forbidden("OPER_LOG_VERIFY_FORBIDDEN", "仅平台租户可校验操作日志")
+	ErrOperLogExportTooLarge  = kerrors.BadRequest("OPER_LOG_EXPORT_TOO_LARGE", "导出数据过多，请缩小时间范围")
+	ErrOperLogExportFailed    = kerrors.InternalServer("OPER_LOG_EXPORT_FAILED", "导出失败")
+)
+
+// 操作结果筛选
+const (
+	OperLogStatusAll     = 0
+	OperLogStatusSuccess = 1
+	OperLogStatusFailed  = 2
+)
+
+type OperLog struct {
+	ID        int64
+	TenantID  int64
+	UserID    int64
+	DeptID    int64
+	IP        string
+	Operation string
+	Name      string
+	PermCode  string
+	Method    string
+	Path      string
+	Request   string
+	Code      int32
+	Reason    string
+	Latency   int64 // 毫秒
+	Diff      string
+	CreatedAt time.Time
+}
+
+type OperLogFilter struct {
+	UserID    int64
+	Operation string // 模糊匹配 Operation 或操作名称
+	Status    int32
+	Start     time.Time // 为零值时不限
+	End       time.Time
+}
+
+// OperLogChain 单个节点的哈希链校验结果
+type OperLogChain struct {
+	Node     string
+	Checked  int64 // 已校验条数
+	BrokenID int64 // 第一条校验失败的日志 ID，0 表示完整
+	Missing  int64 // 链尾缺失的条数（已登记写入但不存在）
+}
+
+type OperLogRepo interface {
+	// ListOperLogs 分页查询当前租户（及数据范围）内的操作日志
+	ListOperLogs(ctx context.Context, filter *OperLogFilter, page, pageSize int) ([]*OperLog, int64, error)
+	// CountOperLogs 统计符合条件的操作日志
+	CountOperLogs(ctx context.Context, filter *OperLogFilter) (int64, error)
+	// FindOperLogs 按时间倒序查询符合条件的操作日志，最多 limit 条
+	FindOperLogs(ctx context.Context, filter *OperLogFilter, limit int) ([]*OperLog, error)
+	// DeleteOperLogsBefore 物理删除所有租户早于指定时间的操作日志，哈希链从清理位置继续可校验
+	DeleteOperLogsBefore(ctx context.Context, before time.Time) (int64, error)
+	// ListOperLogNodes 查询写入过操作日志的节点
+	ListOperLogNodes(ctx context.Context) ([]string, error)
+	// VerifyOperLogChain 按序号校验节点的哈希链
+	VerifyOperLogChain(ctx context.Context, node string) (*OperLogChain, error)
+}
+
+type OperLogUseCase struct {
+	repo          OperLogRepo
+	oss           oss.Storage
+	urlExpires    time.Duration
+	retentionDays int32
+	log           *log.Helper
+}
+
+func NewOperLogUseCase(repo OperLogRepo, storage oss.Storage, c *conf.App, logger log.Logger) *OperLogUseCase {
+	urlExpires := time.Hour
+	if c.GetUpload().GetPrivateUrlExpires() != nil {
+		urlExpires = c.Upload.PrivateUrlExpires.AsDuration()
+	}
+	return &OperLogUseCase{
+		repo:          repo,
+		oss:           storage,
+		urlExpires:    urlExpires,
+		retentionDays: c.GetAudit().GetRetentionDays(),
+		log:           log.NewHelper(logger),
+	}
+}
+
+// List 分页查询操作日志
+func (uc *OperLogUseCase) List(ctx context.Context, filter *OperLogFilter, page, pageSize int) ([]*OperLog, int64, error) {
+	return uc.repo.ListOperLogs(ctx, filter, page, pageSize)
+}
+
+// Export 导出操作日志为 CSV，上传到对象存储并返回临时访问地址
+func (uc *OperLogUseCase) Export(ctx context.Context, filter *OperLogFilter) (string, error) {
+	count, err := uc.repo.CountOperLogs(ctx, filter)
+	if err != nil {
+		return "", err
+	}
+	if count > maxOperLogExport {
+		return "", ErrOperLogExportTooLarge
+	}
+	logs, err := uc.repo.FindOperLogs(ctx, filter, maxOperLogExport)
+	if err != nil {
+		return "", err
+	}
+
+	w, err := sheet.NewWriter(sheet.CSV)
+	if err != nil {
+		return "", ErrOperLogExportFailed.WithCause(err)
+	}
+	_ = w.Write("ID", "时间", "用户ID", "部门ID", "IP", "操作", "接口", "权限码", "方法", "路径", "结果码", "原因", "耗时(ms)", "请求参数", "数据变更")
+	for _, l := range logs {
+		_ = w.Write(
+			strconv.FormatInt(l.ID, 10),
+			l.CreatedAt.Format(time.DateTime),
+			strconv.FormatInt(l.UserID, 10),
+			strconv.FormatInt(l.DeptID, 10),
+			l.IP,
+			l.Name,
+			l.Operation,
+			l.PermCode,
+			l.Method,
+			l.Path,
+			strconv.Itoa(int(l.Code)),
+			l.Reason,
+			strconv.FormatInt(l.Latency, 10),
+			l.Request,
+			l.Diff,
+		)
+	}
+	if err := w.Close(); err != nil {
+		return "", ErrOperLogExportFailed.WithCause(err)
+	}
+	buf := w.Bytes()
+
+	now := time.Now()
+	key := fmt.Sprintf("export/oper_log/%d/%s/oper_log_%s_%s.csv",
+		auth.GetTenantID(ctx), now.Format("2006/01/02"), now.Format("20060102150405"), uuid.NewString()[:8])
+	if _, err := uc.oss.Upload(ctx, key, buf, int64(buf.Len()), sheet.ContentType(sheet.CSV), true); err != nil {
+		uc.log.Errorf("upload oper log export failed: %v", err)
+		return "", ErrOperLogExportFailed
+	}
+	return uc.oss.GenerateURL(ctx, key, true, uc.urlExpires), nil
+}
+
+// Verify 校验哈希链，node 为空时校验全部节点（仅平台租户）
+func (uc *OperLogUseCase) Verify(ctx context.Context, node string) ([]*OperLogChain, error) {
+	if auth.GetTenantID(ctx) != systemTenantID {
+		return nil, ErrOperLogVerifyForbidden
+	}
+	// 哈希链跨租户，校验时不做租户隔离
+	ctx = auth.WithSkipDataScope(ctx)
+
+	nodes := []string{node}
+	if node == "" {
+		var err error
+		if nodes, err = uc.repo.ListOperLogNodes(ctx); err != nil {
+			return nil, err
+		}
+	}
+	result := make([]*OperLogChain, 0, len(nodes))
+	for _, n := range nodes {
+		chain, err := uc.repo.VerifyOperLogChain(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		if chain.BrokenID != 0 {
+			uc.log.Warnf("oper log chain of node %s broken at %d", n, chain.BrokenID)
+		}
+		if chain.Missing != 0 {
+			uc.log.Warnf("oper log chain of node %s is missing its last %d records", n, chain.Missing)
+		}
+		result = append(result, chain)
+	}
+	return result, nil
+}
+
+// Cleanup 删除超出保留期的操作日志，保留天数为 0 时不清理
+func (uc *OperLogUseCase) Cleanup(ctx context.Context) (int64, error) {
+	if uc.retentionDays <= 0 {
+		return 0, nil
+	}
+	before := time.Now().AddDate(0, 0, -int(uc.retentionDays))
+	return uc.repo.DeleteOperLogsBefore(ctx, before)
+}
@@ -10,14 +10,17 @@ import (
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/debug"
 )
 
+// 验证码缓存 Key：otp:<用途>:<租户ID>:<渠道>:<场景>:<接收方>
 const (
-	otpIntervalKeyPattern = "otp:interval:%s:%s:%s"
-	otpCodeKeyPattern     = "otp:code:%s:%s:%s"
-	otpFailKeyPattern     = "otp:fail:%s:%s:%s"
+	otpIntervalKeyPattern = "otp:interval:%d:%s:%s:%s"
+	otpCodeKeyPattern     = "otp:code:%d:%s:%s:%s"
+	otpFailKeyPattern     = "otp:fail:%d:%s:%s:%s"
 	otpMaxFailCount       = 5
 	otpFailExpiration     = time.Hour
 )
@@ -63,20 +66,21 @@ type OtpCache interface {
 }
 
 type OtpUseCase struct {
-	sms   SmsSender
-	email EmailSender
-	cache OtpCache
-	conf  *conf.App_Otp
-	log   *log.Helper
+	sms     SmsSender
+	email   EmailSender
+	cache   OtpCache
+	conf    *conf.App_Otp
+	configs *provider.ConfigProvider
+	log     *log.Helper
 }
 
-func NewOtpUseCase(s SmsSender, e EmailSender, c OtpCache, conf *conf.App, logger log.Logger) *OtpUseCase {
-	return &OtpUseCase{sms: s, email: e, cache: c, conf: conf.Otp, log: log.NewHelper(logger)}
+func NewOtpUseCase(s SmsSender, e EmailSender, c OtpCache, conf *conf.App, configs *provider.ConfigProvider, logger log.Logger) *OtpUseCase {
+	return &OtpUseCase{sms: s, email: e, cache: c, conf: conf.Otp, configs: configs, log: log.NewHelper(logger)}
 }
 
 // SendPhoneOtp 发送手机验证码
 func (uc *OtpUseCase) SendPhoneOtp(ctx context.Context, phone, scene string) (int64, error) {
-	cfg, ok := uc.conf.PhoneScenes[scene]
+	cfg, ok := uc.scene(ctx, ConfigKeyOtpPhoneScenes, uc.conf.PhoneScenes, scene)
 	if !ok {
 		return 0, ErrorSceneNotFound
 	}
@@ -88,7 +92,7 @@ func (uc *OtpUseCase) SendPhoneOtp(ctx context.Context, phone, scene string) (in
 
 // SendEmailOtp 发送邮箱验证码
 func (uc *OtpUseCase) SendEmailOtp(ctx context.Context, email, scene string) (int64, error) {
-	cfg, ok := uc.conf.EmailScenes[scene]
+	cfg, ok := uc.scene(ctx, ConfigKeyOtpEmailScenes, uc.conf.EmailScenes, scene)
 	if !ok {
 		return 0, ErrorSceneNotFound
 	}
@@ -98,10 +102,23 @@ func (uc *OtpUseCase) SendEmailOtp(ctx context.Context, email, scene string) (in
 	})
 }
 
+// scene 场景配置，优先读取系统参数，未配置时使用 config.yaml
+func (uc *OtpUseCase) scene(ctx context.Context, key string, fallback map[string]*conf.App_Otp_Scene, scene string) (*conf.App_Otp_Scene, bool) {
+	cfg, ok, err := lookupScene(ctx, uc.configs, key, scene, func() *conf.App_Otp_Scene { return &conf.App_Otp_Scene{} })
+	if err != nil {
+		uc.log.Warnf("invalid system config %s: %v", key, err)
+	}
+	if ok {
+		return cfg, true
+	}
+	cfg, ok = fallback[scene]
+	return cfg, ok
+}
+
 // 内部抽象流程
 func (uc *OtpUseCase) process(ctx context.Context, kind, scene, receiver string, cfg *conf.App_Otp_Scene, sendFn func(code string) error) (int64, error) {
-	intervalKey := fmt.Sprintf(otpIntervalKeyPattern, kind, scene, receiver)
-	codeKey := fmt.Sprintf(otpCodeKeyPattern, kind, scene, receiver)
+	intervalKey := fmt.Sprintf(otpIntervalKeyPattern, auth.GetTenantID(ctx), kind, scene, receiver)
+	codeKey := fmt.Sprintf(otpCodeKeyPattern, auth.GetTenantID(ctx), kind, scene, receiver)
 
 	resendInterval := cfg.ResendInterval.AsDuration()
 
@@ -128,7 +145,7 @@ func (uc *OtpUseCase) process(ctx context.Context, kind, scene, receiver string,
 	}
 
 	// 发新验证码前清理上一轮的失败计数
-	failKey := fmt.Sprintf(otpFailKeyPattern, kind, scene, receiver)
+	failKey := fmt.Sprintf(otpFailKeyPattern, auth.GetTenantID(ctx), kind, scene, receiver)
 	_ = uc.cache.Del(ctx, failKey)
 
 	if debug.IsDebug() {
@@ -152,8 +169,8 @@ func (uc *OtpUseCase) VerifyEmailOtp(ctx context.Context, email string, scene Sc
 
 // 内部通用校验逻辑
 func (uc *OtpUseCase) verify(ctx context.Context, kind string, scene Scene, receiver, inputCode string) (bool, error) {
-	codeKey := fmt.Sprintf(otpCodeKeyPattern, kind, scene, receiver)
-	failKey := fmt.Sprintf(otpFailKeyPattern, kind, scene, receiver)
+	codeKey := fmt.Sprintf(otpCodeKeyPattern, auth.GetTenantID(ctx), kind, scene, receiver)
+	failKey := fmt.Sprintf(otpFailKeyPattern, auth.GetTenantID(ctx), kind, scene, receiver)
 
 	stored, err := uc.cache.Get(ctx, codeKey)
 	if err != nil {
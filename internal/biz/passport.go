@@ -8,6 +8,7 @@ import (
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"golang.org/x/crypto/bcrypt"
@@ -26,6 +27,7 @@ type SysUser struct {
 	Username     string
 	PasswordHash string
 	Phone        string
+	Email        string
 	Nickname     string
 	DeptID       int64
 	TenantID     int64
@@ -38,29 +40,58 @@ type SysUserRepo interface {
 	CreateUser(ctx context.Context, user *SysUser) (*SysUser, error)
 	GetUserByUsername(ctx context.Context, username string) (*SysUser, error)
 	GetUserByPhone(ctx context.Context, phone string) (*SysUser, error)
+	// GetUserByID 按 ID 查询当前租户数据范围内的用户（经缓存，不含密码哈希；事务内或 WithPrimary 时直接读库）
 	GetUserByID(ctx context.Context, id int64) (*SysUser, error)
 	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
 	UpdatePhone(ctx context.Context, id int64, phone string) error
+	// ExistingUsernames 返回当前租户中已被占用的用户名（不受数据范围限制）
+	ExistingUsernames(ctx context.Context, usernames []string) ([]string, error)
+	// ExistingPhones 返回当前租户中已被占用的手机号（不受数据范围限制）
+	ExistingPhones(ctx context.Context, phones []string) ([]string, error)
+	// CountUsers 统计当前租户（及数据范围）内符合条件的用户
+	CountUsers(ctx context.Context, filter *UserFilter) (int64, error)
+	// FindUsers 按 ID 顺序查询当前租户（及数据范围）内符合条件的用户，最多 limit 条
+	FindUsers(ctx context.Context, filter *UserFilter, limit int) ([]*SysUser, error)
+	// ListUserRoleCodes 查询用户直接分配且未到期的角色编码
+	ListUserRoleCodes(ctx context.Context, userIDs []int64) (map[int64][]string, error)
+}
+
+// 用户状态筛选
+const (
+	UserStatusAll      = 0
+	UserStatusEnabled  = 1
+	UserStatusDisabled = 2
+)
+
+type UserFilter struct {
+	Keyword string // 模糊匹配用户名、姓名或手机号
+	DeptID  int64  // 包含下级部门，为 0 时不限
+	Status  int32
 }
 
 type PassportUseCase struct {
-	auth    auth.TokenService
-	sysUser SysUserRepo
-	conf    *conf.App_Auth_Passport
-	log     *log.Helper
+	auth        auth.TokenService
+	sysUser     SysUserRepo
+	tenants     *provider.TenantProvider
+	conf        *conf.App_Auth_Passport
+	multiTenant bool
+	log         *log.Helper
 }
 
 func NewPassportUseCase(
 	auth auth.TokenService,
 	sysUser SysUserRepo,
+	tenants *provider.TenantProvider,
 	conf *conf.App,
 	logger log.Logger,
 ) *PassportUseCase {
 	return &PassportUseCase{
-		auth:    auth,
-		sysUser: sysUser,
-		conf:    conf.Auth.Passport,
-		log:     log.NewHelper(logger),
+		auth:        auth,
+		sysUser:     sysUser,
+		tenants:     tenants,
+		conf:        conf.Auth.Passport,
+		multiTenant: conf.EnableMultiTenant,
+		log:         log.NewHelper(logger),
 	}
 }
 
@@ -92,6 +123,10 @@ func (uc *PassportUseCase) LoginByPassword(ctx context.Context, username, passwo
 		return "", ErrUserDisabled
 	}
 
+	if err := uc.checkTenant(user.TenantID); err != nil {
+		return "", err
+	}
+
 	return uc.auth.GenerateToken(ctx, uc.formatUserID(user.ID), user.DeptID, user.TenantID)
 }
 
@@ -109,6 +144,10 @@ func (uc *PassportUseCase) LoginByOtp(ctx context.Context, phone string) (string
 		return "", ErrUserDisabled
 	}
 
+	if err := uc.checkTenant(user.TenantID); err != nil {
+		return "", err
+	}
+
 	return uc.auth.GenerateToken(ctx, uc.formatUserID(user.ID), user.DeptID, user.TenantID)
 }
 
@@ -131,7 +170,8 @@ func (uc *PassportUseCase) UpdatePassword(ctx context.Context, oldPassword, newP
 		return err
 	}
 
-	user, err := uc.sysUser.GetUserByID(ctx, userId)
+	// 用户缓存不含密码哈希，从主库读取
+	user, err := uc.sysUser.GetUserByID(WithPrimary(ctx), userId)
 	if err != nil {
 		return err
 	}
@@ -215,6 +255,16 @@ func (uc *PassportUseCase) ResetPassword(ctx context.Context, mobile, newPasswor
 	return nil
 }
 
+// checkTenant 校验用户所属租户状态（仅多租户模式）
+// 宽限期内的租户允许登录，由请求中间件限制为只读
+func (uc *PassportUseCase) checkTenant(tenantID int64) error {
+	if !uc.multiTenant {
+		return nil
+	}
+	_, err := uc.tenants.Check(tenantID)
+	return err
+}
+
 func (uc *PassportUseCase) hashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	return string(bytes), err
@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ApiPermission 接口权限，由 proto 注解声明
+type ApiPermission struct {
+	Operation string
+	Code      string
+	Name      string
+}
+
+type PermissionRepo interface {
+	// SyncApiPermissions 以声明为准同步 API 类型的权限：新增、更新，并删除已不存在的权限及其角色、套餐授权
+	SyncApiPermissions(ctx context.Context, perms []*ApiPermission) error
+}
+
+type PermissionUseCase struct {
+	repo  PermissionRepo
+	authz *AuthzUseCase
+	log   *log.Helper
+}
+
+func NewPermissionUseCase(repo PermissionRepo, authz *AuthzUseCase, logger log.Logger) *PermissionUseCase {
+	return &PermissionUseCase{
+		repo:  repo,
+		authz: authz,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// SyncApiPermissions 同步接口权限并刷新全部节点的接口权限映射，删除的权限同时撤销授权
+func (uc *PermissionUseCase) SyncApiPermissions(ctx context.Context, perms []*ApiPermission) error {
+	if err := uc.repo.SyncApiPermissions(ctx, perms); err != nil {
+		return err
+	}
+	return uc.authz.Invalidate(ctx, TopicPermission, TopicPackage, TopicPolicy)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// 系统参数值类型
+const (
+	ConfigTypeString   = "string"
+	ConfigTypeInt      = "int"
+	ConfigTypeBool     = "bool"
+	ConfigTypeDuration = "duration" // time.ParseDuration 格式，如 5m、1h30m
+	ConfigTypeJSON     = "json"
+)
+
+// ConfigValue 系统参数值
+type ConfigValue struct {
+	Type  string
+	Value string
+}
+
+// ConfigEntry 系统参数快照，TenantID 为 0 表示全局参数
+type ConfigEntry struct {
+	TenantID int64
+	Key      string
+	ConfigValue
+}
+
+// ConfigLoader 接口，由 Data 层实现
+type ConfigLoader interface {
+	// LoadAllConfigs 查询所有租户（含全局）的系统参数
+	LoadAllConfigs(ctx context.Context) ([]ConfigEntry, error)
+}
+
+// ConfigProvider 系统参数内存快照，租户参数覆盖全局参数
+// 参数变更后由 ConfigUseCase 刷新并广播，其他节点收到通知后重新加载
+type ConfigProvider struct {
+	mux sync.RWMutex
+	// Key: TenantID -> 参数键
+	values map[int64]map[string]ConfigValue
+	repo   ConfigLoader
+}
+
+func NewConfigProvider(repo ConfigLoader) *ConfigProvider {
+	p := &ConfigProvider{
+		values: make(map[int64]map[string]ConfigValue),
+		repo:   repo,
+	}
+	if err := p.Load(context.Background()); err != nil {
+		panic(fmt.Sprintf("failed to load system configs: %v", err))
+	}
+	return p
+}
+
+// Load 全量刷新内存映射
+func (p *ConfigProvider) Load(ctx context.Context) error {
+	list, err := p.repo.LoadAllConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	values := make(map[int64]map[string]ConfigValue)
+	for _, e := range list {
+		if _, ok := values[e.TenantID]; !ok {
+			values[e.TenantID] = make(map[string]ConfigValue)
+		}
+		values[e.TenantID][e.Key] = e.ConfigValue
+	}
+	p.mux.Lock()
+	p.values = values
+	p.mux.Unlock()
+	return nil
+}
+
+// Lookup 查询租户生效的参数值，租户未覆盖时取全局参数
+func (p *ConfigProvider) Lookup(tenantID int64, key string) (ConfigValue, bool) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	if v, ok := p.values[tenantID][key]; ok {
+		return v, true
+	}
+	v, ok := p.values[0][key]
+	return v, ok
+}
+
+// String 当前租户的字符串参数，未配置时返回 def
+func (p *ConfigProvider) String(ctx context.Context, key, def string) string {
+	if v, ok := p.Lookup(auth.GetTenantID(ctx), key); ok {
+		return v.Value
+	}
+	return def
+}
+
+// Int 当前租户的整数参数，未配置或格式错误时返回 def
+func (p *ConfigProvider) Int(ctx context.Context, key string, def int64) int64 {
+	if v, ok := p.Lookup(auth.GetTenantID(ctx), key); ok {
+		if n, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+// Bool 当前租户的布尔参数，未配置或格式错误时返回 def
+func (p *ConfigProvider) Bool(ctx context.Context, key string, def bool) bool {
+	if v, ok := p.Lookup(auth.GetTenantID(ctx), key); ok {
+		if b, err := strconv.ParseBool(v.Value); err == nil {
+			return b
+		}
+	}
+	return def
+}
+
+// Duration 当前租户的时长参数，未配置或格式错误时返回 def
+func (p *ConfigProvider) Duration(ctx context.Context, key string, def time.Duration) time.Duration {
+	if v, ok := p.Lookup(auth.GetTenantID(ctx), key); ok {
+		if d, err := time.ParseDuration(v.Value); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// JSON 将当前租户的 JSON 参数解析到 out，未配置时返回 false
+func (p *ConfigProvider) JSON(ctx context.Context, key string, out any) (bool, error) {
+	v, ok := p.Lookup(auth.GetTenantID(ctx), key)
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(v.Value), out); err != nil {
+		return false, fmt.Errorf("config %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// ValidateConfigValue 校验参数值与类型是否匹配
+func ValidateConfigValue(valueType, value string) error {
+	var err error
+	switch valueType {
+	case ConfigTypeString:
+	case ConfigTypeInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case ConfigTypeBool:
+		_, err = strconv.ParseBool(value)
+	case ConfigTypeDuration:
+		_, err = time.ParseDuration(value)
+	case ConfigTypeJSON:
+		if !json.Valid([]byte(value)) {
+			err = fmt.Errorf("invalid json")
+		}
+	default:
+		err = fmt.Errorf("unknown value type %q", valueType)
+	}
+	return err
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// ScopeGrant 用户某个角色在当前接口上被授予的数据范围
+type ScopeGrant struct {
+	Role  string // 角色编码
+	Scope string // 数据范围
+}
+
+// ResolvedScope 多角色合并后的数据范围
+type ResolvedScope struct {
+	Scope    string  // 单一范围时为原值，需要合并时为 CUSTOM
+	DeptIDs  []int64 // Scope 为 CUSTOM 时可访问的部门
+	WithSelf bool    // Scope 为 CUSTOM 时是否额外包含个人数据
+}
+
+// DataScopeLoader 接口，由 Data 层实现
+type DataScopeLoader interface {
+	// LoadRoleDeptIDs 查询角色自定义的部门集合
+	LoadRoleDeptIDs(ctx context.Context, tenantID int64, roleCode string) ([]int64, error)
+	// LoadSubDeptIDs 查询部门及其所有下级部门
+	LoadSubDeptIDs(ctx context.Context, tenantID, deptID int64) ([]int64, error)
+}
+
+// 合并结果缓存的容量及有效期，有效期兜底未广播到本节点的部门、角色变更
+const (
+	dataScopeCacheSize = 10000
+	dataScopeCacheTTL  = 10 * time.Minute
+)
+
+type DataScopeProvider struct {
+	mux sync.Mutex
+	// Key: 用户 + 租户 + 部门 + 授权组合
+	cache *scopeCache
+	repo  DataScopeLoader
+}
+
+func NewDataScopeProvider(repo DataScopeLoader) *DataScopeProvider {
+	return &DataScopeProvider{
+		cache: newScopeCache(dataScopeCacheSize, dataScopeCacheTTL),
+		repo:  repo,
+	}
+}
+
+// Resolve 合并用户多个角色的数据范围：
+//   - 任一角色为 ALL 则为 ALL
+//   - 所有角色为同一种非 CUSTOM 范围时保持原值
+//   - 否则取各角色部门集合的并集（SELF 以 WithSelf 标记保留）
+//   - 没有任何授权时按最小权限处理为 SELF
+func (p *DataScopeProvider) Resolve(ctx context.Context, info auth.ContextInfo, grants []ScopeGrant) (ResolvedScope, error) {
+	if len(grants) == 0 {
+		return ResolvedScope{Scope: auth.ScopeSelf}, nil
+	}
+
+	kinds := make(map[string]struct{})
+	for _, g := range grants {
+		if g.Scope == auth.ScopeAll {
+			return ResolvedScope{Scope: auth.ScopeAll}, nil
+		}
+		kinds[g.Scope] = struct{}{}
+	}
+	if _, custom := kinds[auth.ScopeCustom]; !custom && len(kinds) == 1 {
+		return ResolvedScope{Scope: grants[0].Scope}, nil
+	}
+
+	key := p.cacheKey(info, grants)
+	p.mux.Lock()
+	cached, ok := p.cache.get(key)
+	gen := p.cache.gen
+	p.mux.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	resolved, err := p.union(ctx, info, grants)
+	if err != nil {
+		return ResolvedScope{}, err
+	}
+
+	p.mux.Lock()
+	// 查询期间缓存已被清空时不写入，避免保存变更前的结果
+	if p.cache.gen == gen {
+		p.cache.set(key, resolved)
+	}
+	p.mux.Unlock()
+	return resolved, nil
+}
+
+// Invalidate 清空缓存（角色部门、部门树变更时调用）
+func (p *DataScopeProvider) Invalidate() {
+	p.mux.Lock()
+	p.cache.clear()
+	p.mux.Unlock()
+}
+
+func (p *DataScopeProvider) union(ctx context.Context, info auth.ContextInfo, grants []ScopeGrant) (ResolvedScope, error) {
+	resolved := ResolvedScope{Scope: auth.ScopeCustom}
+	depts := make(map[int64]struct{})
+	add := func(ids ...int64) {
+		for _, id := range ids {
+			depts[id] = struct{}{}
+		}
+	}
+
+	for _, g := range grants {
+		switch g.Scope {
+		case auth.ScopeSelf:
+			resolved.WithSelf = true
+		case auth.ScopeDept:
+			add(info.DeptID)
+		case auth.ScopeDeptSub:
+			ids, err := p.repo.LoadSubDeptIDs(ctx, info.TenantID, info.DeptID)
+			if err != nil {
+				return ResolvedScope{}, err
+			}
+			add(ids...)
+		case auth.ScopeCustom:
+			ids, err := p.repo.LoadRoleDeptIDs(ctx, info.TenantID, g.Role)
+			if err != nil {
+				return ResolvedScope{}, err
+			}
+			add(ids...)
+		}
+	}
+
+	resolved.DeptIDs = make([]int64, 0, len(depts))
+	for id := range depts {
+		resolved.DeptIDs = append(resolved.DeptIDs, id)
+	}
+	sort.Slice(resolved.DeptIDs, func(i, j int) bool { return resolved.DeptIDs[i] < resolved.DeptIDs[j] })
+	return resolved, nil
+}
+
+func (p *DataScopeProvider) cacheKey(info auth.ContextInfo, grants []ScopeGrant) string {
+	parts := make([]string, 0, len(grants))
+	for _, g := range grants {
+		parts = append(parts, g.Role+"="+g.Scope)
+	}
+	sort.Strings(parts)
+
+	var b strings.Builder
+	b.WriteString(strconv.FormatInt(info.UserID, 10))
+	b.WriteByte(':')
+	b.WriteString(strconv.FormatInt(info.TenantID, 10))
+	b.WriteByte(':')
+	b.WriteString(strconv.FormatInt(info.DeptID, 10))
+	b.WriteByte(':')
+	b.WriteString(strings.Join(parts, ","))
+	return b.String()
+}
+
+// scopeCache 容量有限的 LRU 缓存，条目超过有效期后失效，由 DataScopeProvider 加锁访问
+type scopeCache struct {
+	size    int
+	ttl     time.Duration
+	gen     uint64     // 每次清空加一
+	order   *list.List // 最近使用的在前
+	entries map[string]*list.Element
+}
+
+type scopeEntry struct {
+	key      string
+	scope    ResolvedScope
+	expireAt time.Time
+}
+
+func newScopeCache(size int, ttl time.Duration) *scopeCache {
+	return &scopeCache{size: size, ttl: ttl, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *scopeCache) get(key string) (ResolvedScope, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return ResolvedScope{}, false
+	}
+	entry := e.Value.(*scopeEntry)
+	if time.Now().After(entry.expireAt) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return ResolvedScope{}, false
+	}
+	c.order.MoveToFront(e)
+	return entry.scope, true
+}
+
+func (c *scopeCache) set(key string, scope ResolvedScope) {
+	entry := &scopeEntry{key: key, scope: scope, expireAt: time.Now().Add(c.ttl)}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*scopeEntry).key)
+	}
+}
+
+func (c *scopeCache) clear() {
+	c.gen++
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// fakeLoader 下级部门由 subDepts 决定，记录查询次数
+type fakeLoader struct {
+	subDepts []int64
+	loads    atomic.Int32
+}
+
+func (l *fakeLoader) LoadRoleDeptIDs(context.Context, int64, string) ([]int64, error) {
+	l.loads.Add(1)
+	return []int64{100}, nil
+}
+
+func (l *fakeLoader) LoadSubDeptIDs(context.Context, int64, int64) ([]int64, error) {
+	l.loads.Add(1)
+	return l.subDepts, nil
+}
+
+func TestDataScopeProviderCache(t *testing.T) {
+	loader := &fakeLoader{subDepts: []int64{1, 2}}
+	p := NewDataScopeProvider(loader)
+	info := auth.ContextInfo{UserID: 1, TenantID: 1, DeptID: 1}
+	grants := []ScopeGrant{{Role: "a", Scope: auth.ScopeDeptSub}, {Role: "b", Scope: auth.ScopeSelf}}
+
+	resolve := func() ResolvedScope {
+		t.Helper()
+		r, err := p.Resolve(context.Background(), info, grants)
+		if err != nil {
+			t.Fatal(err)
+		}
+		slices.Sort(r.DeptIDs)
+		return r
+	}
+
+	if r := resolve(); fmt.Sprint(r.DeptIDs) != "[1 2]" || !r.WithSelf {
+		t.Fatalf("resolved = %+v", r)
+	}
+	resolve()
+	if n := loader.loads.Load(); n != 1 {
+		t.Fatalf("loads = %d, want 1 (second resolve cached)", n)
+	}
+
+	// 部门树变更后失效，重新加载
+	loader.subDepts = []int64{1, 2, 3}
+	p.Invalidate()
+	if r := resolve(); fmt.Sprint(r.DeptIDs) != "[1 2 3]" {
+		t.Fatalf("resolved after invalidate = %+v", r)
+	}
+	if n := loader.loads.Load(); n != 2 {
+		t.Fatalf("loads = %d, want 2", n)
+	}
+}
+
+func TestScopeCacheEviction(t *testing.T) {
+	c := newScopeCache(2, time.Minute)
+	c.set("a", ResolvedScope{Scope: "a"})
+	c.set("b", ResolvedScope{Scope: "b"})
+	c.get("a") // a 最近使用，淘汰 b
+	c.set("c", ResolvedScope{Scope: "c"})
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := c.get(key); ok != want {
+			t.Errorf("get(%s) = %v, want %v", key, ok, want)
+		}
+	}
+	if len(c.entries) != 2 || c.order.Len() != 2 {
+		t.Fatalf("size = %d/%d, want 2", len(c.entries), c.order.Len())
+	}
+}
+
+func TestScopeCacheExpiry(t *testing.T) {
+	c := newScopeCache(2, time.Millisecond)
+	c.set("a", ResolvedScope{Scope: "a"})
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := c.get("a"); ok {
+		t.Fatal("expired entry returned")
+	}
+	if len(c.entries) != 0 || c.order.Len() != 0 {
+		t.Fatal("expired entry not removed")
+	}
+}
+
+func TestDataScopeProviderInvalidateDuringLoad(t *testing.T) {
+	loader := &fakeLoader{subDepts: []int64{1}}
+	p := NewDataScopeProvider(loader)
+	info := auth.ContextInfo{UserID: 1, TenantID: 1, DeptID: 1}
+	grants := []ScopeGrant{{Role: "a", Scope: auth.ScopeDeptSub}, {Role: "b", Scope: auth.ScopeSelf}}
+
+	// 模拟查询期间部门变更：加载结束前清空缓存，旧结果不应写入
+	p.repo = invalidatingLoader{fakeLoader: loader, p: p}
+	if _, err := p.Resolve(context.Background(), info, grants); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.cache.get(p.cacheKey(info, grants)); ok {
+		t.Fatal("stale result cached after invalidate")
+	}
+}
+
+type invalidatingLoader struct {
+	*fakeLoader
+	p *DataScopeProvider
+}
+
+func (l invalidatingLoader) LoadSubDeptIDs(ctx context.Context, tenantID, deptID int64) ([]int64, error) {
+	l.p.Invalidate()
+	return l.fakeLoader.LoadSubDeptIDs(ctx, tenantID, deptID)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+)
+
+// 租户状态
+const (
+	TenantStatusNormal   int16 = 1 // 正常
+	TenantStatusDisabled int16 = 2 // 禁用
+)
+
+var (
+	ErrTenantInvalid  = kerrors.Forbidden("TENANT_INVALID", "租户不存在或已删除")
+	ErrTenantDisabled = kerrors.Forbidden("TENANT_DISABLED", "租户已被禁用")
+	ErrTenantExpired  = kerrors.Forbidden("TENANT_EXPIRED", "租户已过期")
+	ErrTenantReadOnly = kerrors.Forbidden("TENANT_READ_ONLY", "租户已过期，当前仅允许只读操作")
+)
+
+// TenantState 租户状态快照
+type TenantState struct {
+	ID         int64
+	Code       string
+	Status     int16
+	ExpireTime time.Time // 零值表示永不过期
+}
+
+type TenantProvider struct {
+	mux sync.RWMutex
+	// Key: TenantID
+	tenants map[int64]TenantState
+	// Key: 租户编码, Value: TenantID
+	codes       map[string]int64
+	gracePeriod time.Duration
+	repo        TenantLoader
+}
+
+// TenantLoader 接口，由 Data 层实现
+type TenantLoader interface {
+	// LoadAllTenants 查询所有未删除租户的状态与过期时间
+	LoadAllTenants(ctx context.Context) ([]TenantState, error)
+}
+
+func NewTenantProvider(repo TenantLoader, c *conf.App) *TenantProvider {
+	p := &TenantProvider{
+		tenants: make(map[int64]TenantState),
+		codes:   make(map[string]int64),
+		repo:    repo,
+	}
+	if c.Tenant != nil && c.Tenant.GracePeriod != nil {
+		p.gracePeriod = c.Tenant.GracePeriod.AsDuration()
+	}
+	if err := p.Load(context.Background()); err != nil {
+		// 租户状态是访问控制的一部分，加载失败直接 panic
+		panic(fmt.Sprintf("failed to load tenants: %v", err))
+	}
+	return p
+}
+
+// Load 全量刷新内存映射
+func (p *TenantProvider) Load(ctx context.Context) error {
+	list, err := p.repo.LoadAllTenants(ctx)
+	if err != nil {
+		return err
+	}
+	tenants := make(map[int64]TenantState, len(list))
+	codes := make(map[string]int64, len(list))
+	for _, t := range list {
+		tenants[t.ID] = t
+		codes[t.Code] = t.ID
+	}
+	p.mux.Lock()
+	p.tenants = tenants
+	p.codes = codes
+	p.mux.Unlock()
+	return nil
+}
+
+// GetIDByCode 根据租户编码获取租户 ID
+func (p *TenantProvider) GetIDByCode(code string) (int64, bool) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	id, ok := p.codes[code]
+	return id, ok
+}
+
+// Check 校验租户当前是否可用
+// readOnly 为 true 表示租户已过期但仍在宽限期内，只允许读操作
+func (p *TenantProvider) Check(tenantID int64) (readOnly bool, err error) {
+	p.mux.RLock()
+	t, ok := p.tenants[tenantID]
+	p.mux.RUnlock()
+
+	if !ok {
+		return false, ErrTenantInvalid
+	}
+	if t.Status == TenantStatusDisabled {
+		return false, ErrTenantDisabled
+	}
+	if t.ExpireTime.IsZero() {
+		return false, nil
+	}
+
+	now := time.Now()
+	if now.Before(t.ExpireTime) {
+		return false, nil
+	}
+	if now.Before(t.ExpireTime.Add(p.gracePeriod)) {
+		return true, nil
+	}
+	return false, ErrTenantExpired
+}
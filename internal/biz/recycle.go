@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// 回收站数据类型
+const (
+	RecycleUser       = "user"
+	RecycleRole       = "role"
+	RecycleDept       = "dept"
+	RecyclePermission = "permission"
+)
+
+// RecycleKinds 全部回收站数据类型
+var RecycleKinds = []string{RecycleUser, RecycleRole, RecycleDept, RecyclePermission}
+
+// 恢复冲突原因
+const (
+	RecycleConflictNotFound  = "记录不存在或不在回收站中"
+	RecycleConflictDuplicate = "与现有数据的唯一标识重复"
+	RecycleConflictBatch     = "与本次恢复的其他记录唯一标识重复"
+	RecycleConflictParent    = "上级部门已删除，请先恢复上级部门"
+)
+
+// 定时清理时单批彻底删除的条数
+const recycleCleanupBatch = 500
+
+var (
+	ErrRecycleKindInvalid = kerrors.BadRequest("RECYCLE_KIND_INVALID", "不支持的回收站数据类型")
+	ErrRecycleForbidden   = kerrors.Forbidden("RECYCLE_FORBIDDEN", "仅平台租户可管理已删除的权限")
+)
+
+// TrashedRecord 回收站中的记录
+type TrashedRecord struct {
+	ID            int64
+	TenantID      int64
+	Name          string
+	Code          string // 用户名、角色编码或权限码，部门为空
+	DeletedAt     time.Time
+	DeletedBy     int64
+	DeletedByName string
+}
+
+// RecycleConflict 无法恢复的记录及原因
+type RecycleConflict struct {
+	ID     int64
+	Reason string
+}
+
+type RecycleRepo interface {
+	// ListTrashed 按删除时间倒序分页查询当前租户（及数据范围）内已逻辑删除的记录
+	ListTrashed(ctx context.Context, kind string, page, pageSize int) ([]*TrashedRecord, int64, error)
+	// FindRestoreConflicts 检查恢复后是否违反唯一约束（仅约束未删除数据）或上级已删除，返回不可恢复的记录
+	FindRestoreConflicts(ctx context.Context, kind string, ids []int64) ([]*RecycleConflict, error)
+	// RestoreTrashed 恢复回收站中的记录
+	RestoreTrashed(ctx context.Context, kind string, ids []int64) (int64, error)
+	// PurgeTrashed 彻底删除回收站中的记录及其关联数据
+	PurgeTrashed(ctx context.Context, kind string, ids []int64) (int64, error)
+	// ListExpiredTrashIDs 查询所有租户中早于指定时间删除的记录
+	ListExpiredTrashIDs(ctx context.Context, kind string, before time.Time, limit int) ([]int64, error)
+}
+
+type RecycleUseCase struct {
+	repo          RecycleRepo
+	tx            Transaction
+	authz         *AuthzUseCase
+	retentionDays int32
+	log           *log.Helper
+}
+
+func NewRecycleUseCase(repo RecycleRepo, tx Transaction, authz *AuthzUseCase, c *conf.App, logger log.Logger) *RecycleUseCase {
+	return &RecycleUseCase{
+		repo:          repo,
+		tx:            tx,
+		authz:         authz,
+		retentionDays: c.GetRecycle().GetRetentionDays(),
+		log:           log.NewHelper(logger),
+	}
+}
+
+// List 分页查询回收站
+func (uc *RecycleUseCase) List(ctx context.Context, kind string, page, pageSize int) ([]*TrashedRecord, int64, error) {
+	if err := uc.checkKind(ctx, kind); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListTrashed(ctx, kind, page, pageSize)
+}
+
+// Restore 恢复记录，存在冲突的记录跳过并返回原因
+func (uc *RecycleUseCase) Restore(ctx context.Context, kind string, ids []int64) (int64, []*RecycleConflict, error) {
+	if err := uc.checkKind(ctx, kind); err != nil {
+		return 0, nil, err
+	}
+	var (
+		restored  int64
+		conflicts []*RecycleConflict
+	)
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		conflicts, err = uc.repo.FindRestoreConflicts(ctx, kind, ids)
+		if err != nil {
+			return err
+		}
+		skip := make(map[int64]struct{}, len(conflicts))
+		for _, c := range conflicts {
+			skip[c.ID] = struct{}{}
+		}
+		valid := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			if _, ok := skip[id]; !ok {
+				valid = append(valid, id)
+			}
+		}
+		if len(valid) == 0 {
+			return nil
+		}
+		restored, err = uc.repo.RestoreTrashed(ctx, kind, valid)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if restored > 0 {
+		if err := uc.authz.Invalidate(ctx, recycleTopics(kind)...); err != nil {
+			return 0, nil, err
+		}
+	}
+	return restored, conflicts, nil
+}
+
+// Purge 彻底删除记录
+func (uc *RecycleUseCase) Purge(ctx context.Context, kind string, ids []int64) (int64, error) {
+	if err := uc.checkKind(ctx, kind); err != nil {
+		return 0, err
+	}
+	var n int64
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		n, err = uc.repo.PurgeTrashed(ctx, kind, ids)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		if err := uc.authz.Invalidate(ctx, recycleTopics(kind)...); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// Cleanup 彻底删除超出保留期的记录，保留天数为 0 时不清理
+func (uc *RecycleUseCase) Cleanup(ctx context.Context) (int64, error) {
+	if uc.retentionDays <= 0 {
+		return 0, nil
+	}
+	before := time.Now().AddDate(0, 0, -int(uc.retentionDays))
+	var total int64
+	for _, kind := range RecycleKinds {
+		var purged int64
+		for {
+			ids, err := uc.repo.ListExpiredTrashIDs(ctx, kind, before, recycleCleanupBatch)
+			if err != nil {
+				return total, err
+			}
+			if len(ids) == 0 {
+				break
+			}
+			var n int64
+			err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+				n, err = uc.repo.PurgeTrashed(ctx, kind, ids)
+				return err
+			})
+			if err != nil {
+				return total, err
+			}
+			purged += n
+			if len(ids) < recycleCleanupBatch {
+				break
+			}
+		}
+		if purged > 0 {
+			total += purged
+			if err := uc.authz.Invalidate(ctx, recycleTopics(kind)...); err != nil {
+				uc.log.Errorf("invalidate cache after recycle cleanup of %s failed: %v", kind, err)
+			}
+		}
+	}
+	return total, nil
+}
+
+// checkKind 权限为全局数据，仅平台租户可管理
+func (uc *RecycleUseCase) checkKind(ctx context.Context, kind string) error {
+	switch kind {
+	case RecycleUser, RecycleRole, RecycleDept:
+		return nil
+	case RecyclePermission:
+		if auth.GetTenantID(ctx) != systemTenantID {
+			return ErrRecycleForbidden
+		}
+		return nil
+	}
+	return ErrRecycleKindInvalid
+}
+
+// recycleTopics 恢复或彻底删除后需要刷新的权限缓存
+func recycleTopics(kind string) []string {
+	switch kind {
+	case RecycleUser:
+		return []string{TopicPolicy}
+	case RecycleRole:
+		return []string{TopicPolicy, TopicDataScope}
+	case RecycleDept:
+		return []string{TopicDataScope}
+	case RecyclePermission:
+		return []string{TopicPermission, TopicPackage, TopicPolicy}
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+var (
+	ErrRoleNotFound           = kerrors.NotFound("ROLE_NOT_FOUND", "角色不存在")
+	ErrDeptInvalid            = kerrors.BadRequest("DEPT_INVALID", "部门不存在或无权访问")
+	ErrUserInvalid            = kerrors.BadRequest("USER_INVALID", "用户不存在或无权访问")
+	ErrPermissionInvalid      = kerrors.BadRequest("PERMISSION_INVALID", "权限码不存在")
+	ErrDataScopeInvalid       = kerrors.BadRequest("DATA_SCOPE_INVALID", "数据范围错误")
+	ErrConditionInvalid       = kerrors.BadRequest("CONDITION_INVALID", "授权条件表达式错误")
+	ErrPermissionOutOfPackage = kerrors.Forbidden("PACKAGE_LIMIT", "您的租户套餐暂不支持此功能")
+	ErrEffectInvalid          = kerrors.BadRequest("EFFECT_INVALID", "授权效果错误")
+	ErrRoleInheritCycle       = kerrors.BadRequest("ROLE_INHERIT_CYCLE", "角色继承关系存在循环")
+	ErrExpireAtInvalid        = kerrors.BadRequest("EXPIRE_AT_INVALID", "到期时间必须晚于当前时间")
+	ErrRoleCodeInvalid        = kerrors.BadRequest("ROLE_CODE_INVALID", "角色编码不能为空或纯数字")
+	ErrAdminRoleForbidden     = kerrors.Forbidden("ADMIN_ROLE_FORBIDDEN", "仅超级管理员可分配或继承管理员角色")
+)
+
+type SysRole struct {
+	ID       int64
+	TenantID int64
+	Name     string
+	Code     string
+}
+
+type RoleRepo interface {
+	GetRoleByID(ctx context.Context, id int64) (*SysRole, error)
+	// ListRolesByIDs 查询当前租户（及数据范围）内的角色
+	ListRolesByIDs(ctx context.Context, ids []int64) ([]*SysRole, error)
+	// ListRoles 查询当前租户（及数据范围）内的全部角色
+	ListRoles(ctx context.Context) ([]*SysRole, error)
+	// CountDepts 统计当前租户（及数据范围）内存在的部门数量
+	CountDepts(ctx context.Context, ids []int64) (int64, error)
+	// ExistsUser 用户是否存在于当前租户（及数据范围）内
+	ExistsUser(ctx context.Context, id int64) (bool, error)
+	// CountPermissions 统计存在的权限码数量
+	CountPermissions(ctx context.Context, codes []string) (int64, error)
+	// ReplaceRoleDepts 覆盖角色的自定义数据范围部门
+	ReplaceRoleDepts(ctx context.Context, roleID int64, deptIDs []int64) error
+	// LockRoleParents 锁定并查询当前租户的角色继承关系，须在事务中调用，Key 为角色 ID，Value 为父角色 ID
+	LockRoleParents(ctx context.Context) (map[int64][]int64, error)
+}
+
+type RoleUseCase struct {
+	repo        RoleRepo
+	tx          Transaction
+	policy      PolicyRepo
+	authz       *AuthzUseCase
+	packages    *provider.PackageProvider
+	multiTenant bool
+	log         *log.Helper
+}
+
+func NewRoleUseCase(
+	repo RoleRepo,
+	tx Transaction,
+	policy PolicyRepo,
+	authz *AuthzUseCase,
+	packages *provider.PackageProvider,
+	c *conf.App,
+	logger log.Logger,
+) *RoleUseCase {
+	return &RoleUseCase{
+		repo:        repo,
+		tx:          tx,
+		policy:      policy,
+		authz:       authz,
+		packages:    packages,
+		multiTenant: c.EnableMultiTenant,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// AssignRoleDepts 设置角色的自定义数据范围部门（data_scope 为 CUSTOM 时生效）
+func (uc *RoleUseCase) AssignRoleDepts(ctx context.Context, roleID int64, deptIDs []int64) error {
+	if _, err := uc.repo.GetRoleByID(ctx, roleID); err != nil {
+		return err
+	}
+
+	deptIDs = uniqueIDs(deptIDs)
+	if len(deptIDs) > 0 {
+		count, err := uc.repo.CountDepts(ctx, deptIDs)
+		if err != nil {
+			return err
+		}
+		if count != int64(len(deptIDs)) {
+			return ErrDeptInvalid
+		}
+	}
+
+	if err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		return uc.repo.ReplaceRoleDepts(ctx, roleID, deptIDs)
+	}); err != nil {
+		return err
+	}
+
+	return uc.authz.Invalidate(ctx, TopicDataScope)
+}
+
+// GrantRolePermissions 覆盖角色的授权（权限码及数据范围）
+func (uc *RoleUseCase) GrantRolePermissions(ctx context.Context, roleID int64, grants []*RoleGrant) error {
+	role, err := uc.repo.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(grants))
+	codes := make([]string, 0, len(grants))
+	for _, g := range grants {
+		if !auth.IsValidScope(g.DataScope) {
+			return ErrDataScopeInvalid
+		}
+		if g.Effect != "" && g.Effect != pkgCasbin.EffectAllow && g.Effect != pkgCasbin.EffectDeny {
+			return ErrEffectInvalid
+		}
+		if err := pkgCasbin.ValidateCondition(g.Condition); err != nil {
+			return ErrConditionInvalid.WithCause(err)
+		}
+		if _, ok := seen[g.PermCode]; ok {
+			return ErrPermissionInvalid
+		}
+		seen[g.PermCode] = struct{}{}
+		codes = append(codes, g.PermCode)
+	}
+	if len(codes) > 0 {
+		count, err := uc.repo.CountPermissions(ctx, codes)
+		if err != nil {
+			return err
+		}
+		if count != int64(len(codes)) {
+			return ErrPermissionInvalid
+		}
+	}
+
+	// 多租户模式下授权不能超出租户套餐边界
+	tenantID := auth.GetTenantID(ctx)
+	if uc.multiTenant {
+		for _, code := range codes {
+			if !uc.packages.IsTenantPermAllowed(tenantID, code) {
+				return ErrPermissionOutOfPackage
+			}
+		}
+	}
+
+	return uc.policy.SetRolePermissions(ctx, tenantID, role.Code, grants)
+}
+
+// AssignUserRoles 覆盖用户的角色，expireAt 为空表示永久
+func (uc *RoleUseCase) AssignUserRoles(ctx context.Context, userID int64, roleIDs []int64, expireAt *time.Time) error {
+	if expireAt != nil && !expireAt.After(time.Now()) {
+		return ErrExpireAtInvalid
+	}
+	ok, err := uc.repo.ExistsUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUserInvalid
+	}
+
+	codes, err := uc.roleCodes(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	return uc.policy.SetUserRoles(ctx, auth.GetTenantID(ctx), userID, codes, expireAt)
+}
+
+// SetRoleParents 覆盖角色继承的父角色，角色拥有父角色（及其祖先）的全部授权
+func (uc *RoleUseCase) SetRoleParents(ctx context.Context, roleID int64, parentIDs []int64) error {
+	role, err := uc.repo.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateRoleCode(role.Code); err != nil {
+		return err
+	}
+	parentIDs = uniqueIDs(parentIDs)
+	codes, err := uc.roleCodes(ctx, parentIDs)
+	if err != nil {
+		return err
+	}
+
+	// 检查与写入在同一事务中并锁定继承关系，避免并发修改各自通过检查后共同形成循环
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		edges, err := uc.repo.LockRoleParents(ctx)
+		if err != nil {
+			return err
+		}
+		edges[roleID] = parentIDs
+		if hasInheritCycle(edges, roleID) {
+			return ErrRoleInheritCycle
+		}
+		return uc.policy.SetRoleParents(ctx, auth.GetTenantID(ctx), role.Code, codes)
+	})
+}
+
+// roleCodes 查询待分配或继承的角色编码，管理员角色仅超级管理员可分配
+func (uc *RoleUseCase) roleCodes(ctx context.Context, roleIDs []int64) ([]string, error) {
+	roleIDs = uniqueIDs(roleIDs)
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+	roles, err := uc.repo.ListRolesByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) != len(roleIDs) {
+		return nil, ErrRoleNotFound
+	}
+	codes := make([]string, 0, len(roles))
+	for _, r := range roles {
+		if err := ValidateRoleCode(r.Code); err != nil {
+			return nil, err
+		}
+		if r.Code == pkgCasbin.AdminRole && !uc.policy.IsAdmin(ctx, auth.GetUserID(ctx)) {
+			return nil, ErrAdminRoleForbidden
+		}
+		codes = append(codes, r.Code)
+	}
+	return codes, nil
+}
+
+// ValidateRoleCode 角色编码不能为纯数字：Casbin 分组规则中数字主体表示用户 ID
+func ValidateRoleCode(code string) error {
+	if code == "" {
+		return ErrRoleCodeInvalid
+	}
+	if _, err := strconv.ParseInt(code, 10, 64); err == nil {
+		return ErrRoleCodeInvalid
+	}
+	return nil
+}
+
+// PurgeExpiredUserRoles 回收已到期的用户角色
+func (uc *RoleUseCase) PurgeExpiredUserRoles(ctx context.Context) (int, error) {
+	return uc.policy.RemoveExpiredUserRoles(ctx)
+}
+
+// hasInheritCycle 从 roleID 出发沿父角色遍历，能回到自身即存在循环
+func hasInheritCycle(edges map[int64][]int64, roleID int64) bool {
+	visited := make(map[int64]struct{})
+	stack := append([]int64(nil), edges[roleID]...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == roleID {
+			return true
+		}
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		stack = append(stack, edges[id]...)
+	}
+	return false
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+type txKey struct{}
+
+// fakeTx 记录 Context 是否处于事务中
+type fakeTx struct{}
+
+func (fakeTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+func (t fakeTx) InNestedTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return t.InTx(ctx, fn)
+}
+
+func inTx(ctx context.Context) bool {
+	v, _ := ctx.Value(txKey{}).(bool)
+	return v
+}
+
+// fakeRoleRepo 角色 ID 即下标 + 1
+type fakeRoleRepo struct {
+	RoleRepo
+	roles   []*SysRole
+	parents map[int64][]int64
+	locked  bool
+}
+
+func (r *fakeRoleRepo) GetRoleByID(_ context.Context, id int64) (*SysRole, error) {
+	if id < 1 || int(id) > len(r.roles) {
+		return nil, ErrRoleNotFound
+	}
+	return r.roles[id-1], nil
+}
+
+func (r *fakeRoleRepo) ListRolesByIDs(ctx context.Context, ids []int64) ([]*SysRole, error) {
+	var list []*SysRole
+	for _, id := range ids {
+		if role, err := r.GetRoleByID(ctx, id); err == nil {
+			list = append(list, role)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeRoleRepo) ExistsUser(context.Context, int64) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeRoleRepo) LockRoleParents(ctx context.Context) (map[int64][]int64, error) {
+	r.locked = inTx(ctx)
+	edges := make(map[int64][]int64, len(r.parents))
+	for k, v := range r.parents {
+		edges[k] = slices.Clone(v)
+	}
+	return edges, nil
+}
+
+// fakePolicy 记录写入的角色编码，admins 为超级管理员
+type fakePolicy struct {
+	PolicyRepo
+	admins  []int64
+	written []string
+	inTx    bool
+}
+
+func (p *fakePolicy) IsAdmin(_ context.Context, userID int64) bool {
+	return slices.Contains(p.admins, userID)
+}
+
+func (p *fakePolicy) SetUserRoles(_ context.Context, _, _ int64, codes []string, _ *time.Time) error {
+	p.written = codes
+	return nil
+}
+
+func (p *fakePolicy) SetRoleParents(ctx context.Context, _ int64, _ string, codes []string) error {
+	p.written, p.inTx = codes, inTx(ctx)
+	return nil
+}
+
+func newRoleTestUseCase() (*RoleUseCase, *fakeRoleRepo, *fakePolicy) {
+	repo := &fakeRoleRepo{
+		roles: []*SysRole{
+			{ID: 1, TenantID: 1, Code: "admin"},
+			{ID: 2, TenantID: 1, Code: "editor"},
+			{ID: 3, TenantID: 1, Code: "viewer"},
+			{ID: 4, TenantID: 1, Code: "123"},
+		},
+		parents: map[int64][]int64{3: {2}},
+	}
+	policy := &fakePolicy{admins: []int64{1}}
+	uc := &RoleUseCase{repo: repo, tx: fakeTx{}, policy: policy, log: log.NewHelper(log.DefaultLogger)}
+	return uc, repo, policy
+}
+
+func userContext(userID int64) context.Context {
+	return auth.NewContext(context.Background(), auth.ContextInfo{TenantID: 1, UserID: userID})
+}
+
+func TestAssignUserRoles(t *testing.T) {
+	uc, _, policy := newRoleTestUseCase()
+
+	if err := uc.AssignUserRoles(userContext(2), 10, []int64{2, 3, 2}, nil); err != nil || !slices.Equal(policy.written, []string{"editor", "viewer"}) {
+		t.Fatalf("assign = %v, %v", policy.written, err)
+	}
+	// 管理员角色仅超级管理员可分配
+	if err := uc.AssignUserRoles(userContext(2), 10, []int64{1, 2}, nil); !errors.Is(err, ErrAdminRoleForbidden) {
+		t.Fatalf("assign admin by tenant admin: %v", err)
+	}
+	if err := uc.AssignUserRoles(userContext(1), 10, []int64{1}, nil); err != nil {
+		t.Fatalf("assign admin by super admin: %v", err)
+	}
+	// 纯数字编码会被当作用户 ID
+	if err := uc.AssignUserRoles(userContext(1), 10, []int64{4}, nil); !errors.Is(err, ErrRoleCodeInvalid) {
+		t.Fatalf("assign numeric role: %v", err)
+	}
+}
+
+func TestSetRoleParents(t *testing.T) {
+	uc, repo, policy := newRoleTestUseCase()
+
+	if err := uc.SetRoleParents(userContext(2), 2, []int64{3}); !errors.Is(err, ErrRoleInheritCycle) {
+		t.Fatalf("cycle: %v", err)
+	}
+	if !repo.locked {
+		t.Fatal("inherit edges read outside the write transaction")
+	}
+	if err := uc.SetRoleParents(userContext(2), 3, []int64{2}); err != nil || !policy.inTx {
+		t.Fatalf("set parents = %v, in tx %v", err, policy.inTx)
+	}
+	if err := uc.SetRoleParents(userContext(2), 2, []int64{1}); !errors.Is(err, ErrAdminRoleForbidden) {
+		t.Fatalf("inherit admin by tenant admin: %v", err)
+	}
+	if err := uc.SetRoleParents(userContext(1), 2, []int64{1}); err != nil {
+		t.Fatalf("inherit admin by super admin: %v", err)
+	}
+	if err := uc.SetRoleParents(userContext(1), 4, nil); !errors.Is(err, ErrRoleCodeInvalid) {
+		t.Fatalf("numeric role: %v", err)
+	}
+}
+
+func TestValidateRoleCode(t *testing.T) {
+	for code, valid := range map[string]bool{"editor": true, "dept_1": true, "": false, "123": false, "-1": false} {
+		if err := ValidateRoleCode(code); (err == nil) != valid {
+			t.Errorf("ValidateRoleCode(%q) = %v", code, err)
+		}
+	}
+}
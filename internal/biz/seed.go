@@ -0,0 +1,451 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// 初始化数据的默认值
+const (
+	seedPackageID   = int64(1)
+	seedPackageName = "全功能版套餐"
+	seedTenantCode  = "system"
+	// seedAdminRole Casbin 匹配器将租户 1 下拥有该角色的用户视为超级管理员
+	seedAdminRole = "admin"
+
+	// 超级管理员的用户名及密码，未设置密码时生成随机密码
+	SeedAdminUsernameEnv = "BUBBLE_ADMIN_USERNAME"
+	SeedAdminPasswordEnv = "BUBBLE_ADMIN_PASSWORD"
+)
+
+// SeedData 初始化数据，按租户编码、部门名称、角色编码、用户名等业务键判断是否已存在，已存在的数据不会被修改
+type SeedData struct {
+	Tenants   []*SeedTenant   `yaml:"tenants"`
+	Depts     []*SeedDept     `yaml:"depts"`
+	Roles     []*SeedRole     `yaml:"roles"`
+	Users     []*SeedUser     `yaml:"users"`
+	DictTypes []*SeedDictType `yaml:"dict_types"`
+	Configs   []*SeedConfig   `yaml:"configs"`
+}
+
+// SeedTenant 租户，使用全功能版套餐
+type SeedTenant struct {
+	ID   int64  `yaml:"-"` // 为 0 时自动生成
+	Code string `yaml:"code"`
+	Name string `yaml:"name"`
+}
+
+// SeedDept 部门，上级部门需先于下级部门声明
+type SeedDept struct {
+	Tenant string `yaml:"tenant"` // 租户编码，默认 system
+	Name   string `yaml:"name"`
+	Parent string `yaml:"parent"` // 上级部门名称，为空表示顶级部门
+	Sort   int32  `yaml:"sort"`
+}
+
+// SeedRole 角色及授权的权限码
+type SeedRole struct {
+	Tenant      string   `yaml:"tenant"`
+	Code        string   `yaml:"code"`
+	Name        string   `yaml:"name"`
+	Permissions []string `yaml:"permissions"`
+	DataScope   string   `yaml:"data_scope"` // 授权的数据范围，默认 ALL
+}
+
+// SeedUser 用户，未填写密码时生成随机密码
+type SeedUser struct {
+	Tenant   string   `yaml:"tenant"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	Name     string   `yaml:"name"`
+	Mobile   string   `yaml:"mobile"`
+	Email    string   `yaml:"email"`
+	Dept     string   `yaml:"dept"` // 部门名称
+	Roles    []string `yaml:"roles"`
+}
+
+// SeedDictType 全局字典类型及字典项
+type SeedDictType struct {
+	Code  string          `yaml:"code"`
+	Name  string          `yaml:"name"`
+	Items []*SeedDictItem `yaml:"items"`
+}
+
+type SeedDictItem struct {
+	Label string `yaml:"label"`
+	Value string `yaml:"value"`
+	Sort  int32  `yaml:"sort"`
+	Color string `yaml:"color"`
+}
+
+// SeedConfig 全局系统参数
+type SeedConfig struct {
+	Key       string `yaml:"key"`
+	Name      string `yaml:"name"`
+	ValueType string `yaml:"value_type"`
+	Value     string `yaml:"value"`
+	Remark    string `yaml:"remark"`
+}
+
+// SeedResult 初始化结果
+type SeedResult struct {
+	Created map[string]int // 各类数据新建的数量
+	// Passwords 本次新建且未指定密码的用户的随机密码，Key 为 租户编码/用户名，仅在此时可见
+	Passwords map[string]string
+}
+
+func (r *SeedResult) add(kind string, created bool) {
+	if created {
+		r.Created[kind]++
+	}
+}
+
+type SeedRepo interface {
+	EnsurePackage(ctx context.Context, id int64, name string) (bool, error)
+	// GrantPackagePermissions 将全部权限加入套餐，返回新增的数量
+	GrantPackagePermissions(ctx context.Context, packageID int64) (int, error)
+	// EnsureTenant 按编码查找租户，不存在时创建，返回租户 ID 及是否新建，下同
+	EnsureTenant(ctx context.Context, t *SeedTenant, packageID int64) (int64, bool, error)
+	// EnsureDept 按租户、上级部门及名称查找部门
+	EnsureDept(ctx context.Context, tenantID, parentID int64, name string, sort int32) (int64, bool, error)
+	// EnsureRole 按租户及编码查找角色
+	EnsureRole(ctx context.Context, tenantID int64, code, name string) (int64, bool, error)
+	// GrantRolePermissions 为角色授权尚未授权的权限码，返回新增的数量
+	GrantRolePermissions(ctx context.Context, tenantID, roleID int64, codes []string, dataScope string) (int, error)
+	// FindUserID 按租户及用户名查找用户，不存在时返回 0
+	FindUserID(ctx context.Context, tenantID int64, username string) (int64, error)
+	CreateUser(ctx context.Context, user *SysUser) error
+	EnsureUserRole(ctx context.Context, tenantID, userID, roleID int64) (bool, error)
+	EnsureDictType(ctx context.Context, code, name string) (bool, error)
+	EnsureDictItem(ctx context.Context, typeCode string, item *SeedDictItem) (bool, error)
+	EnsureConfig(ctx context.Context, c *SeedConfig) (bool, error)
+}
+
+// SeedUseCase 初始化新安装的数据库：系统租户、根部门、超级管理员及权限，可重复执行
+type SeedUseCase struct {
+	repo  SeedRepo
+	tx    Transaction
+	perms *PermissionUseCase
+	authz *AuthzUseCase
+	log   *log.Helper
+}
+
+func NewSeedUseCase(repo SeedRepo, tx Transaction, perms *PermissionUseCase, authz *AuthzUseCase, logger log.Logger) *SeedUseCase {
+	return &SeedUseCase{
+		repo:  repo,
+		tx:    tx,
+		perms: perms,
+		authz: authz,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// DefaultSeedData 内置的初始化数据：系统租户、根部门、admin 角色、超级管理员及系统字典
+func DefaultSeedData() *SeedData {
+	username := os.Getenv(SeedAdminUsernameEnv)
+	if username == "" {
+		username = "root"
+	}
+	return &SeedData{
+		Tenants: []*SeedTenant{{ID: systemTenantID, Code: seedTenantCode, Name: "系统管理总部"}},
+		Depts:   []*SeedDept{{Name: "总经办"}},
+		Roles:   []*SeedRole{{Code: seedAdminRole, Name: "系统超级管理员"}},
+		Users: []*SeedUser{{
+			Username: username,
+			Password: os.Getenv(SeedAdminPasswordEnv),
+			Name:     "超级管理员",
+			Dept:     "总经办",
+			Roles:    []string{seedAdminRole},
+		}},
+		DictTypes: []*SeedDictType{
+			{Code: "sys_user_status", Name: "用户状态", Items: []*SeedDictItem{
+				{Label: "启用", Value: "1", Sort: 1, Color: "success"},
+				{Label: "禁用", Value: "2", Sort: 2, Color: "danger"},
+			}},
+			{Code: "sys_tenant_status", Name: "租户状态", Items: []*SeedDictItem{
+				{Label: "正常", Value: "1", Sort: 1, Color: "success"},
+				{Label: "禁用", Value: "2", Sort: 2, Color: "danger"},
+			}},
+			{Code: "sys_data_scope", Name: "数据范围", Items: []*SeedDictItem{
+				{Label: "仅本人", Value: auth.ScopeSelf, Sort: 1},
+				{Label: "本部门", Value: auth.ScopeDept, Sort: 2},
+				{Label: "本部门及下级", Value: auth.ScopeDeptSub, Sort: 3},
+				{Label: "自定义", Value: auth.ScopeCustom, Sort: 4},
+				{Label: "全部", Value: auth.ScopeAll, Sort: 5},
+			}},
+		},
+	}
+}
+
+// LoadSeedFile 读取 YAML 格式的额外初始化数据
+func LoadSeedFile(path string) (*SeedData, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var data SeedData
+	if err := yaml.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("parse seed file %s: %w", path, err)
+	}
+	return &data, nil
+}
+
+// Merge 追加 other 中的数据
+func (d *SeedData) Merge(other *SeedData) {
+	if other == nil {
+		return
+	}
+	d.Tenants = append(d.Tenants, other.Tenants...)
+	d.Depts = append(d.Depts, other.Depts...)
+	d.Roles = append(d.Roles, other.Roles...)
+	d.Users = append(d.Users, other.Users...)
+	d.DictTypes = append(d.DictTypes, other.DictTypes...)
+	d.Configs = append(d.Configs, other.Configs...)
+}
+
+// Run 同步接口权限并写入初始化数据，已存在的数据保持不变
+func (uc *SeedUseCase) Run(ctx context.Context, perms []*ApiPermission, data *SeedData) (*SeedResult, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	// 权限树以 proto 注解为准
+	if err := uc.perms.SyncApiPermissions(ctx, perms); err != nil {
+		return nil, err
+	}
+
+	result := &SeedResult{Created: make(map[string]int), Passwords: make(map[string]string)}
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		created, err := uc.repo.EnsurePackage(ctx, seedPackageID, seedPackageName)
+		if err != nil {
+			return err
+		}
+		result.add("package", created)
+		n, err := uc.repo.GrantPackagePermissions(ctx, seedPackageID)
+		if err != nil {
+			return err
+		}
+		result.Created["package_permission"] += n
+
+		tenants, err := uc.seedTenants(ctx, data.Tenants, result)
+		if err != nil {
+			return err
+		}
+		depts, err := uc.seedDepts(ctx, tenants, data.Depts, result)
+		if err != nil {
+			return err
+		}
+		roles, err := uc.seedRoles(ctx, tenants, data.Roles, result)
+		if err != nil {
+			return err
+		}
+		if err := uc.seedUsers(ctx, tenants, depts, roles, data.Users, result); err != nil {
+			return err
+		}
+		if err := uc.seedDicts(ctx, data.DictTypes, result); err != nil {
+			return err
+		}
+		for _, c := range data.Configs {
+			if err := provider.ValidateConfigValue(c.ValueType, c.Value); err != nil {
+				return fmt.Errorf("seed config %s: %w", c.Key, err)
+			}
+			created, err := uc.repo.EnsureConfig(ctx, c)
+			if err != nil {
+				return fmt.Errorf("seed config %s: %w", c.Key, err)
+			}
+			result.add("config", created)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 通知运行中的节点重新加载权限缓存
+	if err := uc.authz.ReloadAll(ctx); err != nil {
+		uc.log.Warnf("reload authz cache failed: %v", err)
+	}
+	return result, nil
+}
+
+func (uc *SeedUseCase) seedTenants(ctx context.Context, list []*SeedTenant, result *SeedResult) (map[string]int64, error) {
+	tenants := make(map[string]int64, len(list))
+	for _, t := range list {
+		id, created, err := uc.repo.EnsureTenant(ctx, t, seedPackageID)
+		if err != nil {
+			return nil, fmt.Errorf("seed tenant %s: %w", t.Code, err)
+		}
+		tenants[t.Code] = id
+		result.add("tenant", created)
+	}
+	return tenants, nil
+}
+
+// seedKey 租户内的业务键
+func seedKey(tenant, name string) string {
+	return tenant + "/" + name
+}
+
+func seedTenant(tenants map[string]int64, code string) (string, int64, error) {
+	if code == "" {
+		code = seedTenantCode
+	}
+	id, ok := tenants[code]
+	if !ok {
+		return "", 0, fmt.Errorf("tenant %s not declared", code)
+	}
+	return code, id, nil
+}
+
+func (uc *SeedUseCase) seedDepts(ctx context.Context, tenants map[string]int64, list []*SeedDept, result *SeedResult) (map[string]int64, error) {
+	depts := make(map[string]int64, len(list))
+	for _, d := range list {
+		code, tenantID, err := seedTenant(tenants, d.Tenant)
+		if err != nil {
+			return nil, fmt.Errorf("seed dept %s: %w", d.Name, err)
+		}
+		var parentID int64
+		if d.Parent != "" {
+			id, ok := depts[seedKey(code, d.Parent)]
+			if !ok {
+				return nil, fmt.Errorf("seed dept %s: parent %s not declared", d.Name, d.Parent)
+			}
+			parentID = id
+		}
+		id, created, err := uc.repo.EnsureDept(ctx, tenantID, parentID, d.Name, d.Sort)
+		if err != nil {
+			return nil, fmt.Errorf("seed dept %s: %w", d.Name, err)
+		}
+		depts[seedKey(code, d.Name)] = id
+		result.add("dept", created)
+	}
+	return depts, nil
+}
+
+func (uc *SeedUseCase) seedRoles(ctx context.Context, tenants map[string]int64, list []*SeedRole, result *SeedResult) (map[string]int64, error) {
+	roles := make(map[string]int64, len(list))
+	for _, r := range list {
+		if err := ValidateRoleCode(r.Code); err != nil {
+			return nil, fmt.Errorf("seed role %q: %w", r.Code, err)
+		}
+		code, tenantID, err := seedTenant(tenants, r.Tenant)
+		if err != nil {
+			return nil, fmt.Errorf("seed role %s: %w", r.Code, err)
+		}
+		id, created, err := uc.repo.EnsureRole(ctx, tenantID, r.Code, r.Name)
+		if err != nil {
+			return nil, fmt.Errorf("seed role %s: %w", r.Code, err)
+		}
+		roles[seedKey(code, r.Code)] = id
+		result.add("role", created)
+
+		if len(r.Permissions) == 0 {
+			continue
+		}
+		scope := r.DataScope
+		if scope == "" {
+			scope = auth.ScopeAll
+		}
+		n, err := uc.repo.GrantRolePermissions(ctx, tenantID, id, r.Permissions, scope)
+		if err != nil {
+			return nil, fmt.Errorf("seed role %s: %w", r.Code, err)
+		}
+		result.Created["role_permission"] += n
+	}
+	return roles, nil
+}
+
+func (uc *SeedUseCase) seedUsers(ctx context.Context, tenants, depts, roles map[string]int64, list []*SeedUser, result *SeedResult) error {
+	for _, u := range list {
+		code, tenantID, err := seedTenant(tenants, u.Tenant)
+		if err != nil {
+			return fmt.Errorf("seed user %s: %w", u.Username, err)
+		}
+		var deptID int64
+		if u.Dept != "" {
+			id, ok := depts[seedKey(code, u.Dept)]
+			if !ok {
+				return fmt.Errorf("seed user %s: dept %s not declared", u.Username, u.Dept)
+			}
+			deptID = id
+		}
+
+		userID, err := uc.repo.FindUserID(ctx, tenantID, u.Username)
+		if err != nil {
+			return fmt.Errorf("seed user %s: %w", u.Username, err)
+		}
+		if userID == 0 {
+			password := u.Password
+			if password == "" {
+				if password, err = randomPassword(); err != nil {
+					return err
+				}
+				result.Passwords[seedKey(code, u.Username)] = password
+			}
+			hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+			if err != nil {
+				return err
+			}
+			user := &SysUser{
+				Username:     u.Username,
+				PasswordHash: string(hash),
+				Nickname:     u.Name,
+				Phone:        u.Mobile,
+				Email:        u.Email,
+				DeptID:       deptID,
+				TenantID:     tenantID,
+			}
+			if err := uc.repo.CreateUser(ctx, user); err != nil {
+				return fmt.Errorf("seed user %s: %w", u.Username, err)
+			}
+			userID = user.ID
+			result.add("user", true)
+		}
+
+		for _, role := range u.Roles {
+			roleID, ok := roles[seedKey(code, role)]
+			if !ok {
+				return fmt.Errorf("seed user %s: role %s not declared", u.Username, role)
+			}
+			created, err := uc.repo.EnsureUserRole(ctx, tenantID, userID, roleID)
+			if err != nil {
+				return fmt.Errorf("seed user %s: %w", u.Username, err)
+			}
+			result.add("user_role", created)
+		}
+	}
+	return nil
+}
+
+func (uc *SeedUseCase) seedDicts(ctx context.Context, list []*SeedDictType, result *SeedResult) error {
+	for _, t := range list {
+		created, err := uc.repo.EnsureDictType(ctx, t.Code, t.Name)
+		if err != nil {
+			return fmt.Errorf("seed dict %s: %w", t.Code, err)
+		}
+		result.add("dict_type", created)
+		for _, item := range t.Items {
+			created, err := uc.repo.EnsureDictItem(ctx, t.Code, item)
+			if err != nil {
+				return fmt.Errorf("seed dict %s: %w", t.Code, err)
+			}
+			result.add("dict_item", created)
+		}
+	}
+	return nil
+}
+
+// randomPassword 生成随机密码
+func randomPassword() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
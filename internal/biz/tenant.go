@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+)
+
+const (
+	tenantExpireTemplate = "tenant_expire"
+	// tenantExpireNoticeLockTTL 到期提醒任务的锁时长，需覆盖一次发送耗时
+	tenantExpireNoticeLockTTL = 10 * time.Minute
+)
+
+var (
+	ErrTenantNotFound      = kerrors.NotFound("TENANT_NOT_FOUND", "租户不存在")
+	ErrTenantStatusInvalid = kerrors.BadRequest("TENANT_STATUS_INVALID", "租户状态错误")
+	ErrTenantExpireInvalid = kerrors.BadRequest("TENANT_EXPIRE_INVALID", "过期时间须晚于当前时间")
+)
+
+type SysTenant struct {
+	ID         int64
+	Code       string
+	Name       string
+	PackageID  int64
+	ExpireTime time.Time
+	Status     int16
+	UpdatedBy  int64
+	UpdatedAt  time.Time
+	Version    int64
+}
+
+type TenantRepo interface {
+	GetTenantByID(ctx context.Context, id int64) (*SysTenant, error)
+	// UpdateStatus 修改租户状态，version 不为 0 时校验版本，返回修改后的版本号
+	UpdateStatus(ctx context.Context, id int64, status int16, version int64) (int64, error)
+	// UpdateExpireTime 修改租户过期时间，version 不为 0 时校验版本，返回修改后的版本号
+	UpdateExpireTime(ctx context.Context, id int64, expireTime time.Time, version int64) (int64, error)
+	// ListExpiringTenants 查询过期时间在 [from, to) 区间内的正常租户
+	ListExpiringTenants(ctx context.Context, from, to time.Time) ([]*SysTenant, error)
+	// ListTenantAdminEmails 查询租户管理员的邮箱
+	ListTenantAdminEmails(ctx context.Context, tenantID int64) ([]string, error)
+	// LockExpireNotice 获取到期提醒的分布式锁，已被其他节点持有时 ok 为 false
+	LockExpireNotice(ctx context.Context, ttl time.Duration) (unlock func(), ok bool, err error)
+	// IsExpireNotified 查询租户是否已按该过期时间提醒过
+	IsExpireNotified(ctx context.Context, tenantID int64, expireTime time.Time) (bool, error)
+	// MarkExpireNotified 记录租户已按该过期时间提醒
+	MarkExpireNotified(ctx context.Context, tenantID int64, expireTime time.Time, ttl time.Duration) error
+}
+
+type TenantUseCase struct {
+	repo     TenantRepo
+	tenants  *provider.TenantProvider
+	packages *provider.PackageProvider
+	authz    *AuthzUseCase
+	email    EmailSender
+	conf     *conf.App_Tenant
+	log      *log.Helper
+}
+
+func NewTenantUseCase(
+	repo TenantRepo,
+	tenants *provider.TenantProvider,
+	packages *provider.PackageProvider,
+	authz *AuthzUseCase,
+	email EmailSender,
+	c *conf.App,
+	logger log.Logger,
+) *TenantUseCase {
+	return &TenantUseCase{
+		repo:     repo,
+		tenants:  tenants,
+		packages: packages,
+		authz:    authz,
+		email:    email,
+		conf:     c.Tenant,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Get 查询租户
+func (uc *TenantUseCase) Get(ctx context.Context, id int64) (*SysTenant, error) {
+	return uc.repo.GetTenantByID(ctx, id)
+}
+
+// UpdateStatus 启用/禁用租户，version 不为 0 时校验版本，返回修改后的版本号
+func (uc *TenantUseCase) UpdateStatus(ctx context.Context, id int64, status int16, version int64) (int64, error) {
+	if status != provider.TenantStatusNormal && status != provider.TenantStatusDisabled {
+		return 0, ErrTenantStatusInvalid
+	}
+	if _, err := uc.repo.GetTenantByID(ctx, id); err != nil {
+		return 0, err
+	}
+	newVersion, err := uc.repo.UpdateStatus(ctx, id, status, version)
+	if err != nil {
+		return 0, err
+	}
+	return newVersion, uc.authz.Invalidate(ctx, TopicTenant, TopicPackage)
+}
+
+// Renew 续期租户，version 不为 0 时校验版本，返回修改后的版本号
+func (uc *TenantUseCase) Renew(ctx context.Context, id int64, expireTime time.Time, version int64) (int64, error) {
+	if !expireTime.After(time.Now()) {
+		return 0, ErrTenantExpireInvalid
+	}
+	if _, err := uc.repo.GetTenantByID(ctx, id); err != nil {
+		return 0, err
+	}
+	newVersion, err := uc.repo.UpdateExpireTime(ctx, id, expireTime, version)
+	if err != nil {
+		return 0, err
+	}
+	return newVersion, uc.authz.Invalidate(ctx, TopicTenant)
+}
+
+// Refresh 刷新本节点租户状态及套餐权限缓存（定时任务兜底）
+func (uc *TenantUseCase) Refresh(ctx context.Context) error {
+	if err := uc.tenants.Load(ctx); err != nil {
+		return err
+	}
+	return uc.packages.Load(ctx)
+}
+
+// NotifyExpiring 向即将到期租户的管理员发送邮件提醒，多节点下仅一个节点执行，同一过期时间只提醒一次
+func (uc *TenantUseCase) NotifyExpiring(ctx context.Context) error {
+	if uc.conf == nil || uc.conf.ExpireNoticeDays <= 0 {
+		return nil
+	}
+	unlock, ok, err := uc.repo.LockExpireNotice(ctx, tenantExpireNoticeLockTTL)
+	if err != nil || !ok {
+		return err
+	}
+	defer unlock()
+
+	now := time.Now()
+	tenants, err := uc.repo.ListExpiringTenants(ctx, now, now.AddDate(0, 0, int(uc.conf.ExpireNoticeDays)))
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tenants {
+		notified, err := uc.repo.IsExpireNotified(ctx, t.ID, t.ExpireTime)
+		if err != nil {
+			uc.log.Errorf("check expire notice of tenant %d failed: %v", t.ID, err)
+			continue
+		}
+		if notified {
+			continue
+		}
+		emails, err := uc.repo.ListTenantAdminEmails(ctx, t.ID)
+		if err != nil {
+			uc.log.Errorf("list admin emails of tenant %d failed: %v", t.ID, err)
+			continue
+		}
+		params := map[string]string{
+			"tenant_name": t.Name,
+			"expire_time": t.ExpireTime.Format(time.DateTime),
+			"days":        strconv.Itoa(int(math.Ceil(t.ExpireTime.Sub(now).Hours() / 24))),
+		}
+		sent := false
+		for _, to := range emails {
+			if err := uc.email.Send(ctx, to, tenantExpireTemplate, params); err != nil {
+				uc.log.Errorf("send tenant expire notice to %s failed: %v", to, err)
+				continue
+			}
+			sent = true
+		}
+		// 全部发送失败或暂无管理员邮箱时不记录，次日重试
+		if !sent {
+			continue
+		}
+		// 标记保留到过期后一天，过期时间变更后自然失效
+		if err := uc.repo.MarkExpireNotified(ctx, t.ID, t.ExpireTime, t.ExpireTime.Sub(now)+24*time.Hour); err != nil {
+			uc.log.Errorf("mark expire notice of tenant %d failed: %v", t.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+)
+
+// fakeTenantRepo 锁及提醒标记保存在内存中，多个用例共享时模拟多节点
+type fakeTenantRepo struct {
+	TenantRepo
+	tenants  []*SysTenant
+	emails   map[int64][]string
+	locked   bool
+	notified map[int64]time.Time
+}
+
+func (r *fakeTenantRepo) ListExpiringTenants(context.Context, time.Time, time.Time) ([]*SysTenant, error) {
+	return r.tenants, nil
+}
+
+func (r *fakeTenantRepo) ListTenantAdminEmails(_ context.Context, tenantID int64) ([]string, error) {
+	return r.emails[tenantID], nil
+}
+
+func (r *fakeTenantRepo) LockExpireNotice(context.Context, time.Duration) (func(), bool, error) {
+	if r.locked {
+		return nil, false, nil
+	}
+	r.locked = true
+	return func() { r.locked = false }, true, nil
+}
+
+func (r *fakeTenantRepo) IsExpireNotified(_ context.Context, tenantID int64, expireTime time.Time) (bool, error) {
+	t, ok := r.notified[tenantID]
+	return ok && t.Equal(expireTime), nil
+}
+
+func (r *fakeTenantRepo) MarkExpireNotified(_ context.Context, tenantID int64, expireTime time.Time, _ time.Duration) error {
+	r.notified[tenantID] = expireTime
+	return nil
+}
+
+// fakeEmail 记录收件人，fail 中的地址发送失败
+type fakeEmail struct {
+	sent []string
+	fail []string
+}
+
+func (e *fakeEmail) Send(_ context.Context, to, _ string, _ map[string]string) error {
+	if slices.Contains(e.fail, to) {
+		return errors.New("smtp unavailable")
+	}
+	e.sent = append(e.sent, to)
+	return nil
+}
+
+func TestNotifyExpiring(t *testing.T) {
+	expireTime := time.Now().Add(48 * time.Hour)
+	repo := &fakeTenantRepo{
+		tenants:  []*SysTenant{{ID: 1, Name: "a", ExpireTime: expireTime}, {ID: 2, Name: "b", ExpireTime: expireTime}},
+		emails:   map[int64][]string{1: {"a@example.com"}, 2: {"b@example.com"}},
+		notified: map[int64]time.Time{},
+	}
+	email := &fakeEmail{fail: []string{"b@example.com"}}
+	uc := &TenantUseCase{repo: repo, email: email, conf: &conf.App_Tenant{ExpireNoticeDays: 7}, log: log.NewHelper(log.DefaultLogger)}
+	ctx := context.Background()
+
+	// 其他节点持有锁时不发送
+	repo.locked = true
+	if err := uc.NotifyExpiring(ctx); err != nil || len(email.sent) != 0 {
+		t.Fatalf("locked by other node: sent %v, %v", email.sent, err)
+	}
+	repo.locked = false
+
+	if err := uc.NotifyExpiring(ctx); err != nil || !slices.Equal(email.sent, []string{"a@example.com"}) {
+		t.Fatalf("first run: sent %v, %v", email.sent, err)
+	}
+	if repo.locked {
+		t.Fatal("lock not released")
+	}
+	// 已提醒的租户不重复发送，发送失败的租户下次重试
+	email.fail = nil
+	if err := uc.NotifyExpiring(ctx); err != nil || !slices.Equal(email.sent, []string{"a@example.com", "b@example.com"}) {
+		t.Fatalf("second run: sent %v, %v", email.sent, err)
+	}
+	// 续期后按新的过期时间再次提醒
+	repo.tenants[0].ExpireTime = expireTime.Add(24 * time.Hour)
+	if err := uc.NotifyExpiring(ctx); err != nil || len(email.sent) != 3 || email.sent[2] != "a@example.com" {
+		t.Fatalf("after renew: sent %v, %v", email.sent, err)
+	}
+}
+
+func TestRenewExpireTime(t *testing.T) {
+	uc := &TenantUseCase{repo: &fakeTenantRepo{}, log: log.NewHelper(log.DefaultLogger)}
+	for _, expireTime := range []time.Time{time.Unix(0, 0), time.Now().Add(-time.Minute)} {
+		if _, err := uc.Renew(context.Background(), 1, expireTime, 0); !errors.Is(err, ErrTenantExpireInvalid) {
+			t.Errorf("renew to %v: %v", expireTime, err)
+		}
+	}
+}
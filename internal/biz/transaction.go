@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"sync"
+)
+
+// Transaction 事务接口
+type Transaction interface {
+	// InTx 在事务中执行 fn：ctx 已处于事务中时加入该事务，否则开启新事务
+	// fn 返回错误或 panic 时回滚整个事务
+	InTx(context.Context, func(ctx context.Context) error) error
+	// InNestedTx 在嵌套事务中执行 fn：ctx 已处于事务中时创建保存点，fn 出错只回滚到保存点，
+	// 外层事务可以继续；否则与 InTx 相同
+	InNestedTx(context.Context, func(ctx context.Context) error) error
+}
+
+// TxHooks 事务提交或回滚后执行的回调，由 Transaction 的实现创建并在事务结束时触发
+type TxHooks struct {
+	parent *TxHooks
+
+	mu            sync.Mutex
+	afterCommit   []func(ctx context.Context)
+	afterRollback []func(ctx context.Context)
+}
+
+type txHooksKey struct{}
+
+// WithTxHooks 为新事务（nested 为 false）或保存点（nested 为 true）创建回调集合
+func WithTxHooks(ctx context.Context, nested bool) (context.Context, *TxHooks) {
+	h := &TxHooks{}
+	if nested {
+		h.parent, _ = ctx.Value(txHooksKey{}).(*TxHooks)
+	}
+	return context.WithValue(ctx, txHooksKey{}, h), h
+}
+
+// Commit 事务已提交：执行提交回调；保存点释放时回调移交外层事务，随外层事务的结果执行
+func (h *TxHooks) Commit(ctx context.Context) {
+	commit, rollback := h.take()
+	if h.parent != nil {
+		h.parent.mu.Lock()
+		h.parent.afterCommit = append(h.parent.afterCommit, commit...)
+		h.parent.afterRollback = append(h.parent.afterRollback, rollback...)
+		h.parent.mu.Unlock()
+		return
+	}
+	for _, fn := range commit {
+		fn(ctx)
+	}
+}
+
+// Rollback 事务或保存点已回滚：执行回滚回调，丢弃提交回调
+func (h *TxHooks) Rollback(ctx context.Context) {
+	_, rollback := h.take()
+	for _, fn := range rollback {
+		fn(ctx)
+	}
+}
+
+func (h *TxHooks) take() (commit, rollback []func(ctx context.Context)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	commit, rollback = h.afterCommit, h.afterRollback
+	h.afterCommit, h.afterRollback = nil, nil
+	return commit, rollback
+}
+
+// AfterCommit 注册事务提交后执行的回调，用于发送通知、推送消息、刷新缓存等副作用
+// ctx 不在事务中时立即执行；回调收到的 ctx 不再包含事务
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	h, ok := ctx.Value(txHooksKey{}).(*TxHooks)
+	if !ok {
+		fn(ctx)
+		return
+	}
+	h.mu.Lock()
+	h.afterCommit = append(h.afterCommit, fn)
+	h.mu.Unlock()
+}
+
+// AfterRollback 注册事务（或所在的保存点）回滚后执行的回调，ctx 不在事务中时忽略
+func AfterRollback(ctx context.Context, fn func(ctx context.Context)) {
+	h, ok := ctx.Value(txHooksKey{}).(*TxHooks)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	h.afterRollback = append(h.afterRollback, fn)
+	h.mu.Unlock()
+}
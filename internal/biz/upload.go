@@ -15,6 +15,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/oss"
 )
 
@@ -29,17 +30,19 @@ var (
 
 // UploadUseCase 文件上传用例
 type UploadUseCase struct {
-	oss    oss.Storage
-	config *conf.App_Upload
-	log    *log.Helper
+	oss     oss.Storage
+	config  *conf.App_Upload
+	configs *provider.ConfigProvider
+	log     *log.Helper
 }
 
 // NewUploadUseCase 创建文件上传用例
-func NewUploadUseCase(oss oss.Storage, c *conf.App, logger log.Logger) *UploadUseCase {
+func NewUploadUseCase(oss oss.Storage, c *conf.App, configs *provider.ConfigProvider, logger log.Logger) *UploadUseCase {
 	return &UploadUseCase{
-		oss:    oss,
-		config: c.Upload,
-		log:    log.NewHelper(logger),
+		oss:     oss,
+		config:  c.Upload,
+		configs: configs,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -64,7 +67,7 @@ type UploadFileResult struct {
 // UploadFile 上传文件
 func (uc *UploadUseCase) UploadFile(ctx context.Context, input *UploadFileInput) (*UploadFileResult, error) {
 	// 1. 获取场景配置
-	sceneConfig, ok := uc.config.Scenes[input.Scene]
+	sceneConfig, ok := uc.scene(ctx, input.Scene)
 	if !ok {
 		uc.log.Errorf("Upload scene not configured: %s", input.Scene)
 		return nil, ErrorUploadSceneNotFound
@@ -110,6 +113,19 @@ func (uc *UploadUseCase) UploadFile(ctx context.Context, input *UploadFileInput)
 	}, nil
 }
 
+// scene 场景配置，优先读取系统参数，未配置时使用 config.yaml
+func (uc *UploadUseCase) scene(ctx context.Context, scene string) (*conf.App_Upload_Scene, bool) {
+	cfg, ok, err := lookupScene(ctx, uc.configs, ConfigKeyUploadScenes, scene, func() *conf.App_Upload_Scene { return &conf.App_Upload_Scene{} })
+	if err != nil {
+		uc.log.Warnf("invalid system config %s: %v", ConfigKeyUploadScenes, err)
+	}
+	if ok {
+		return cfg, true
+	}
+	cfg, ok = uc.config.Scenes[scene]
+	return cfg, ok
+}
+
 // verifyFileType 验证文件类型
 func (uc *UploadUseCase) verifyFileType(input *UploadFileInput, allowedTypes []string) error {
 	if len(allowedTypes) == 0 {
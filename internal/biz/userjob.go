@@ -0,0 +1,646 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/oss"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/sheet"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// 单次导入的最大行数（不含表头）
+	maxUserImportRows = 10000
+	// 单次导出的最大条数
+	maxUserExport = 50000
+	// 同时执行的任务数，其余任务排队
+	maxRunningUserJobs = 2
+	// 每处理多少行更新一次进度
+	userJobProgressEvery = 100
+	// 超过该时长未更新的任务视为已中断（如节点重启）
+	userJobStaleAfter = 30 * time.Minute
+	// 导入文件使用的上传场景
+	userImportScene = "user_import"
+)
+
+// 任务类型
+const (
+	UserJobImport = 1
+	UserJobExport = 2
+)
+
+// 任务状态
+const (
+	UserJobPending   = 1
+	UserJobRunning   = 2
+	UserJobSucceeded = 3
+	UserJobFailed    = 4
+)
+
+var (
+	ErrUserJobNotFound    = kerrors.NotFound("USER_JOB_NOT_FOUND", "任务不存在")
+	ErrUserJobFailed      = kerrors.InternalServer("USER_JOB_FAILED", "任务执行失败")
+	ErrUserImportFormat   = kerrors.BadRequest("USER_IMPORT_FORMAT", "仅支持 CSV 或 XLSX 文件")
+	ErrUserImportParse    = kerrors.BadRequest("USER_IMPORT_PARSE", "文件解析失败，请检查文件内容")
+	ErrUserImportHeader   = kerrors.BadRequest("USER_IMPORT_HEADER", "文件表头缺少用户名或部门列")
+	ErrUserImportEmpty    = kerrors.BadRequest("USER_IMPORT_EMPTY", "文件中没有数据")
+	ErrUserImportTooLarge = kerrors.BadRequest("USER_IMPORT_TOO_LARGE", fmt.Sprintf("单次最多导入 %d 行", maxUserImportRows))
+	ErrUserExportTooLarge = kerrors.BadRequest("USER_EXPORT_TOO_LARGE", "导出数据过多，请缩小筛选范围")
+
+	// errImportRoleAssign 用户已写入但角色分配失败，计为失败行
+	errImportRoleAssign = errors.New("用户已创建，角色分配失败")
+)
+
+// 导入导出文件的列，导出文件可直接用于导入
+const (
+	colUsername = "用户名"
+	colName     = "姓名"
+	colPhone    = "手机号"
+	colEmail    = "邮箱"
+	colDept     = "部门"
+	colRoles    = "角色"
+	colStatus   = "状态"
+	colPassword = "密码" // 仅导入
+)
+
+var userSheetHeader = []string{colUsername, colName, colPhone, colEmail, colDept, colRoles, colStatus}
+
+// 状态列取值，导入时留空视为启用
+const (
+	userStatusEnabledText  = "启用"
+	userStatusDisabledText = "禁用"
+)
+
+var (
+	usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.@-]{3,20}$`)
+	phonePattern    = regexp.MustCompile(`^1[3-9]\d{9}$`)
+)
+
+type UserJob struct {
+	ID         int64
+	Type       int16
+	Status     int16
+	DryRun     bool
+	Format     string
+	FileKey    string
+	ResultKey  string
+	ResultURL  string // 结果文件的临时下载地址，仅查询时填充
+	Params     string
+	Total      int
+	Succeeded  int
+	Failed     int
+	Message    string
+	CreatedBy  int64
+	CreatedAt  time.Time
+	FinishedAt time.Time
+}
+
+type UserJobRepo interface {
+	CreateUserJob(ctx context.Context, job *UserJob) error
+	// GetUserJob 查询当前租户（及数据范围）内的任务
+	GetUserJob(ctx context.Context, id int64) (*UserJob, error)
+	// StartUserJob 将排队中的任务置为执行中，任务已不在排队状态时返回 false
+	StartUserJob(ctx context.Context, id int64) (bool, error)
+	// UpdateUserJob 保存任务的状态、进度与结果
+	UpdateUserJob(ctx context.Context, job *UserJob) error
+	// FailStaleUserJobs 将所有租户中早于 before 未更新的未完成任务置为失败
+	FailStaleUserJobs(ctx context.Context, before time.Time, message string) (int64, error)
+}
+
+type UserJobUseCase struct {
+	repo       UserJobRepo
+	users      SysUserRepo
+	depts      DeptRepo
+	roles      RoleRepo
+	policy     PolicyRepo
+	upload     *UploadUseCase
+	oss        oss.Storage
+	urlExpires time.Duration
+	sem        chan struct{}
+	log        *log.Helper
+}
+
+func NewUserJobUseCase(
+	repo UserJobRepo,
+	users SysUserRepo,
+	depts DeptRepo,
+	roles RoleRepo,
+	policy PolicyRepo,
+	upload *UploadUseCase,
+	storage oss.Storage,
+	c *conf.App,
+	logger log.Logger,
+) *UserJobUseCase {
+	urlExpires := time.Hour
+	if c.GetUpload().GetPrivateUrlExpires() != nil {
+		urlExpires = c.Upload.PrivateUrlExpires.AsDuration()
+	}
+	return &UserJobUseCase{
+		repo:       repo,
+		users:      users,
+		depts:      depts,
+		roles:      roles,
+		policy:     policy,
+		upload:     upload,
+		oss:        storage,
+		urlExpires: urlExpires,
+		sem:        make(chan struct{}, maxRunningUserJobs),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Import 上传导入文件并创建导入任务，dryRun 为 true 时只校验不写入
+func (uc *UserJobUseCase) Import(ctx context.Context, input *UploadFileInput, dryRun bool) (int64, error) {
+	format := sheet.FormatOf(input.Name)
+	if format == "" {
+		return 0, ErrUserImportFormat
+	}
+	input.Scene = userImportScene
+	file, err := uc.upload.UploadFile(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+
+	job := &UserJob{Type: UserJobImport, Status: UserJobPending, DryRun: dryRun, Format: format, FileKey: file.FileKey}
+	if err := uc.repo.CreateUserJob(ctx, job); err != nil {
+		return 0, err
+	}
+	uc.start(ctx, job, uc.importUsers)
+	return job.ID, nil
+}
+
+// Export 创建导出任务
+func (uc *UserJobUseCase) Export(ctx context.Context, filter *UserFilter, format string) (int64, error) {
+	params, err := json.Marshal(filter)
+	if err != nil {
+		return 0, err
+	}
+	job := &UserJob{Type: UserJobExport, Status: UserJobPending, Format: format, Params: string(params)}
+	if err := uc.repo.CreateUserJob(ctx, job); err != nil {
+		return 0, err
+	}
+	uc.start(ctx, job, uc.exportUsers)
+	return job.ID, nil
+}
+
+// GetJob 查询任务，仅发起人可见
+func (uc *UserJobUseCase) GetJob(ctx context.Context, id int64) (*UserJob, error) {
+	// 客户端创建任务后立即轮询进度，读取主库
+	job, err := uc.repo.GetUserJob(WithPrimary(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	if job.CreatedBy != auth.GetUserID(ctx) {
+		return nil, ErrUserJobNotFound
+	}
+	if job.ResultKey != "" {
+		job.ResultURL = uc.oss.GenerateURL(ctx, job.ResultKey, true, uc.urlExpires)
+	}
+	return job, nil
+}
+
+// FailStaleJobs 将长时间未更新的任务置为失败
+func (uc *UserJobUseCase) FailStaleJobs(ctx context.Context) (int64, error) {
+	return uc.repo.FailStaleUserJobs(ctx, time.Now().Add(-userJobStaleAfter), "任务中断或超时，请重新发起")
+}
+
+// start 在后台执行任务，任务沿用发起人的身份与数据范围，不受请求生命周期影响
+func (uc *UserJobUseCase) start(ctx context.Context, job *UserJob, fn func(context.Context, *UserJob) error) {
+	ctx = auth.NewContext(context.Background(), auth.GetContextInfo(ctx))
+	go func() {
+		uc.sem <- struct{}{}
+		defer func() { <-uc.sem }()
+		uc.run(ctx, job, fn)
+	}()
+}
+
+func (uc *UserJobUseCase) run(ctx context.Context, job *UserJob, fn func(context.Context, *UserJob) error) {
+	ok, err := uc.repo.StartUserJob(ctx, job.ID)
+	if err != nil {
+		uc.log.Errorf("start user job %d failed: %v", job.ID, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	job.Status = UserJobRunning
+
+	err = func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return fn(ctx, job)
+	}()
+
+	job.Status = UserJobSucceeded
+	if err != nil {
+		uc.log.Errorf("user job %d failed: %v", job.ID, err)
+		job.Status = UserJobFailed
+		job.Message = ErrUserJobFailed.Message
+		if se := new(kerrors.Error); errors.As(err, &se) && se.Code < 500 {
+			job.Message = se.Message
+		}
+	}
+	job.FinishedAt = time.Now()
+	if err := uc.repo.UpdateUserJob(ctx, job); err != nil {
+		uc.log.Errorf("save user job %d failed: %v", job.ID, err)
+	}
+}
+
+// userImportRow 导入文件中的一行数据
+type userImportRow struct {
+	line     int // 文件中的行号，表头为第 1 行
+	cells    []string
+	user     *SysUser
+	deptPath string
+	password string
+	roles    []string
+	errs     []string
+}
+
+func (uc *UserJobUseCase) importUsers(ctx context.Context, job *UserJob) error {
+	rc, err := uc.oss.Download(ctx, job.FileKey)
+	if err != nil {
+		return err
+	}
+	rows, err := sheet.Read(rc, job.Format, maxUserImportRows+1)
+	_ = rc.Close()
+	if errors.Is(err, sheet.ErrTooManyRows) {
+		return ErrUserImportTooLarge
+	}
+	if err != nil {
+		return ErrUserImportParse.WithCause(err)
+	}
+	if len(rows) == 0 {
+		return ErrUserImportEmpty
+	}
+
+	// 按表头名称定位列，未知列忽略，因此错误报告可修改后直接重新导入
+	header := rows[0]
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		if _, ok := cols[h]; !ok {
+			cols[h] = i
+		}
+	}
+	if _, ok := cols[colUsername]; !ok {
+		return ErrUserImportHeader
+	}
+	if _, ok := cols[colDept]; !ok {
+		return ErrUserImportHeader
+	}
+
+	var list []*userImportRow
+	for i, cells := range rows[1:] {
+		if isBlankRow(cells) {
+			continue
+		}
+		list = append(list, parseUserImportRow(i+2, cells, cols))
+	}
+	if len(list) == 0 {
+		return ErrUserImportEmpty
+	}
+	job.Total = len(list)
+
+	if err := uc.checkUserImportRows(ctx, list); err != nil {
+		return err
+	}
+
+	tenantID := auth.GetTenantID(ctx)
+	var failed []*userImportRow
+	for i, row := range list {
+		if len(row.errs) == 0 && !job.DryRun {
+			if err := uc.createImportedUser(ctx, tenantID, row); err != nil {
+				uc.log.Warnf("import user %s at line %d failed: %v", row.user.Username, row.line, err)
+				row.errs = append(row.errs, importErrorText(err))
+			}
+		}
+		if len(row.errs) > 0 {
+			failed = append(failed, row)
+			job.Failed++
+		} else {
+			job.Succeeded++
+		}
+		if (i+1)%userJobProgressEvery == 0 {
+			if err := uc.repo.UpdateUserJob(ctx, job); err != nil {
+				uc.log.Warnf("update user job %d progress failed: %v", job.ID, err)
+			}
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+	w, err := sheet.NewWriter(job.Format)
+	if err != nil {
+		return err
+	}
+	_ = w.Write(append(append([]string{"行号"}, header...), "错误原因")...)
+	for _, row := range failed {
+		cells := make([]string, len(header))
+		copy(cells, row.cells)
+		_ = w.Write(append(append([]string{strconv.Itoa(row.line)}, cells...), strings.Join(row.errs, "；"))...)
+	}
+	job.ResultKey, err = uc.saveResult(ctx, w, "user_import_errors", job.Format)
+	return err
+}
+
+// parseUserImportRow 解析并校验单行的格式，部门、角色及唯一性由 checkUserImportRows 校验
+func parseUserImportRow(line int, cells []string, cols map[string]int) *userImportRow {
+	cell := func(name string) string {
+		if i, ok := cols[name]; ok && i < len(cells) {
+			return strings.TrimSpace(cells[i])
+		}
+		return ""
+	}
+	row := &userImportRow{
+		line:  line,
+		cells: cells,
+		user: &SysUser{
+			Username:    cell(colUsername),
+			Nickname:    cell(colName),
+			Phone:       cell(colPhone),
+			Email:       cell(colEmail),
+			IsAvailable: true,
+		},
+		deptPath: normalizeDeptPath(cell(colDept)),
+		password: cell(colPassword),
+	}
+
+	u := row.user
+	if !usernamePattern.MatchString(u.Username) {
+		row.errs = append(row.errs, "用户名须为 3-20 位字母、数字或 _.@-")
+	}
+	if row.deptPath == "" {
+		row.errs = append(row.errs, "部门不能为空")
+	}
+	if utf8.RuneCountInString(u.Nickname) > 64 {
+		row.errs = append(row.errs, "姓名不能超过 64 个字符")
+	}
+	if u.Phone != "" && !phonePattern.MatchString(u.Phone) {
+		row.errs = append(row.errs, "手机号格式错误")
+	}
+	if u.Email != "" {
+		if addr, err := mail.ParseAddress(u.Email); err != nil || addr.Address != u.Email || len(u.Email) > 128 {
+			row.errs = append(row.errs, "邮箱格式错误")
+		}
+	}
+	if row.password != "" && (len(row.password) < 6 || len(row.password) > 20) {
+		row.errs = append(row.errs, "密码须为 6-20 位")
+	}
+	switch cell(colStatus) {
+	case "", userStatusEnabledText:
+	case userStatusDisabledText:
+		u.IsAvailable = false
+	default:
+		row.errs = append(row.errs, "状态须为“启用”或“禁用”")
+	}
+	for _, code := range strings.FieldsFunc(cell(colRoles), func(r rune) bool { return r == ',' || r == '，' }) {
+		if code = strings.TrimSpace(code); code != "" {
+			row.roles = append(row.roles, code)
+		}
+	}
+	return row
+}
+
+// checkUserImportRows 校验部门、角色以及用户名、手机号的唯一性
+func (uc *UserJobUseCase) checkUserImportRows(ctx context.Context, list []*userImportRow) error {
+	depts, err := uc.depts.ListDepts(ctx)
+	if err != nil {
+		return err
+	}
+	scopedIDs, err := uc.depts.ListScopedDeptIDs(ctx)
+	if err != nil {
+		return err
+	}
+	roles, err := uc.roles.ListRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 同一路径对应多个部门时记为 0，要求用户修正重名部门
+	deptByPath := make(map[string]int64, len(depts))
+	for id, path := range deptPaths(depts) {
+		if _, ok := deptByPath[path]; ok {
+			deptByPath[path] = 0
+			continue
+		}
+		deptByPath[path] = id
+	}
+	scoped := make(map[int64]struct{}, len(scopedIDs))
+	for _, id := range scopedIDs {
+		scoped[id] = struct{}{}
+	}
+	roleCodes := make(map[string]struct{}, len(roles))
+	for _, r := range roles {
+		roleCodes[r.Code] = struct{}{}
+	}
+
+	var usernames, phones []string
+	for _, row := range list {
+		usernames = append(usernames, row.user.Username)
+		if row.user.Phone != "" {
+			phones = append(phones, row.user.Phone)
+		}
+	}
+	takenNames, err := uc.users.ExistingUsernames(ctx, usernames)
+	if err != nil {
+		return err
+	}
+	takenPhones, err := uc.users.ExistingPhones(ctx, phones)
+	if err != nil {
+		return err
+	}
+	seenNames := toSet(takenNames)
+	seenPhones := toSet(takenPhones)
+
+	for _, row := range list {
+		u := row.user
+		if _, ok := seenNames[u.Username]; ok {
+			row.errs = append(row.errs, "用户名已存在")
+		} else {
+			seenNames[u.Username] = struct{}{}
+		}
+		if u.Phone != "" {
+			if _, ok := seenPhones[u.Phone]; ok {
+				row.errs = append(row.errs, "手机号已被使用")
+			} else {
+				seenPhones[u.Phone] = struct{}{}
+			}
+		}
+
+		if row.deptPath != "" {
+			id, ok := deptByPath[row.deptPath]
+			switch {
+			case !ok:
+				row.errs = append(row.errs, "部门不存在")
+			case id == 0:
+				row.errs = append(row.errs, "部门路径对应多个部门")
+			default:
+				if _, ok := scoped[id]; !ok {
+					row.errs = append(row.errs, "无权访问该部门")
+				}
+				u.DeptID = id
+			}
+		}
+		for _, code := range row.roles {
+			if _, ok := roleCodes[code]; !ok {
+				row.errs = append(row.errs, "角色不存在："+code)
+			}
+		}
+	}
+	return nil
+}
+
+// createImportedUser 创建用户并分配角色，未填写密码时生成随机密码（用户可通过手机验证码重置）
+func (uc *UserJobUseCase) createImportedUser(ctx context.Context, tenantID int64, row *userImportRow) error {
+	password := row.password
+	if password == "" {
+		b := make([]byte, 12)
+		if _, err := rand.Read(b); err != nil {
+			return err
+		}
+		password = base64.RawURLEncoding.EncodeToString(b)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	row.user.PasswordHash = string(hash)
+	row.user.TenantID = tenantID
+
+	user, err := uc.users.CreateUser(ctx, row.user)
+	if err != nil {
+		return err
+	}
+	if len(row.roles) == 0 {
+		return nil
+	}
+	if err := uc.policy.SetUserRoles(ctx, tenantID, user.ID, uniqueStrings(row.roles), nil); err != nil {
+		return fmt.Errorf("%w: %v", errImportRoleAssign, err)
+	}
+	return nil
+}
+
+func (uc *UserJobUseCase) exportUsers(ctx context.Context, job *UserJob) error {
+	var filter UserFilter
+	if err := json.Unmarshal([]byte(job.Params), &filter); err != nil {
+		return err
+	}
+	count, err := uc.users.CountUsers(ctx, &filter)
+	if err != nil {
+		return err
+	}
+	if count > maxUserExport {
+		return ErrUserExportTooLarge
+	}
+	users, err := uc.users.FindUsers(ctx, &filter, maxUserExport)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	roles, err := uc.users.ListUserRoleCodes(ctx, ids)
+	if err != nil {
+		return err
+	}
+	depts, err := uc.depts.ListDepts(ctx)
+	if err != nil {
+		return err
+	}
+	paths := deptPaths(depts)
+
+	w, err := sheet.NewWriter(job.Format)
+	if err != nil {
+		return err
+	}
+	_ = w.Write(userSheetHeader...)
+	for _, u := range users {
+		status := userStatusEnabledText
+		if !u.IsAvailable {
+			status = userStatusDisabledText
+		}
+		_ = w.Write(u.Username, u.Nickname, u.Phone, u.Email, paths[u.DeptID], strings.Join(roles[u.ID], ","), status)
+	}
+	job.Total = len(users)
+	job.Succeeded = len(users)
+	job.ResultKey, err = uc.saveResult(ctx, w, "user", job.Format)
+	return err
+}
+
+// saveResult 将结果文件私有上传到对象存储，返回存储路径
+func (uc *UserJobUseCase) saveResult(ctx context.Context, w sheet.Writer, name, format string) (string, error) {
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	buf := w.Bytes()
+	now := time.Now()
+	key := fmt.Sprintf("export/%s/%d/%s/%s_%s_%s.%s",
+		name, auth.GetTenantID(ctx), now.Format("2006/01/02"), name, now.Format("20060102150405"), uuid.NewString()[:8], format)
+	if _, err := uc.oss.Upload(ctx, key, buf, int64(buf.Len()), sheet.ContentType(format), true); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// importErrorText 写入错误报告的失败原因，非业务错误不暴露细节
+func importErrorText(err error) string {
+	if se := new(kerrors.Error); errors.As(err, &se) && se.Code < 500 {
+		return se.Message
+	}
+	if errors.Is(err, errImportRoleAssign) {
+		return errImportRoleAssign.Error()
+	}
+	return "写入失败"
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+func isBlankRow(cells []string) bool {
+	for _, c := range cells {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
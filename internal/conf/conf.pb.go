@@ -135,12 +135,15 @@ func (x *Server) GetGrpc() *Server_GRPC {
 }
 
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Sms           *Data_Sms              `protobuf:"bytes,3,opt,name=sms,proto3" json:"sms,omitempty"`
-	Email         *Data_Email            `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Oss           *Data_Oss              `protobuf:"bytes,5,opt,name=oss,proto3" json:"oss,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis    *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Sms      *Data_Sms              `protobuf:"bytes,3,opt,name=sms,proto3" json:"sms,omitempty"`
+	Email    *Data_Email            `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Oss      *Data_Oss              `protobuf:"bytes,5,opt,name=oss,proto3" json:"oss,omitempty"`
+	Casbin   *Data_Casbin           `protobuf:"bytes,6,opt,name=casbin,proto3" json:"casbin,omitempty"`
+	// 额外的命名数据源（如报表库），不维护表结构，按名称注入到仓库
+	Sources       map[string]*Data_Database `protobuf:"bytes,7,rep,name=sources,proto3" json:"sources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +213,20 @@ func (x *Data) GetOss() *Data_Oss {
 	return nil
 }
 
+func (x *Data) GetCasbin() *Data_Casbin {
+	if x != nil {
+		return x.Casbin
+	}
+	return nil
+}
+
+func (x *Data) GetSources() map[string]*Data_Database {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
 type App struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Auth              *App_Auth              `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
@@ -218,6 +235,9 @@ type App struct {
 	Otp               *App_Otp               `protobuf:"bytes,4,opt,name=otp,proto3" json:"otp,omitempty"`
 	Upload            *App_Upload            `protobuf:"bytes,5,opt,name=upload,proto3" json:"upload,omitempty"`
 	EnableMultiTenant bool                   `protobuf:"varint,6,opt,name=enable_multi_tenant,json=enableMultiTenant,proto3" json:"enable_multi_tenant,omitempty"`
+	Tenant            *App_Tenant            `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Audit             *App_Audit             `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
+	Recycle           *App_Recycle           `protobuf:"bytes,9,opt,name=recycle,proto3" json:"recycle,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -294,13 +314,37 @@ func (x *App) GetEnableMultiTenant() bool {
 	return false
 }
 
+func (x *App) GetTenant() *App_Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *App) GetAudit() *App_Audit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *App) GetRecycle() *App_Recycle {
+	if x != nil {
+		return x.Recycle
+	}
+	return nil
+}
+
 type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 可信反向代理的 CIDR 或 IP，仅来自这些地址的请求才读取 X-Forwarded-For / X-Real-IP，
+	// 为空时客户端 IP 始终取连接地址
+	TrustedProxies []string `protobuf:"bytes,4,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server_HTTP) Reset() {
@@ -354,6 +398,13 @@ func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Server_HTTP) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -416,14 +467,21 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 
 type Data_Database struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
-	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // postgres, mysql, sqlite
 	Source string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	// 连接池配置
 	MaxIdleConns    int32                `protobuf:"varint,3,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
 	MaxOpenConns    int32                `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
 	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 启动时的表结构维护方式：
+	//   - auto（默认）: GORM AutoMigrate，仅用于开发
+	//   - versioned: 执行内嵌的版本化迁移脚本
+	//   - none: 不处理，由 migrate 命令单独执行
+	Migration string `protobuf:"bytes,6,opt,name=migration,proto3" json:"migration,omitempty"`
+	// 只读副本 DSN，与主库使用相同的驱动和连接池配置；读取随机分配到副本，写入及事务使用主库
+	Replicas      []string `protobuf:"bytes,7,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Database) Reset() {
@@ -491,6 +549,20 @@ func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
 	return nil
 }
 
+func (x *Data_Database) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+func (x *Data_Database) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
 type Data_Redis struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 移除 network 字段
@@ -500,6 +572,7 @@ type Data_Redis struct {
 	Password      string               `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
 	Database      int32                `protobuf:"varint,7,opt,name=database,proto3" json:"database,omitempty"`
 	PoolSize      int32                `protobuf:"varint,8,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Embedded      bool                 `protobuf:"varint,9,opt,name=embedded,proto3" json:"embedded,omitempty"` // 使用进程内的 miniredis 代替 Redis 服务，仅用于本地开发及测试
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -576,6 +649,13 @@ func (x *Data_Redis) GetPoolSize() int32 {
 	return 0
 }
 
+func (x *Data_Redis) GetEmbedded() bool {
+	if x != nil {
+		return x.Embedded
+	}
+	return false
+}
+
 type Data_Sms struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Provider        string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                                                                                                // aliyun, tencent
@@ -812,6 +892,50 @@ func (x *Data_Oss) GetProvider() string {
 	return ""
 }
 
+type Data_Casbin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelPath     string                 `protobuf:"bytes,1,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"` // 模型文件路径，为空或不存在时使用内置模型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Casbin) Reset() {
+	*x = Data_Casbin{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Casbin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Casbin) ProtoMessage() {}
+
+func (x *Data_Casbin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Casbin.ProtoReflect.Descriptor instead.
+func (*Data_Casbin) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Casbin) GetModelPath() string {
+	if x != nil {
+		return x.ModelPath
+	}
+	return ""
+}
+
 type Data_Email_SMTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -824,7 +948,7 @@ type Data_Email_SMTP struct {
 
 func (x *Data_Email_SMTP) Reset() {
 	*x = Data_Email_SMTP{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -836,7 +960,7 @@ func (x *Data_Email_SMTP) String() string {
 func (*Data_Email_SMTP) ProtoMessage() {}
 
 func (x *Data_Email_SMTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1006,7 @@ func (x *Data_Email_SMTP) GetPassword() string {
 
 type App_Auth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PublicPaths   []string               `protobuf:"bytes,1,rep,name=public_paths,json=publicPaths,proto3" json:"public_paths,omitempty"`
+	PublicPaths   []string               `protobuf:"bytes,1,rep,name=public_paths,json=publicPaths,proto3" json:"public_paths,omitempty"` // 额外的公开路径，接口访问规则优先使用 proto 注解 (bubble.auth) 声明
 	Passport      *App_Auth_Passport     `protobuf:"bytes,2,opt,name=passport,proto3" json:"passport,omitempty"`
 	Jwt           *App_Auth_JWT          `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -891,7 +1015,7 @@ type App_Auth struct {
 
 func (x *App_Auth) Reset() {
 	*x = App_Auth{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1027,7 @@ func (x *App_Auth) String() string {
 func (*App_Auth) ProtoMessage() {}
 
 func (x *App_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1074,7 @@ type App_Otp struct {
 
 func (x *App_Otp) Reset() {
 	*x = App_Otp{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1086,7 @@ func (x *App_Otp) String() string {
 func (*App_Otp) ProtoMessage() {}
 
 func (x *App_Otp) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1126,7 @@ type App_Upload struct {
 
 func (x *App_Upload) Reset() {
 	*x = App_Upload{}
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1014,7 +1138,7 @@ func (x *App_Upload) String() string {
 func (*App_Upload) ProtoMessage() {}
 
 func (x *App_Upload) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,6 +1168,178 @@ func (x *App_Upload) GetScenes() map[string]*App_Upload_Scene {
 	return nil
 }
 
+type App_Tenant struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GracePeriod      *durationpb.Duration   `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`                   // 过期宽限期，宽限期内只读
+	ExpireNoticeDays int32                  `protobuf:"varint,2,opt,name=expire_notice_days,json=expireNoticeDays,proto3" json:"expire_notice_days,omitempty"` // 到期前 N 天邮件提醒租户管理员
+	Resolver         *App_Tenant_Resolver   `protobuf:"bytes,3,opt,name=resolver,proto3" json:"resolver,omitempty"`                                            // 公开接口的租户解析规则（仅多租户模式）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *App_Tenant) Reset() {
+	*x = App_Tenant{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Tenant) ProtoMessage() {}
+
+func (x *App_Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Tenant.ProtoReflect.Descriptor instead.
+func (*App_Tenant) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *App_Tenant) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *App_Tenant) GetExpireNoticeDays() int32 {
+	if x != nil {
+		return x.ExpireNoticeDays
+	}
+	return 0
+}
+
+func (x *App_Tenant) GetResolver() *App_Tenant_Resolver {
+	if x != nil {
+		return x.Resolver
+	}
+	return nil
+}
+
+type App_Audit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BufferSize    int32                  `protobuf:"varint,1,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`          // 异步写入缓冲队列长度，队列满时丢弃并记录错误日志
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`             // 单次批量写入条数
+	FlushInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`  // 最长写入间隔
+	RetentionDays int32                  `protobuf:"varint,4,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // 保留天数，0 表示永久保留
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Audit) Reset() {
+	*x = App_Audit{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Audit) ProtoMessage() {}
+
+func (x *App_Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Audit.ProtoReflect.Descriptor instead.
+func (*App_Audit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *App_Audit) GetBufferSize() int32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+func (x *App_Audit) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *App_Audit) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *App_Audit) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+type App_Recycle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetentionDays int32                  `protobuf:"varint,1,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // 回收站保留天数，超期后彻底删除，0 表示永久保留
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Recycle) Reset() {
+	*x = App_Recycle{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Recycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Recycle) ProtoMessage() {}
+
+func (x *App_Recycle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Recycle.ProtoReflect.Descriptor instead.
+func (*App_Recycle) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *App_Recycle) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
 type App_Auth_Passport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AutoRegister  bool                   `protobuf:"varint,1,opt,name=auto_register,json=autoRegister,proto3" json:"auto_register,omitempty"`
@@ -1053,7 +1349,7 @@ type App_Auth_Passport struct {
 
 func (x *App_Auth_Passport) Reset() {
 	*x = App_Auth_Passport{}
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1361,7 @@ func (x *App_Auth_Passport) String() string {
 func (*App_Auth_Passport) ProtoMessage() {}
 
 func (x *App_Auth_Passport) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1395,7 @@ type App_Auth_JWT struct {
 
 func (x *App_Auth_JWT) Reset() {
 	*x = App_Auth_JWT{}
-	mi := &file_conf_conf_proto_msgTypes[18]
+	mi := &file_conf_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1111,7 +1407,7 @@ func (x *App_Auth_JWT) String() string {
 func (*App_Auth_JWT) ProtoMessage() {}
 
 func (x *App_Auth_JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[18]
+	mi := &file_conf_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1456,7 @@ type App_Otp_Scene struct {
 
 func (x *App_Otp_Scene) Reset() {
 	*x = App_Otp_Scene{}
-	mi := &file_conf_conf_proto_msgTypes[19]
+	mi := &file_conf_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1172,7 +1468,7 @@ func (x *App_Otp_Scene) String() string {
 func (*App_Otp_Scene) ProtoMessage() {}
 
 func (x *App_Otp_Scene) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[19]
+	mi := &file_conf_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +1524,7 @@ type App_Upload_Scene struct {
 
 func (x *App_Upload_Scene) Reset() {
 	*x = App_Upload_Scene{}
-	mi := &file_conf_conf_proto_msgTypes[22]
+	mi := &file_conf_conf_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1240,7 +1536,7 @@ func (x *App_Upload_Scene) String() string {
 func (*App_Upload_Scene) ProtoMessage() {}
 
 func (x *App_Upload_Scene) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[22]
+	mi := &file_conf_conf_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,6 +1580,74 @@ func (x *App_Upload_Scene) GetAllowedTypes() []string {
 	return nil
 }
 
+type App_Tenant_Resolver struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         []string               `protobuf:"bytes,1,rep,name=order,proto3" json:"order,omitempty"`                                // 解析顺序：header, subdomain, field
+	Header        string                 `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`                              // 请求头名称，默认 X-Tenant-Code
+	BaseDomain    string                 `protobuf:"bytes,3,opt,name=base_domain,json=baseDomain,proto3" json:"base_domain,omitempty"`    // 子域名解析的主域名，如 admin.example.com
+	DefaultCode   string                 `protobuf:"bytes,4,opt,name=default_code,json=defaultCode,proto3" json:"default_code,omitempty"` // 未解析到租户时使用的租户编码，为空则拒绝请求
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Tenant_Resolver) Reset() {
+	*x = App_Tenant_Resolver{}
+	mi := &file_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Tenant_Resolver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Tenant_Resolver) ProtoMessage() {}
+
+func (x *App_Tenant_Resolver) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Tenant_Resolver.ProtoReflect.Descriptor instead.
+func (*App_Tenant_Resolver) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 3, 0}
+}
+
+func (x *App_Tenant_Resolver) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *App_Tenant_Resolver) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *App_Tenant_Resolver) GetBaseDomain() string {
+	if x != nil {
+		return x.BaseDomain
+	}
+	return ""
+}
+
+func (x *App_Tenant_Resolver) GetDefaultCode() string {
+	if x != nil {
+		return x.DefaultCode
+	}
+	return ""
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -1293,37 +1657,43 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03app\x18\x03 \x01(\v2\x0f.kratos.api.AppR\x03app\"\xb8\x02\n" +
+	"\x03app\x18\x03 \x01(\v2\x0f.kratos.api.AppR\x03app\"\xe2\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1a\x92\x01\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12'\n" +
+	"\x0ftrusted_proxies\x18\x04 \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8d\f\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xcd\x0e\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12&\n" +
 	"\x03sms\x18\x03 \x01(\v2\x14.kratos.api.Data.SmsR\x03sms\x12,\n" +
 	"\x05email\x18\x04 \x01(\v2\x16.kratos.api.Data.EmailR\x05email\x12&\n" +
-	"\x03oss\x18\x05 \x01(\v2\x14.kratos.api.Data.OssR\x03oss\x1a\xcd\x01\n" +
+	"\x03oss\x18\x05 \x01(\v2\x14.kratos.api.Data.OssR\x03oss\x12/\n" +
+	"\x06casbin\x18\x06 \x01(\v2\x17.kratos.api.Data.CasbinR\x06casbin\x127\n" +
+	"\asources\x18\a \x03(\v2\x1d.kratos.api.Data.SourcesEntryR\asources\x1a\x87\x02\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
 	"\x0emax_idle_conns\x18\x03 \x01(\x05R\fmaxIdleConns\x12$\n" +
 	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x12E\n" +
-	"\x11conn_max_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x1a\xee\x01\n" +
+	"\x11conn_max_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x12\x1c\n" +
+	"\tmigration\x18\x06 \x01(\tR\tmigration\x12\x1a\n" +
+	"\breplicas\x18\a \x03(\tR\breplicas\x1a\x8a\x02\n" +
 	"\x05Redis\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1a\n" +
 	"\bpassword\x18\x06 \x01(\tR\bpassword\x12\x1a\n" +
 	"\bdatabase\x18\a \x01(\x05R\bdatabase\x12\x1b\n" +
-	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x1a\x9c\x02\n" +
+	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x12\x1a\n" +
+	"\bembedded\x18\t \x01(\bR\bembedded\x1a\x9c\x02\n" +
 	"\x03Sms\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12T\n" +
 	"\x10template_mapping\x18\x02 \x03(\v2).kratos.api.Data.Sms.TemplateMappingEntryR\x0ftemplateMapping\x12\x1d\n" +
@@ -1354,14 +1724,23 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06region\x18\x05 \x01(\tR\x06region\x12\x16\n" +
 	"\x06domain\x18\x06 \x01(\tR\x06domain\x12\x1b\n" +
 	"\tuse_https\x18\a \x01(\bR\buseHttps\x12\x1a\n" +
-	"\bprovider\x18\b \x01(\tR\bprovider\"\x89\v\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\x1a'\n" +
+	"\x06Casbin\x12\x1d\n" +
+	"\n" +
+	"model_path\x18\x01 \x01(\tR\tmodelPath\x1aU\n" +
+	"\fSourcesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.Data.DatabaseR\x05value:\x028\x01\"\xb0\x10\n" +
 	"\x03App\x12(\n" +
 	"\x04auth\x18\x01 \x01(\v2\x14.kratos.api.App.AuthR\x04auth\x12\x10\n" +
 	"\x03env\x18\x02 \x01(\tR\x03env\x12\x1b\n" +
 	"\tworker_id\x18\x03 \x01(\x03R\bworkerId\x12%\n" +
 	"\x03otp\x18\x04 \x01(\v2\x13.kratos.api.App.OtpR\x03otp\x12.\n" +
 	"\x06upload\x18\x05 \x01(\v2\x16.kratos.api.App.UploadR\x06upload\x12.\n" +
-	"\x13enable_multi_tenant\x18\x06 \x01(\bR\x11enableMultiTenant\x1a\x8e\x02\n" +
+	"\x13enable_multi_tenant\x18\x06 \x01(\bR\x11enableMultiTenant\x12.\n" +
+	"\x06tenant\x18\a \x01(\v2\x16.kratos.api.App.TenantR\x06tenant\x12+\n" +
+	"\x05audit\x18\b \x01(\v2\x15.kratos.api.App.AuditR\x05audit\x121\n" +
+	"\arecycle\x18\t \x01(\v2\x17.kratos.api.App.RecycleR\arecycle\x1a\x8e\x02\n" +
 	"\x04Auth\x12!\n" +
 	"\fpublic_paths\x18\x01 \x03(\tR\vpublicPaths\x129\n" +
 	"\bpassport\x18\x02 \x01(\v2\x1d.kratos.api.App.Auth.PassportR\bpassport\x12*\n" +
@@ -1400,7 +1779,26 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\rallowed_types\x18\x04 \x03(\tR\fallowedTypes\x1aW\n" +
 	"\vScenesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
-	"\x05value\x18\x02 \x01(\v2\x1c.kratos.api.App.Upload.SceneR\x05value:\x028\x01B+Z)bubble-admin-go-kratos/internal/conf;confb\x06proto3"
+	"\x05value\x18\x02 \x01(\v2\x1c.kratos.api.App.Upload.SceneR\x05value:\x028\x01\x1a\xaf\x02\n" +
+	"\x06Tenant\x12<\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12,\n" +
+	"\x12expire_notice_days\x18\x02 \x01(\x05R\x10expireNoticeDays\x12;\n" +
+	"\bresolver\x18\x03 \x01(\v2\x1f.kratos.api.App.Tenant.ResolverR\bresolver\x1a|\n" +
+	"\bResolver\x12\x14\n" +
+	"\x05order\x18\x01 \x03(\tR\x05order\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\x12\x1f\n" +
+	"\vbase_domain\x18\x03 \x01(\tR\n" +
+	"baseDomain\x12!\n" +
+	"\fdefault_code\x18\x04 \x01(\tR\vdefaultCode\x1a\xb0\x01\n" +
+	"\x05Audit\x12\x1f\n" +
+	"\vbuffer_size\x18\x01 \x01(\x05R\n" +
+	"bufferSize\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12@\n" +
+	"\x0eflush_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x12%\n" +
+	"\x0eretention_days\x18\x04 \x01(\x05R\rretentionDays\x1a0\n" +
+	"\aRecycle\x12%\n" +
+	"\x0eretention_days\x18\x01 \x01(\x05R\rretentionDaysB+Z)bubble-admin-go-kratos/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -1414,7 +1812,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -1427,20 +1825,26 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data_Sms)(nil),            // 8: kratos.api.Data.Sms
 	(*Data_Email)(nil),          // 9: kratos.api.Data.Email
 	(*Data_Oss)(nil),            // 10: kratos.api.Data.Oss
-	nil,                         // 11: kratos.api.Data.Sms.TemplateMappingEntry
-	(*Data_Email_SMTP)(nil),     // 12: kratos.api.Data.Email.SMTP
-	nil,                         // 13: kratos.api.Data.Email.SubjectMappingEntry
-	(*App_Auth)(nil),            // 14: kratos.api.App.Auth
-	(*App_Otp)(nil),             // 15: kratos.api.App.Otp
-	(*App_Upload)(nil),          // 16: kratos.api.App.Upload
-	(*App_Auth_Passport)(nil),   // 17: kratos.api.App.Auth.Passport
-	(*App_Auth_JWT)(nil),        // 18: kratos.api.App.Auth.JWT
-	(*App_Otp_Scene)(nil),       // 19: kratos.api.App.Otp.Scene
-	nil,                         // 20: kratos.api.App.Otp.PhoneScenesEntry
-	nil,                         // 21: kratos.api.App.Otp.EmailScenesEntry
-	(*App_Upload_Scene)(nil),    // 22: kratos.api.App.Upload.Scene
-	nil,                         // 23: kratos.api.App.Upload.ScenesEntry
-	(*durationpb.Duration)(nil), // 24: google.protobuf.Duration
+	(*Data_Casbin)(nil),         // 11: kratos.api.Data.Casbin
+	nil,                         // 12: kratos.api.Data.SourcesEntry
+	nil,                         // 13: kratos.api.Data.Sms.TemplateMappingEntry
+	(*Data_Email_SMTP)(nil),     // 14: kratos.api.Data.Email.SMTP
+	nil,                         // 15: kratos.api.Data.Email.SubjectMappingEntry
+	(*App_Auth)(nil),            // 16: kratos.api.App.Auth
+	(*App_Otp)(nil),             // 17: kratos.api.App.Otp
+	(*App_Upload)(nil),          // 18: kratos.api.App.Upload
+	(*App_Tenant)(nil),          // 19: kratos.api.App.Tenant
+	(*App_Audit)(nil),           // 20: kratos.api.App.Audit
+	(*App_Recycle)(nil),         // 21: kratos.api.App.Recycle
+	(*App_Auth_Passport)(nil),   // 22: kratos.api.App.Auth.Passport
+	(*App_Auth_JWT)(nil),        // 23: kratos.api.App.Auth.JWT
+	(*App_Otp_Scene)(nil),       // 24: kratos.api.App.Otp.Scene
+	nil,                         // 25: kratos.api.App.Otp.PhoneScenesEntry
+	nil,                         // 26: kratos.api.App.Otp.EmailScenesEntry
+	(*App_Upload_Scene)(nil),    // 27: kratos.api.App.Upload.Scene
+	nil,                         // 28: kratos.api.App.Upload.ScenesEntry
+	(*App_Tenant_Resolver)(nil), // 29: kratos.api.App.Tenant.Resolver
+	(*durationpb.Duration)(nil), // 30: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1453,33 +1857,42 @@ var file_conf_conf_proto_depIdxs = []int32{
 	8,  // 7: kratos.api.Data.sms:type_name -> kratos.api.Data.Sms
 	9,  // 8: kratos.api.Data.email:type_name -> kratos.api.Data.Email
 	10, // 9: kratos.api.Data.oss:type_name -> kratos.api.Data.Oss
-	14, // 10: kratos.api.App.auth:type_name -> kratos.api.App.Auth
-	15, // 11: kratos.api.App.otp:type_name -> kratos.api.App.Otp
-	16, // 12: kratos.api.App.upload:type_name -> kratos.api.App.Upload
-	24, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	24, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	24, // 15: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	24, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	24, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	11, // 18: kratos.api.Data.Sms.template_mapping:type_name -> kratos.api.Data.Sms.TemplateMappingEntry
-	12, // 19: kratos.api.Data.Email.smtp:type_name -> kratos.api.Data.Email.SMTP
-	13, // 20: kratos.api.Data.Email.subject_mapping:type_name -> kratos.api.Data.Email.SubjectMappingEntry
-	17, // 21: kratos.api.App.Auth.passport:type_name -> kratos.api.App.Auth.Passport
-	18, // 22: kratos.api.App.Auth.jwt:type_name -> kratos.api.App.Auth.JWT
-	20, // 23: kratos.api.App.Otp.phone_scenes:type_name -> kratos.api.App.Otp.PhoneScenesEntry
-	21, // 24: kratos.api.App.Otp.email_scenes:type_name -> kratos.api.App.Otp.EmailScenesEntry
-	24, // 25: kratos.api.App.Upload.private_url_expires:type_name -> google.protobuf.Duration
-	23, // 26: kratos.api.App.Upload.scenes:type_name -> kratos.api.App.Upload.ScenesEntry
-	24, // 27: kratos.api.App.Otp.Scene.expires_in:type_name -> google.protobuf.Duration
-	24, // 28: kratos.api.App.Otp.Scene.resend_interval:type_name -> google.protobuf.Duration
-	19, // 29: kratos.api.App.Otp.PhoneScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
-	19, // 30: kratos.api.App.Otp.EmailScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
-	22, // 31: kratos.api.App.Upload.ScenesEntry.value:type_name -> kratos.api.App.Upload.Scene
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	11, // 10: kratos.api.Data.casbin:type_name -> kratos.api.Data.Casbin
+	12, // 11: kratos.api.Data.sources:type_name -> kratos.api.Data.SourcesEntry
+	16, // 12: kratos.api.App.auth:type_name -> kratos.api.App.Auth
+	17, // 13: kratos.api.App.otp:type_name -> kratos.api.App.Otp
+	18, // 14: kratos.api.App.upload:type_name -> kratos.api.App.Upload
+	19, // 15: kratos.api.App.tenant:type_name -> kratos.api.App.Tenant
+	20, // 16: kratos.api.App.audit:type_name -> kratos.api.App.Audit
+	21, // 17: kratos.api.App.recycle:type_name -> kratos.api.App.Recycle
+	30, // 18: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	30, // 19: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	30, // 20: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	30, // 21: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	30, // 22: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	13, // 23: kratos.api.Data.Sms.template_mapping:type_name -> kratos.api.Data.Sms.TemplateMappingEntry
+	14, // 24: kratos.api.Data.Email.smtp:type_name -> kratos.api.Data.Email.SMTP
+	15, // 25: kratos.api.Data.Email.subject_mapping:type_name -> kratos.api.Data.Email.SubjectMappingEntry
+	6,  // 26: kratos.api.Data.SourcesEntry.value:type_name -> kratos.api.Data.Database
+	22, // 27: kratos.api.App.Auth.passport:type_name -> kratos.api.App.Auth.Passport
+	23, // 28: kratos.api.App.Auth.jwt:type_name -> kratos.api.App.Auth.JWT
+	25, // 29: kratos.api.App.Otp.phone_scenes:type_name -> kratos.api.App.Otp.PhoneScenesEntry
+	26, // 30: kratos.api.App.Otp.email_scenes:type_name -> kratos.api.App.Otp.EmailScenesEntry
+	30, // 31: kratos.api.App.Upload.private_url_expires:type_name -> google.protobuf.Duration
+	28, // 32: kratos.api.App.Upload.scenes:type_name -> kratos.api.App.Upload.ScenesEntry
+	30, // 33: kratos.api.App.Tenant.grace_period:type_name -> google.protobuf.Duration
+	29, // 34: kratos.api.App.Tenant.resolver:type_name -> kratos.api.App.Tenant.Resolver
+	30, // 35: kratos.api.App.Audit.flush_interval:type_name -> google.protobuf.Duration
+	30, // 36: kratos.api.App.Otp.Scene.expires_in:type_name -> google.protobuf.Duration
+	30, // 37: kratos.api.App.Otp.Scene.resend_interval:type_name -> google.protobuf.Duration
+	24, // 38: kratos.api.App.Otp.PhoneScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
+	24, // 39: kratos.api.App.Otp.EmailScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
+	27, // 40: kratos.api.App.Upload.ScenesEntry.value:type_name -> kratos.api.App.Upload.Scene
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1493,7 +1906,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
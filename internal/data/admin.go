@@ -0,0 +1,157 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var _ biz.AdminRepo = (*adminRepo)(nil)
+
+type adminRepo struct {
+	BaseRepo
+}
+
+func NewAdminRepo(data *Data, logger log.Logger) biz.AdminRepo {
+	return &adminRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+// db 运维命令不受租户及数据范围限制
+func (r *adminRepo) db(ctx context.Context) *gorm.DB {
+	return r.data.DB(auth.WithSkipDataScope(ctx))
+}
+
+func (r *adminRepo) FindTenantID(ctx context.Context, code string) (int64, error) {
+	var tenant model.SysTenant
+	err := r.db(ctx).Select("id").Where("code = ?", code).First(&tenant).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, biz.ErrTenantNotFound
+	}
+	return tenant.ID, err
+}
+
+func (r *adminRepo) FindUser(ctx context.Context, tenantID int64, username string) (*biz.SysUser, error) {
+	var user model.SysUser
+	err := r.db(ctx).Where("tenant_id = ? AND username = ?", tenantID, username).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.SysUser{
+		ID:          user.ID,
+		Username:    user.Username,
+		Phone:       user.Mobile,
+		Email:       user.Email,
+		Nickname:    user.Name,
+		DeptID:      user.DeptID,
+		TenantID:    user.TenantID,
+		IsAvailable: user.Status == biz.UserStatusEnabled,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}, nil
+}
+
+func (r *adminRepo) FindDeptID(ctx context.Context, tenantID int64, name string) (int64, error) {
+	var dept model.SysDept
+	err := r.db(ctx).Select("id").Where("tenant_id = ? AND name = ?", tenantID, name).First(&dept).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, biz.ErrDeptInvalid
+	}
+	return dept.ID, err
+}
+
+func (r *adminRepo) FindRoleIDs(ctx context.Context, tenantID int64, codes []string) ([]int64, error) {
+	var roles []*model.SysRole
+	if err := r.db(ctx).Select("id", "code").Where("tenant_id = ? AND code IN ?", tenantID, codes).Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]int64, len(roles))
+	for _, role := range roles {
+		byCode[role.Code] = role.ID
+	}
+	ids := make([]int64, 0, len(codes))
+	for _, code := range codes {
+		id, ok := byCode[code]
+		if !ok {
+			return nil, biz.ErrRoleNotFound.WithMetadata(map[string]string{"code": code})
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *adminRepo) CreateUser(ctx context.Context, u *biz.SysUser) error {
+	status := int16(biz.UserStatusDisabled)
+	if u.IsAvailable {
+		status = biz.UserStatusEnabled
+	}
+	m := &model.SysUser{
+		BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: u.TenantID, DeptID: u.DeptID}},
+		Username:      u.Username,
+		PasswordHash:  u.PasswordHash,
+		Name:          u.Nickname,
+		Mobile:        u.Phone,
+		Email:         u.Email,
+		Status:        status,
+	}
+	if err := r.db(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	u.ID = m.ID
+	u.CreatedAt = m.CreatedAt
+	u.UpdatedAt = m.UpdatedAt
+	// 清除该 ID 可能存在的“不存在”缓存
+	userCache(r.data).Delete(ctx, userCacheKeys(m.ID)...)
+	return nil
+}
+
+func (r *adminRepo) AssignUserRoles(ctx context.Context, tenantID, userID int64, roleIDs []int64) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	list := make([]*model.SysUserRole, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		list = append(list, &model.SysUserRole{
+			BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}},
+			UserID:        userID,
+			RoleID:        roleID,
+		})
+	}
+	return r.db(ctx).Create(&list).Error
+}
+
+func (r *adminRepo) UpdatePassword(ctx context.Context, userID int64, passwordHash string) error {
+	return r.updateUser(ctx, userID, "password_hash", passwordHash)
+}
+
+func (r *adminRepo) UpdateStatus(ctx context.Context, userID int64, status int16) error {
+	return r.updateUser(ctx, userID, "status", status)
+}
+
+// updateUser 更新用户并使用户缓存失效
+func (r *adminRepo) updateUser(ctx context.Context, userID int64, column string, value interface{}) error {
+	if err := r.db(ctx).Model(&model.SysUser{}).Where("id = ?", userID).Update(column, value).Error; err != nil {
+		return err
+	}
+	userCache(r.data).Delete(ctx, userCacheKeys(userID)...)
+	return nil
+}
+
+func (r *adminRepo) ResetLoginFailed(ctx context.Context, userID int64) error {
+	err := r.db(ctx).Model(&model.SysUser{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"login_failed_count":   0,
+		"last_login_failed_at": nil,
+	}).Error
+	if err != nil {
+		return err
+	}
+	userCache(r.data).Delete(ctx, userCacheKeys(userID)...)
+	return nil
+}
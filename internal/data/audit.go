@@ -0,0 +1,135 @@
+package data
+
+import (
+	"reflect"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/audit"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	auditBeforeKey = "audit:before"
+	// 单条更新语句最多对比的行数，避免批量更新时额外加载过多数据
+	maxAuditRows = 100
+)
+
+// AuditPlugin GORM 插件：审计中间件开启收集时，
+// 对嵌入 BaseAuthModel 的模型记录更新前后的字段差异，写入操作日志的 diff
+//
+// 规则：
+//   - 更新前按相同条件加载原记录（最多 maxAuditRows 行），更新后按主键重新加载并逐字段对比
+//   - updated_at、updated_by、version 不计入差异，敏感字段（如 password_hash）只记录脱敏值
+//   - 对比失败不影响更新本身
+type AuditPlugin struct{}
+
+func (AuditPlugin) Name() string {
+	return "audit"
+}
+
+func (p AuditPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback().Update()
+	if err := cb.Before("gorm:update").Register("audit:before_update", p.before); err != nil {
+		return err
+	}
+	return cb.After("gorm:update").Register("audit:after_update", p.after)
+}
+
+// before 加载更新前的记录
+func (p AuditPlugin) before(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil || !audit.Collecting(stmt.Context) {
+		return
+	}
+	if _, ok := reflect.New(stmt.Schema.ModelType).Interface().(model.AuthScoped); !ok {
+		return
+	}
+	pk := stmt.Schema.PrioritizedPrimaryField
+	if pk == nil {
+		return
+	}
+
+	tx := db.Session(&gorm.Session{NewDB: true, SkipHooks: true})
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok {
+			tx = tx.Clauses(where)
+		}
+	}
+	// 以模型主键更新（如 db.Model(&user).Update(...)）时，主键条件由 gorm:update 追加，这里需手动补上
+	if rv := reflect.Indirect(stmt.ReflectValue); rv.Kind() == reflect.Struct {
+		if id, zero := pk.ValueOf(stmt.Context, rv); !zero {
+			tx = tx.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: pk.DBName}, Value: id})
+		}
+	}
+
+	rows := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+	if err := tx.Limit(maxAuditRows).Find(rows.Interface()).Error; err != nil {
+		return
+	}
+	db.InstanceSet(auditBeforeKey, rows.Elem())
+}
+
+// after 重新加载并记录字段差异
+func (p AuditPlugin) after(db *gorm.DB) {
+	stmt := db.Statement
+	v, ok := db.InstanceGet(auditBeforeKey)
+	if !ok || db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+	before := v.(reflect.Value)
+	if before.Len() == 0 {
+		return
+	}
+	pk := stmt.Schema.PrioritizedPrimaryField
+
+	ids := make([]interface{}, 0, before.Len())
+	for i := 0; i < before.Len(); i++ {
+		id, _ := pk.ValueOf(stmt.Context, before.Index(i))
+		ids = append(ids, id)
+	}
+	after := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+	err := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+		Where(clause.IN{Column: clause.Column{Table: clause.CurrentTable, Name: pk.DBName}, Values: ids}).
+		Find(after.Interface()).Error
+	if err != nil {
+		return
+	}
+	afterByID := make(map[interface{}]reflect.Value, after.Elem().Len())
+	for i := 0; i < after.Elem().Len(); i++ {
+		row := after.Elem().Index(i)
+		id, _ := pk.ValueOf(stmt.Context, row)
+		afterByID[id] = row
+	}
+
+	var diffs []audit.Diff
+	for i := 0; i < before.Len(); i++ {
+		b := before.Index(i)
+		id, _ := pk.ValueOf(stmt.Context, b)
+		a, ok := afterByID[id]
+		if !ok {
+			continue
+		}
+		var changes []audit.Change
+		for _, f := range stmt.Schema.Fields {
+			switch f.DBName {
+			case "", "updated_at", updatedByColumn, versionColumn:
+				continue
+			}
+			bv, _ := f.ValueOf(stmt.Context, b)
+			av, _ := f.ValueOf(stmt.Context, a)
+			if reflect.DeepEqual(bv, av) {
+				continue
+			}
+			if audit.IsSensitive(f.DBName) {
+				bv, av = audit.Redacted, audit.Redacted
+			}
+			changes = append(changes, audit.Change{Field: f.DBName, Before: bv, After: av})
+		}
+		if len(changes) > 0 {
+			pkValue, _ := id.(int64)
+			diffs = append(diffs, audit.Diff{Table: stmt.Table, ID: pkValue, Changes: changes})
+		}
+	}
+	audit.AddDiff(stmt.Context, diffs...)
+}
@@ -0,0 +1,378 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/casbin/casbin/v3/persist"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+	"gorm.io/gorm"
+)
+
+const authzChannel = "authz:invalidate"
+
+var (
+	_ persist.Watcher   = (*AuthzWatcher)(nil)
+	_ biz.AuthzNotifier = (*AuthzWatcher)(nil)
+	_ biz.PolicyRepo    = (*policyRepo)(nil)
+)
+
+// authzEvent 广播消息
+type authzEvent struct {
+	Node   string   `json:"node"`
+	Topics []string `json:"topics"`
+}
+
+// AuthzWatcher 基于 Redis Pub/Sub 的集群权限缓存同步：
+//   - 实现 Casbin Watcher，策略增量变更后由 Enforcer 自动广播，其他节点重新加载策略
+//   - 实现 biz.AuthzNotifier，广播接口权限、套餐、租户、数据范围等缓存的失效
+//
+// 节点忽略自己发出的消息；Pub/Sub 不保证送达，租户与套餐另有定时刷新兜底
+type AuthzWatcher struct {
+	rdb    *redis.Client
+	pubsub *redis.PubSub
+	node   string
+
+	mux      sync.RWMutex
+	callback func(string)
+	handlers []func(ctx context.Context, topics []string)
+
+	log *log.Helper
+}
+
+func NewAuthzWatcher(data *Data, enforcer *casbin.SyncedEnforcer, logger log.Logger) (*AuthzWatcher, func(), error) {
+	hostname, _ := os.Hostname()
+	w := &AuthzWatcher{
+		rdb:  data.RDB(),
+		node: hostname + "-" + uuid.NewString(),
+		log:  log.NewHelper(log.With(logger, "module", "data/authz")),
+	}
+	if err := enforcer.SetWatcher(w); err != nil {
+		return nil, nil, err
+	}
+	// Enforcer.SetWatcher 默认回调未加锁，改用 SyncedEnforcer 的加载方法
+	_ = w.SetUpdateCallback(func(string) {
+		if err := enforcer.LoadPolicy(); err != nil {
+			w.log.Errorf("reload casbin policy failed: %v", err)
+		}
+	})
+
+	if err := w.watchDepts(data.db); err != nil {
+		return nil, nil, err
+	}
+
+	w.pubsub = w.rdb.Subscribe(context.Background(), authzChannel)
+	go w.listen()
+
+	return w, w.Close, nil
+}
+
+// SetUpdateCallback 实现 persist.Watcher
+func (w *AuthzWatcher) SetUpdateCallback(callback func(string)) error {
+	w.mux.Lock()
+	w.callback = callback
+	w.mux.Unlock()
+	return nil
+}
+
+// Update 实现 persist.Watcher，Enforcer 策略变更后调用
+func (w *AuthzWatcher) Update() error {
+	return w.Publish(context.Background(), biz.TopicPolicy)
+}
+
+// Close 实现 persist.Watcher
+func (w *AuthzWatcher) Close() {
+	if w.pubsub != nil {
+		_ = w.pubsub.Close()
+	}
+}
+
+func (w *AuthzWatcher) Publish(ctx context.Context, topics ...string) error {
+	if len(topics) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(authzEvent{Node: w.node, Topics: topics})
+	if err != nil {
+		return err
+	}
+	return w.rdb.Publish(ctx, authzChannel, payload).Err()
+}
+
+func (w *AuthzWatcher) Subscribe(handler func(ctx context.Context, topics []string)) {
+	w.mux.Lock()
+	w.handlers = append(w.handlers, handler)
+	w.mux.Unlock()
+}
+
+// watchDepts 部门新增、移动、删除后（事务提交后）使本节点及其他节点的数据范围缓存失效，
+// 部门树变化会改变“本部门及以下”及自定义范围展开的部门
+func (w *AuthzWatcher) watchDepts(db *gorm.DB) error {
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.RowsAffected == 0 || tx.Statement.Table != (&model.SysDept{}).TableName() {
+			return
+		}
+		biz.AfterCommit(tx.Statement.Context, func(ctx context.Context) {
+			w.dispatch([]string{biz.TopicDataScope})
+			if err := w.Publish(context.WithoutCancel(ctx), biz.TopicDataScope); err != nil {
+				w.log.WithContext(ctx).Errorf("publish data scope invalidation failed: %v", err)
+			}
+		})
+	}
+	cb := db.Callback()
+	if err := cb.Create().After("gorm:create").Register("authz:dept_create", invalidate); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Register("authz:dept_update", invalidate); err != nil {
+		return err
+	}
+	return cb.Delete().After("gorm:delete").Register("authz:dept_delete", invalidate)
+}
+
+func (w *AuthzWatcher) listen() {
+	for msg := range w.pubsub.Channel() {
+		var event authzEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			w.log.Warnf("invalid authz event: %s", msg.Payload)
+			continue
+		}
+		if event.Node == w.node {
+			continue
+		}
+		w.dispatch(event.Topics)
+	}
+}
+
+func (w *AuthzWatcher) dispatch(topics []string) {
+	w.mux.RLock()
+	callback, handlers := w.callback, w.handlers
+	w.mux.RUnlock()
+
+	if slices.Contains(topics, biz.TopicPolicy) && callback != nil {
+		callback(biz.TopicPolicy)
+	}
+	rest := slices.DeleteFunc(slices.Clone(topics), func(t string) bool { return t == biz.TopicPolicy })
+	if len(rest) == 0 {
+		return
+	}
+	ctx := auth.WithSkipDataScope(context.Background())
+	for _, h := range handlers {
+		h(ctx, rest)
+	}
+}
+
+// policyRepo 基于 Casbin Enforcer 维护策略，持久化由 SysPermissionAdapter 完成
+// 覆盖类操作（Set*）在一个事务中写入业务表，提交后重新加载策略并只广播一次
+type policyRepo struct {
+	data     *Data
+	enforcer *casbin.SyncedEnforcer
+	adapter  *SysPermissionAdapter
+	watcher  *AuthzWatcher
+	log      *log.Helper
+}
+
+func NewPolicyRepo(data *Data, enforcer *casbin.SyncedEnforcer, watcher *AuthzWatcher, logger log.Logger) biz.PolicyRepo {
+	return &policyRepo{
+		data:     data,
+		enforcer: enforcer,
+		adapter:  &SysPermissionAdapter{db: data.db},
+		watcher:  watcher,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (r *policyRepo) ReloadPolicy(_ context.Context) error {
+	return r.enforcer.LoadPolicy()
+}
+
+func (r *policyRepo) SetUserRoles(ctx context.Context, tenantID, userID int64, roleCodes []string, expireAt *time.Time) error {
+	// 先清理已到期的角色，避免重新分配时与残留记录重复
+	if _, err := r.RemoveExpiredUserRoles(ctx); err != nil {
+		return err
+	}
+
+	sub := strconv.FormatInt(userID, 10)
+	dom := strconv.FormatInt(tenantID, 10)
+
+	var want [][]string
+	for _, code := range roleCodes {
+		want = append(want, []string{sub, code, dom})
+	}
+	current, err := r.enforcer.GetFilteredGroupingPolicy(0, sub, "", dom)
+	if err != nil {
+		return err
+	}
+	return r.apply(ctx, "g", current, want, func(ctx context.Context) error {
+		// 到期时间不属于 Casbin 策略，直接更新业务表
+		return r.data.DB(ctx).Model(&model.SysUserRole{}).
+			Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+			Update("expire_at", expireAt).Error
+	})
+}
+
+func (r *policyRepo) SetRoleParents(ctx context.Context, tenantID int64, roleCode string, parentCodes []string) error {
+	dom := strconv.FormatInt(tenantID, 10)
+
+	var want [][]string
+	for _, code := range parentCodes {
+		want = append(want, []string{roleCode, code, dom})
+	}
+	current, err := r.enforcer.GetFilteredGroupingPolicy(0, roleCode, "", dom)
+	if err != nil {
+		return err
+	}
+	return r.apply(ctx, "g", current, want, nil)
+}
+
+func (r *policyRepo) SetRolePermissions(ctx context.Context, tenantID int64, roleCode string, grants []*biz.RoleGrant) error {
+	dom := strconv.FormatInt(tenantID, 10)
+
+	var want [][]string
+	for _, g := range grants {
+		cond := g.Condition
+		if cond == "" {
+			cond = pkgCasbin.NoCondition
+		}
+		eft := g.Effect
+		if eft == "" {
+			eft = pkgCasbin.EffectAllow
+		}
+		want = append(want, []string{roleCode, dom, g.PermCode, "V", g.DataScope, cond, eft})
+	}
+	current, err := r.enforcer.GetFilteredPolicy(0, roleCode, dom)
+	if err != nil {
+		return err
+	}
+	return r.apply(ctx, "p", current, want, nil)
+}
+
+func (r *policyRepo) RemoveExpiredUserRoles(ctx context.Context) (int, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	now := time.Now()
+
+	type expiredRole struct {
+		UserID   string `gorm:"column:user_id"`
+		RoleCode string `gorm:"column:role_code"`
+		TenantID string `gorm:"column:tenant_id"`
+	}
+	var list []expiredRole
+	if err := r.data.DB(ctx).Table("sys_user_role ur").
+		Select("ur.user_id, r.code as role_code, ur.tenant_id").
+		Joins("join sys_role r on ur.role_id = r.id").
+		Where("ur.expire_at <= ?", now).
+		Scan(&list).Error; err != nil {
+		return 0, err
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+
+	// 仍在内存中的规则经 Enforcer 移除，以便通知其他节点
+	var rules [][]string
+	for _, ur := range list {
+		rule := []string{ur.UserID, ur.RoleCode, ur.TenantID}
+		if ok, _ := r.enforcer.HasGroupingPolicy(rule); ok {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) > 0 {
+		if _, err := r.enforcer.RemoveGroupingPolicies(rules); err != nil {
+			return 0, err
+		}
+	}
+
+	// 未加载到内存的到期记录直接删除
+	if err := r.data.DB(ctx).Unscoped().
+		Where("expire_at <= ?", now).
+		Delete(&model.SysUserRole{}).Error; err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+func (r *policyRepo) GetUserRoles(_ context.Context, tenantID, userID int64) ([]string, error) {
+	return r.enforcer.GetImplicitRolesForUser(strconv.FormatInt(userID, 10), strconv.FormatInt(tenantID, 10))
+}
+
+func (r *policyRepo) IsAdmin(_ context.Context, userID int64) bool {
+	return pkgCasbin.IsAdmin(r.enforcer, strconv.FormatInt(userID, 10))
+}
+
+func (r *policyRepo) Enforce(_ context.Context, tenantID, userID int64, permCode string, attr *pkgCasbin.Attributes) (bool, []string, error) {
+	sub := strconv.FormatInt(userID, 10)
+	// 超级管理员直接放行，策略表为空时 matcher 中的 eval 会报错
+	if pkgCasbin.IsAdmin(r.enforcer, sub) {
+		return true, nil, nil
+	}
+	return r.enforcer.EnforceEx(sub, strconv.FormatInt(tenantID, 10), permCode, "V", attr)
+}
+
+func (r *policyRepo) ScopeGrants(_ context.Context, tenantID, userID int64, permCodes []string, attr *pkgCasbin.Attributes) []provider.ScopeGrant {
+	return pkgCasbin.CollectGrants(r.enforcer, strconv.FormatInt(userID, 10), strconv.FormatInt(tenantID, 10), permCodes, attr)
+}
+
+// apply 对比现有策略与目标策略，在一个事务中增删差异部分（并执行 then），ctx 已处于事务中时加入该事务
+// 提交后重新加载本节点策略并广播一次，回滚时内存中的策略保持不变
+func (r *policyRepo) apply(ctx context.Context, sec string, current, want [][]string, then func(ctx context.Context) error) error {
+	contains := func(list [][]string, rule []string) bool {
+		return slices.ContainsFunc(list, func(v []string) bool { return slices.Equal(v, rule) })
+	}
+
+	var removed, added [][]string
+	for _, rule := range current {
+		if !contains(want, rule) {
+			removed = append(removed, rule)
+		}
+	}
+	for _, rule := range want {
+		if !contains(current, rule) && !contains(added, rule) {
+			added = append(added, rule)
+		}
+	}
+
+	// 适配器按规则中的租户显式读写，不经过数据权限插件
+	return r.data.InTx(auth.WithSkipDataScope(ctx), func(ctx context.Context) error {
+		tx := r.data.DB(ctx)
+		for _, rule := range removed {
+			if err := r.adapter.removeFilteredPolicy(tx, sec, sec, 0, rule...); err != nil {
+				return err
+			}
+		}
+		for _, rule := range added {
+			if err := r.adapter.addPolicy(tx, sec, sec, rule); err != nil {
+				return err
+			}
+		}
+		if then != nil {
+			if err := then(ctx); err != nil {
+				return err
+			}
+		}
+		if len(removed) > 0 || len(added) > 0 {
+			biz.AfterCommit(ctx, r.reload)
+		}
+		return nil
+	})
+}
+
+// reload 重新加载本节点的策略并通知其他节点
+func (r *policyRepo) reload(ctx context.Context) {
+	if err := r.enforcer.LoadPolicy(); err != nil {
+		r.log.WithContext(ctx).Errorf("reload casbin policy failed: %v", err)
+	}
+	if err := r.watcher.Publish(context.WithoutCancel(ctx), biz.TopicPolicy); err != nil {
+		r.log.WithContext(ctx).Errorf("publish policy update failed: %v", err)
+	}
+}
@@ -0,0 +1,369 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+// topicRecorder 记录本节点收到的失效主题
+type topicRecorder struct {
+	mu     sync.Mutex
+	topics []string
+}
+
+func (r *topicRecorder) handle(_ context.Context, topics []string) {
+	r.mu.Lock()
+	r.topics = append(r.topics, topics...)
+	r.mu.Unlock()
+}
+
+func (r *topicRecorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	topics := r.topics
+	r.topics = nil
+	return topics
+}
+
+func TestAuthzWatcherDeptWrites(t *testing.T) {
+	d := newTestData(t)
+	_, w := newTestWatcher(t, d)
+	local := &topicRecorder{}
+	w.Subscribe(local.handle)
+
+	// 其他节点通过 Pub/Sub 收到广播
+	sub := d.RDB().Subscribe(context.Background(), authzChannel)
+	defer sub.Close()
+	if _, err := sub.Receive(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	expectBroadcast := func(t *testing.T) {
+		t.Helper()
+		select {
+		case msg := <-sub.Channel():
+			var event authzEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil || !slices.Contains(event.Topics, biz.TopicDataScope) {
+				t.Fatalf("broadcast = %s", msg.Payload)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("no broadcast")
+		}
+	}
+	expectLocal := func(t *testing.T, want bool) {
+		t.Helper()
+		if got := slices.Contains(local.take(), biz.TopicDataScope); got != want {
+			t.Fatalf("local invalidation = %v, want %v", got, want)
+		}
+	}
+
+	ctx := tenantContext(1, 1, 0, auth.ScopeAll)
+	dept := &model.SysDept{Name: "dev", Ancestors: "0"}
+
+	t.Run("create", func(t *testing.T) {
+		if err := d.DB(ctx).Create(dept).Error; err != nil {
+			t.Fatal(err)
+		}
+		expectLocal(t, true)
+		expectBroadcast(t)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			if err := d.DB(ctx).Model(dept).Update("ancestors", "0,1").Error; err != nil {
+				return err
+			}
+			expectLocal(t, false) // 提交前不失效
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectLocal(t, true)
+		expectBroadcast(t)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		rollback := errors.New("rollback")
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			if err := d.DB(ctx).Delete(dept).Error; err != nil {
+				return err
+			}
+			return rollback
+		})
+		if !errors.Is(err, rollback) {
+			t.Fatal(err)
+		}
+		expectLocal(t, false)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := d.DB(ctx).Delete(dept).Error; err != nil {
+			t.Fatal(err)
+		}
+		expectLocal(t, true)
+		expectBroadcast(t)
+	})
+
+	t.Run("other tables", func(t *testing.T) {
+		if err := d.DB(ctx).Create(&model.SysUser{Username: "alice"}).Error; err != nil {
+			t.Fatal(err)
+		}
+		expectLocal(t, false)
+	})
+}
+
+// newPolicyFixture 租户 1 的角色 editor、viewer 及权限 user:list、user:edit
+func newPolicyFixture(t *testing.T, d *Data) {
+	t.Helper()
+	db := d.DB(auth.WithSkipDataScope(context.Background()))
+	for _, code := range []string{"editor", "viewer"} {
+		role := &model.SysRole{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Name: code, Code: code}
+		if err := db.Create(role).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, code := range []string{"user:list", "user:edit"} {
+		if err := db.Create(&model.SysPermission{Name: code, Code: code, Type: "API"}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPolicyRepoApply(t *testing.T) {
+	d := newTestData(t)
+	newPolicyFixture(t, d)
+	e, w := newTestWatcher(t, d)
+	repo := NewPolicyRepo(d, e, w, d.logger).(*policyRepo)
+	ctx := context.Background()
+
+	sub := d.RDB().Subscribe(ctx, authzChannel)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		t.Fatal(err)
+	}
+	broadcasts := func() int {
+		n := 0
+		for {
+			select {
+			case <-sub.Channel():
+				n++
+			case <-time.After(100 * time.Millisecond):
+				return n
+			}
+		}
+	}
+	grants := func(codes ...string) []*biz.RoleGrant {
+		var list []*biz.RoleGrant
+		for _, code := range codes {
+			list = append(list, &biz.RoleGrant{PermCode: code, DataScope: auth.ScopeAll})
+		}
+		return list
+	}
+	stored := func() int64 {
+		var n int64
+		if err := d.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysRolePermission{}).Count(&n).Error; err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	perms := func() int {
+		list, err := e.GetFilteredPolicy(0, "editor", "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(list)
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		if err := repo.SetRolePermissions(ctx, 1, "editor", grants("user:list")); err != nil {
+			t.Fatal(err)
+		}
+		if n := broadcasts(); n != 1 {
+			t.Fatalf("broadcasts = %d, want 1", n)
+		}
+		// 删除 user:list、新增 user:edit，只广播一次
+		if err := repo.SetRolePermissions(ctx, 1, "editor", grants("user:edit")); err != nil {
+			t.Fatal(err)
+		}
+		if n := broadcasts(); n != 1 {
+			t.Fatalf("broadcasts = %d, want 1", n)
+		}
+		if ok, _ := e.HasPolicy("editor", "1", "user:edit", "V", auth.ScopeAll, pkgCasbin.NoCondition, pkgCasbin.EffectAllow); !ok {
+			t.Fatal("policy not reloaded")
+		}
+		if n := perms(); n != 1 {
+			t.Fatalf("policies = %d, want 1", n)
+		}
+	})
+
+	t.Run("failure rolls back removal", func(t *testing.T) {
+		// 删除 user:edit 成功后新增不存在的权限失败，整体回滚
+		err := repo.SetRolePermissions(ctx, 1, "editor", grants("user:missing"))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if n := stored(); n != 1 {
+			t.Fatalf("stored = %d, want 1", n)
+		}
+		if n := perms(); n != 1 {
+			t.Fatalf("policies = %d, want 1", n)
+		}
+		if n := broadcasts(); n != 0 {
+			t.Fatalf("broadcasts = %d, want 0", n)
+		}
+	})
+
+	t.Run("outer rollback", func(t *testing.T) {
+		rollback := errors.New("rollback")
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			if err := repo.SetRolePermissions(ctx, 1, "editor", grants("user:list", "user:edit")); err != nil {
+				return err
+			}
+			return rollback
+		})
+		if !errors.Is(err, rollback) {
+			t.Fatal(err)
+		}
+		if n := perms(); n != 1 {
+			t.Fatalf("policies = %d, want 1", n)
+		}
+		if n := broadcasts(); n != 0 {
+			t.Fatalf("broadcasts = %d, want 0", n)
+		}
+	})
+
+	t.Run("user roles with expire", func(t *testing.T) {
+		expireAt := time.Now().Add(time.Hour)
+		if err := repo.SetUserRoles(ctx, 1, 100, []string{"editor", "viewer"}, &expireAt); err != nil {
+			t.Fatal(err)
+		}
+		roles, err := repo.GetUserRoles(ctx, 1, 100)
+		if err != nil || len(roles) != 2 {
+			t.Fatalf("roles = %v, %v", roles, err)
+		}
+		var n int64
+		err = d.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysUserRole{}).Where("expire_at IS NOT NULL").Count(&n).Error
+		if err != nil || n != 2 {
+			t.Fatalf("roles with expire_at = %d, want 2", n)
+		}
+		if n := broadcasts(); n != 1 {
+			t.Fatalf("broadcasts = %d, want 1", n)
+		}
+	})
+}
+
+func TestSysPermissionAdapterLoadPolicyError(t *testing.T) {
+	d := newTestData(t)
+	e, _ := newTestWatcher(t, d)
+	if err := d.db.Migrator().DropTable(&model.SysRoleInherit{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.LoadPolicy(); err == nil {
+		t.Fatal("LoadPolicy ignored query error")
+	}
+}
+
+// TestLoadPolicySkipsTrashed 回收站中的角色、权限不授予访问，恢复后重新生效
+func TestLoadPolicySkipsTrashed(t *testing.T) {
+	d := newTestData(t)
+	newPolicyFixture(t, d)
+	e, w := newTestWatcher(t, d)
+	repo := NewPolicyRepo(d, e, w, d.logger)
+	recycle := NewRecycleRepo(d, d.logger)
+	ctx := tenantContext(1, 1, 0, auth.ScopeAll)
+
+	if err := repo.SetRolePermissions(ctx, 1, "viewer", []*biz.RoleGrant{{PermCode: "user:list", DataScope: auth.ScopeAll}}); err != nil {
+		t.Fatal(err)
+	}
+	// 其他角色的授权，避免策略为空时 eval 报错
+	if err := repo.SetRolePermissions(ctx, 1, "editor", []*biz.RoleGrant{{PermCode: "user:edit", DataScope: auth.ScopeAll}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetUserRoles(ctx, 1, 100, []string{"viewer"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	allowed := func(t *testing.T, want bool) {
+		t.Helper()
+		if err := e.LoadPolicy(); err != nil {
+			t.Fatal(err)
+		}
+		ok, _, err := repo.Enforce(ctx, 1, 100, "user:list", &pkgCasbin.Attributes{})
+		if err != nil || ok != want {
+			t.Fatalf("enforce = %v, %v, want %v", ok, err, want)
+		}
+	}
+	allowed(t, true)
+
+	for _, c := range []struct {
+		kind  string
+		model interface{}
+		where string
+		arg   string
+	}{
+		{biz.RecycleRole, &model.SysRole{}, "code = ?", "viewer"},
+		{biz.RecyclePermission, &model.SysPermission{}, "code = ?", "user:list"},
+	} {
+		t.Run(c.kind, func(t *testing.T) {
+			var id int64
+			if err := d.DB(ctx).Model(c.model).Where(c.where, c.arg).Pluck("id", &id).Error; err != nil {
+				t.Fatal(err)
+			}
+			if err := d.DB(ctx).Delete(c.model, id).Error; err != nil {
+				t.Fatal(err)
+			}
+			allowed(t, false)
+
+			if n, err := recycle.RestoreTrashed(ctx, c.kind, []int64{id}); err != nil || n != 1 {
+				t.Fatalf("restore = %d, %v", n, err)
+			}
+			allowed(t, true)
+		})
+	}
+}
+
+// TestLoadPolicySkipsTrashedInherit 回收站中的父角色不再被继承
+func TestLoadPolicySkipsTrashedInherit(t *testing.T) {
+	d := newTestData(t)
+	newPolicyFixture(t, d)
+	e, w := newTestWatcher(t, d)
+	repo := NewPolicyRepo(d, e, w, d.logger)
+	ctx := tenantContext(1, 1, 0, auth.ScopeAll)
+
+	if err := repo.SetRoleParents(ctx, 1, "viewer", []string{"editor"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetUserRoles(ctx, 1, 100, []string{"viewer"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	roles := func() []string {
+		t.Helper()
+		if err := e.LoadPolicy(); err != nil {
+			t.Fatal(err)
+		}
+		list, err := repo.GetUserRoles(ctx, 1, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		slices.Sort(list)
+		return list
+	}
+	if got := roles(); !slices.Equal(got, []string{"editor", "viewer"}) {
+		t.Fatalf("roles = %v", got)
+	}
+	if err := d.DB(ctx).Where("code = ?", "editor").Delete(&model.SysRole{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if got := roles(); !slices.Equal(got, []string{"viewer"}) {
+		t.Fatalf("roles after trashing parent = %v", got)
+	}
+}
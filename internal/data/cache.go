@@ -0,0 +1,265 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const cacheKeyPrefix = "cache:"
+
+// Redis 中值的首字节：存在的结果、不存在的结果（负缓存）
+const (
+	cacheValueFound    = 'v'
+	cacheValueNotFound = 'n'
+)
+
+// 查询结果，作为指标的 cache.result 属性
+const (
+	cacheLocalHit = "local_hit"
+	cacheHit      = "hit"
+	cacheMiss     = "miss"
+)
+
+// cacheLookups 按缓存名称及结果统计查询次数，使用 OpenTelemetry 全局 MeterProvider
+var cacheLookups, _ = otel.Meter("github.com/sober-studio/bubble-admin-go-kratos/internal/data").Int64Counter(
+	"cache.lookups",
+	metric.WithDescription("旁路缓存查询次数，按缓存名称及命中结果（local_hit、hit、miss）统计"),
+	metric.WithUnit("{lookup}"),
+)
+
+// CacheConfig 旁路缓存配置
+type CacheConfig[T any] struct {
+	// TTL Redis 中的过期时间，实际过期时间增加最多 Jitter 比例的随机值，避免同时失效
+	TTL    time.Duration
+	Jitter float64 // 默认 0.1
+	// NotFound 回源返回该错误时缓存“不存在”的结果 NegativeTTL，命中时返回该错误；NegativeTTL 为 0 时不缓存
+	NotFound    error
+	NegativeTTL time.Duration
+	// LocalSize 进程内缓存的条目数，0 表示不启用；进程内缓存只在本节点写入时失效，
+	// 其他节点依赖 LocalTTL 过期，LocalTTL 需足够短
+	LocalSize int
+	LocalTTL  time.Duration
+	// Encode / Decode 序列化方式，默认 JSON
+	Encode func(T) ([]byte, error)
+	Decode func([]byte) (T, error)
+}
+
+// Cache 旁路缓存：依次查询进程内缓存、Redis，都未命中时回源加载并写入缓存
+// 同一个 key 的并发回源合并为一次，事务内及 biz.WithPrimary 的读取直接回源
+type Cache[T any] struct {
+	name  string
+	conf  CacheConfig[T]
+	rdb   *redis.Client
+	local *localCache
+	group singleflight.Group
+	log   *log.Helper
+}
+
+// sharedCache 按名称返回 Data 上共享的缓存实例，使各仓库的写入能使同一个进程内缓存失效
+func sharedCache[T any](data *Data, name string, c CacheConfig[T]) *Cache[T] {
+	v, _ := data.caches.LoadOrStore(name, sync.OnceValue(func() any {
+		return newCache(data, name, c)
+	}))
+	return v.(func() any)().(*Cache[T])
+}
+
+func newCache[T any](data *Data, name string, c CacheConfig[T]) *Cache[T] {
+	if c.Jitter <= 0 {
+		c.Jitter = 0.1
+	}
+	if c.Encode == nil {
+		c.Encode = func(v T) ([]byte, error) { return json.Marshal(v) }
+	}
+	if c.Decode == nil {
+		c.Decode = func(b []byte) (T, error) {
+			var v T
+			err := json.Unmarshal(b, &v)
+			return v, err
+		}
+	}
+	cache := &Cache[T]{
+		name: name,
+		conf: c,
+		rdb:  data.rdb,
+		log:  log.NewHelper(log.With(data.logger, "module", "data/cache", "cache", name)),
+	}
+	if c.LocalSize > 0 && c.LocalTTL > 0 {
+		cache.local = newLocalCache(c.LocalSize)
+	}
+	return cache
+}
+
+// Get 查询缓存，未命中时调用 load 回源
+// load 的结果不能依赖调用方的租户及数据权限（并发回源时共享第一个调用方的结果），影响结果的参数需体现在 key 中
+func (c *Cache[T]) Get(ctx context.Context, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	if _, inTx := ctx.Value(contextTxKey{}).(*gorm.DB); inTx || biz.IsPrimary(ctx) {
+		return load(ctx)
+	}
+
+	if c.local != nil {
+		if raw, ok := c.local.get(key); ok {
+			c.count(ctx, cacheLocalHit)
+			return c.decode(raw)
+		}
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		raw, err := c.rdb.Get(ctx, c.redisKey(key)).Bytes()
+		if err == nil {
+			c.count(ctx, cacheHit)
+			return raw, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			// Redis 不可用时直接回源，不影响查询
+			c.log.WithContext(ctx).Warnf("get %s failed: %v", key, err)
+		}
+		c.count(ctx, cacheMiss)
+
+		value, err := load(context.WithoutCancel(ctx))
+		switch {
+		case err == nil:
+			if raw, err = c.conf.Encode(value); err != nil {
+				return nil, err
+			}
+			raw = append([]byte{cacheValueFound}, raw...)
+			c.set(ctx, key, raw, c.conf.TTL)
+		case c.conf.NotFound != nil && errors.Is(err, c.conf.NotFound) && c.conf.NegativeTTL > 0:
+			raw = []byte{cacheValueNotFound}
+			c.set(ctx, key, raw, c.conf.NegativeTTL)
+		default:
+			return nil, err
+		}
+		return raw, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	raw := v.([]byte)
+	if c.local != nil {
+		c.local.set(key, raw, c.conf.LocalTTL)
+	}
+	return c.decode(raw)
+}
+
+// Delete 删除缓存，ctx 处于事务中时在提交后删除
+func (c *Cache[T]) Delete(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	biz.AfterCommit(ctx, func(ctx context.Context) {
+		if c.local != nil {
+			c.local.delete(keys...)
+		}
+		redisKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			redisKeys = append(redisKeys, c.redisKey(key))
+		}
+		if err := c.rdb.Del(context.WithoutCancel(ctx), redisKeys...).Err(); err != nil {
+			c.log.WithContext(ctx).Errorf("delete %v failed: %v", keys, err)
+		}
+	})
+}
+
+func (c *Cache[T]) decode(raw []byte) (T, error) {
+	var zero T
+	if len(raw) == 0 || raw[0] == cacheValueNotFound {
+		return zero, c.conf.NotFound
+	}
+	return c.conf.Decode(raw[1:])
+}
+
+func (c *Cache[T]) set(ctx context.Context, key string, raw []byte, ttl time.Duration) {
+	ttl += time.Duration(rand.Float64() * c.conf.Jitter * float64(ttl))
+	if err := c.rdb.Set(ctx, c.redisKey(key), raw, ttl).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("set %s failed: %v", key, err)
+	}
+}
+
+func (c *Cache[T]) redisKey(key string) string {
+	return cacheKeyPrefix + c.name + ":" + key
+}
+
+func (c *Cache[T]) count(ctx context.Context, result string) {
+	countLookup(ctx, c.name, result)
+}
+
+// countLookup 记录一次缓存查询，不经 Cache 实现的缓存（如字典）同样使用
+func countLookup(ctx context.Context, name, result string) {
+	cacheLookups.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cache.name", name),
+		attribute.String("cache.result", result),
+	))
+}
+
+// localCache 进程内缓存，条目数达到上限时先清理过期条目，仍然不足时随机淘汰
+type localCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]localEntry
+}
+
+type localEntry struct {
+	raw      []byte
+	expireAt time.Time
+}
+
+func newLocalCache(size int) *localCache {
+	return &localCache{size: size, entries: make(map[string]localEntry, size)}
+}
+
+func (l *localCache) get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expireAt) {
+		delete(l.entries, key)
+		return nil, false
+	}
+	return e.raw, true
+}
+
+func (l *localCache) set(key string, raw []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.entries[key]; !ok && len(l.entries) >= l.size {
+		now := time.Now()
+		for k, e := range l.entries {
+			if now.After(e.expireAt) {
+				delete(l.entries, k)
+			}
+		}
+		for k := range l.entries {
+			if len(l.entries) < l.size {
+				break
+			}
+			delete(l.entries, k)
+		}
+	}
+	l.entries[key] = localEntry{raw: raw, expireAt: time.Now().Add(ttl)}
+}
+
+func (l *localCache) delete(keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		delete(l.entries, key)
+	}
+}
@@ -0,0 +1,345 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+var errCacheTestNotFound = errors.New("not found")
+
+// cached Redis 中是否存在缓存
+func cached(t *testing.T, d *Data, name, key string) bool {
+	t.Helper()
+	n, err := d.rdb.Exists(context.Background(), cacheKeyPrefix+name+":"+key).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n > 0
+}
+
+func TestCache(t *testing.T) {
+	d := newTestData(t)
+	ctx := context.Background()
+	c := newCache(d, "test", CacheConfig[string]{
+		TTL:         time.Minute,
+		NotFound:    errCacheTestNotFound,
+		NegativeTTL: time.Minute,
+	})
+	var loads atomic.Int32
+	load := func(v string, err error) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) {
+			loads.Add(1)
+			return v, err
+		}
+	}
+
+	t.Run("read through", func(t *testing.T) {
+		loads.Store(0)
+		for range 2 {
+			if v, err := c.Get(ctx, "a", load("A", nil)); err != nil || v != "A" {
+				t.Fatalf("get = %q, %v", v, err)
+			}
+		}
+		if loads.Load() != 1 {
+			t.Fatalf("loads = %d, want 1", loads.Load())
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		loads.Store(0)
+		for range 2 {
+			if _, err := c.Get(ctx, "missing", load("", errCacheTestNotFound)); !errors.Is(err, errCacheTestNotFound) {
+				t.Fatalf("get missing: %v", err)
+			}
+		}
+		if loads.Load() != 1 {
+			t.Fatalf("loads = %d, want 1", loads.Load())
+		}
+		// 其他错误不缓存
+		errLoad := errors.New("load failed")
+		for range 2 {
+			if _, err := c.Get(ctx, "broken", load("", errLoad)); !errors.Is(err, errLoad) {
+				t.Fatalf("get broken: %v", err)
+			}
+		}
+		if loads.Load() != 3 || cached(t, d, "test", "broken") {
+			t.Fatalf("loads = %d, errors should not be cached", loads.Load())
+		}
+	})
+
+	t.Run("singleflight", func(t *testing.T) {
+		loads.Store(0)
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := c.Get(ctx, "slow", func(context.Context) (string, error) {
+					loads.Add(1)
+					<-release
+					return "S", nil
+				})
+				if err != nil || v != "S" {
+					t.Errorf("get = %q, %v", v, err)
+				}
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		if loads.Load() != 1 {
+			t.Fatalf("loads = %d, want 1", loads.Load())
+		}
+	})
+
+	t.Run("bypass", func(t *testing.T) {
+		loads.Store(0)
+		if v, _ := c.Get(biz.WithPrimary(ctx), "a", load("primary", nil)); v != "primary" {
+			t.Fatalf("WithPrimary read = %q", v)
+		}
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			if v, _ := c.Get(ctx, "a", load("tx", nil)); v != "tx" {
+				t.Fatalf("read in tx = %q", v)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 直接回源的结果不写入缓存
+		if v, _ := c.Get(ctx, "a", load("B", nil)); v != "A" {
+			t.Fatalf("cached value = %q, want A", v)
+		}
+	})
+
+	t.Run("delete after commit", func(t *testing.T) {
+		errRollback := errors.New("rollback")
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			c.Delete(ctx, "a")
+			if !cached(t, d, "test", "a") {
+				t.Fatal("deleted before commit")
+			}
+			return errRollback
+		})
+		if !errors.Is(err, errRollback) || !cached(t, d, "test", "a") {
+			t.Fatalf("rollback: %v, cache should be kept", err)
+		}
+		err = d.InTx(ctx, func(ctx context.Context) error {
+			c.Delete(ctx, "a")
+			return nil
+		})
+		if err != nil || cached(t, d, "test", "a") {
+			t.Fatalf("commit: %v, cache should be deleted", err)
+		}
+	})
+
+	t.Run("local", func(t *testing.T) {
+		lc := newCache(d, "local", CacheConfig[string]{TTL: time.Minute, LocalSize: 2, LocalTTL: time.Minute})
+		if _, err := lc.Get(ctx, "a", load("A", nil)); err != nil {
+			t.Fatal(err)
+		}
+		// Redis 中的缓存被其他节点删除后，本节点仍命中进程内缓存
+		if err := d.rdb.Del(ctx, lc.redisKey("a")).Err(); err != nil {
+			t.Fatal(err)
+		}
+		if v, _ := lc.Get(ctx, "a", load("B", nil)); v != "A" {
+			t.Fatalf("local hit = %q, want A", v)
+		}
+		// 本节点的删除同时清除进程内缓存
+		lc.Delete(ctx, "a")
+		if v, _ := lc.Get(ctx, "a", load("B", nil)); v != "B" {
+			t.Fatalf("after delete = %q, want B", v)
+		}
+	})
+}
+
+// TestUserCacheInvalidation 写入 sys_user 的各仓库方法均使用户缓存失效
+func TestUserCacheInvalidation(t *testing.T) {
+	d := newTestData(t)
+	createDeptTree(t, d)
+	ctx := tenantContext(1, 0, 1, auth.ScopeAll)
+	users := NewSysUserRepo(d, d.logger)
+	admin := NewAdminRepo(d, d.logger)
+	notice := NewNoticeRepo(d, d.logger)
+
+	u, err := users.CreateUser(ctx, &biz.SysUser{Username: "alice", Phone: "13800000001", DeptID: 1, TenantID: 1, IsAvailable: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := strconv.FormatInt(u.ID, 10)
+
+	for _, tc := range []struct {
+		name  string
+		write func(ctx context.Context) error
+	}{
+		{"UpdatePassword", func(ctx context.Context) error { return users.UpdatePassword(ctx, u.ID, "hash") }},
+		{"UpdatePhone", func(ctx context.Context) error { return users.UpdatePhone(ctx, u.ID, "13800000002") }},
+		{"admin.UpdatePassword", func(ctx context.Context) error { return admin.UpdatePassword(ctx, u.ID, "hash2") }},
+		{"admin.UpdateStatus", func(ctx context.Context) error { return admin.UpdateStatus(ctx, u.ID, biz.UserStatusEnabled) }},
+		{"admin.ResetLoginFailed", func(ctx context.Context) error { return admin.ResetLoginFailed(ctx, u.ID) }},
+		{"notice.UpdateNotifyChannels", func(ctx context.Context) error {
+			return notice.UpdateNotifyChannels(ctx, u.ID, []string{"email"})
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := users.GetUserByID(ctx, u.ID); err != nil {
+				t.Fatal(err)
+			}
+			if !cached(t, d, "user", key) {
+				t.Fatal("user should be cached")
+			}
+			// 事务内的写入在提交后失效
+			err := d.InTx(ctx, func(ctx context.Context) error {
+				if err := tc.write(ctx); err != nil {
+					return err
+				}
+				if !cached(t, d, "user", key) {
+					t.Fatal("invalidated before commit")
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cached(t, d, "user", key) {
+				t.Fatal("user cache should be invalidated")
+			}
+		})
+	}
+
+	got, err := users.GetUserByID(ctx, u.ID)
+	if err != nil || got.Phone != "13800000002" {
+		t.Fatalf("reloaded user = %+v, %v", got, err)
+	}
+}
+
+// TestGetUserByIDDataScope 缓存命中时同样校验租户及数据范围
+func TestGetUserByIDDataScope(t *testing.T) {
+	d := newTestData(t)
+	createDeptTree(t, d)
+	repo := NewSysUserRepo(d, d.logger)
+	all := tenantContext(1, 0, 1, auth.ScopeAll)
+
+	u, err := repo.CreateUser(all, &biz.SysUser{Username: "bob", DeptID: 13, TenantID: 1, IsAvailable: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetUserByID(all, u.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !cached(t, d, "user", strconv.FormatInt(u.ID, 10)) {
+		t.Fatal("user should be cached")
+	}
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		visible bool
+	}{
+		{"all", all, true},
+		{"login only", tenantContext(1, 0, 0, ""), true},
+		{"dept sub", tenantContext(1, 0, 1, auth.ScopeDeptSub), true},
+		{"dept sub outside", tenantContext(1, 0, 11, auth.ScopeDeptSub), false},
+		{"dept", tenantContext(1, 0, 1, auth.ScopeDept), false},
+		{"self", tenantContext(1, 99, 13, auth.ScopeSelf), false},
+		{"other tenant", tenantContext(2, 0, 0, auth.ScopeAll), false},
+		{"skip data scope", auth.WithSkipDataScope(context.Background()), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := repo.GetUserByID(tc.ctx, u.ID)
+			switch {
+			case tc.visible && err != nil:
+				t.Fatalf("should be visible: %v", err)
+			case !tc.visible && !errors.Is(err, biz.ErrUserNotFound):
+				t.Fatalf("should be hidden: %v", err)
+			}
+		})
+	}
+}
+
+func TestDictCache(t *testing.T) {
+	d := newTestData(t)
+	c := NewRedisDictCache(d)
+	ctx := context.Background()
+	items := map[string][]*biz.DictItem{"gender": {{TypeCode: "gender", Label: "男", Value: "1"}}}
+
+	if err := c.SetDicts(ctx, 1, items); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetDicts(ctx, 1, []string{"gender", "status"})
+	if err != nil || len(got) != 1 || len(got["gender"]) != 1 {
+		t.Fatalf("get = %v, %v", got, err)
+	}
+
+	errRollback := errors.New("rollback")
+	err = d.InTx(ctx, func(ctx context.Context) error {
+		if err := c.DeleteDicts(ctx, "gender"); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatal(err)
+	}
+	if got, _ = c.GetDicts(ctx, 1, []string{"gender"}); len(got) != 1 {
+		t.Fatal("rolled back delete should keep the cache")
+	}
+
+	err = d.InTx(ctx, func(ctx context.Context) error {
+		if err := c.DeleteDicts(ctx, "gender"); err != nil {
+			return err
+		}
+		if got, _ := c.GetDicts(ctx, 1, []string{"gender"}); len(got) != 1 {
+			t.Fatal("deleted before commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ = c.GetDicts(ctx, 1, []string{"gender"}); len(got) != 0 {
+		t.Fatal("dict cache should be deleted after commit")
+	}
+}
+
+func TestApiPermissionCache(t *testing.T) {
+	d := newTestData(t)
+	repo := newPermissionRepo(d, d.logger)
+	ctx := auth.WithSkipDataScope(context.Background())
+
+	sync := func(perms ...*biz.ApiPermission) {
+		t.Helper()
+		if err := repo.SyncApiPermissions(ctx, perms); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sync(&biz.ApiPermission{Operation: "/api.user.v1.User/ListUsers", Code: "user:list", Name: "用户列表"})
+	perms, err := repo.LoadAllApiPermissions(ctx)
+	if err != nil || len(perms) != 1 {
+		t.Fatalf("load = %v, %v", perms, err)
+	}
+	if !cached(t, d, "api_permission", apiPermissionKey) {
+		t.Fatal("api permissions should be cached")
+	}
+
+	// 同步后缓存失效，重新加载得到新的映射
+	sync(
+		&biz.ApiPermission{Operation: "/api.user.v1.User/ListUsers", Code: "user:list", Name: "用户列表"},
+		&biz.ApiPermission{Operation: "/api.user.v1.User/DeleteUser", Code: "user:delete", Name: "删除用户"},
+	)
+	if cached(t, d, "api_permission", apiPermissionKey) {
+		t.Fatal("sync should invalidate the cache")
+	}
+	if perms, err = repo.LoadAllApiPermissions(ctx); err != nil || len(perms) != 2 {
+		t.Fatalf("reload = %v, %v", perms, err)
+	}
+}
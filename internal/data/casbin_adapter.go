@@ -1,11 +1,25 @@
 package data
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/casbin/casbin/v3/model"
 	"github.com/casbin/casbin/v3/persist"
+	dataModel "github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
+)
+
+var (
+	_ persist.Adapter      = (*SysPermissionAdapter)(nil)
+	_ persist.BatchAdapter = (*SysPermissionAdapter)(nil)
 )
 
 type SysPermissionAdapter struct {
@@ -18,51 +32,358 @@ func NewSysPermissionAdapter(db *gorm.DB) persist.Adapter {
 
 // LoadPolicy 将业务数据库的关联关系“翻译”给 Casbin 内存
 func (a *SysPermissionAdapter) LoadPolicy(m model.Model) error {
-	// 1. 加载用户-角色继承 (g)
+	// 策略变更后立即重新加载，读取主库
+	db := a.db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+
+	// 回收站中的角色、权限及其关联不加载，恢复后重新加载即生效
+
+	// 1. 加载用户-角色继承 (g)，已到期的角色不加载
 	type UserRole struct {
 		UserID   string `gorm:"column:user_id"`
 		RoleCode string `gorm:"column:role_code"`
 		TenantID string `gorm:"column:tenant_id"`
 	}
 	var urList []UserRole
-	a.db.Table("sys_user_role ur").
+	if err := db.Table("sys_user_role ur").
 		Select("ur.user_id, r.code as role_code, ur.tenant_id").
-		Joins("left join sys_role r on ur.role_id = r.id").
-		Scan(&urList)
+		Joins("join sys_role r on ur.role_id = r.id").
+		Where("ur.deleted_at IS NULL AND r.deleted_at IS NULL").
+		Where("ur.expire_at IS NULL OR ur.expire_at > ?", time.Now()).
+		Scan(&urList).Error; err != nil {
+		return fmt.Errorf("load user roles: %w", err)
+	}
 
 	for _, ur := range urList {
 		// 对应 g(sub, role, dom)
-		persist.LoadPolicyLine(fmt.Sprintf("g, %s, %s, %s", ur.UserID, ur.RoleCode, ur.TenantID), m)
+		if err := persist.LoadPolicyLine(fmt.Sprintf("g, %s, %s, %s", ur.UserID, ur.RoleCode, ur.TenantID), m); err != nil {
+			return err
+		}
+	}
+
+	// 2. 加载角色-父角色继承 (g)
+	type RoleInherit struct {
+		RoleCode   string `gorm:"column:role_code"`
+		ParentCode string `gorm:"column:parent_code"`
+		TenantID   string `gorm:"column:tenant_id"`
+	}
+	var riList []RoleInherit
+	if err := db.Table("sys_role_inherit ri").
+		Select("r.code as role_code, pr.code as parent_code, ri.tenant_id").
+		Joins("join sys_role r on ri.role_id = r.id").
+		Joins("join sys_role pr on ri.parent_id = pr.id").
+		Where("r.deleted_at IS NULL AND pr.deleted_at IS NULL").
+		Scan(&riList).Error; err != nil {
+		return fmt.Errorf("load role inherits: %w", err)
 	}
 
-	// 2. 加载角色-权限-范围策略 (p)
+	for _, ri := range riList {
+		// 对应 g(role, parent, dom)
+		if err := persist.LoadPolicyLine(fmt.Sprintf("g, %s, %s, %s", ri.RoleCode, ri.ParentCode, ri.TenantID), m); err != nil {
+			return err
+		}
+	}
+
+	// 3. 加载角色-权限-范围策略 (p)
 	type RolePerm struct {
 		RoleCode  string `gorm:"column:role_code"`
 		TenantID  string `gorm:"column:tenant_id"`
 		PermCode  string `gorm:"column:perm_code"`
 		DataScope string `gorm:"column:data_scope"`
+		Condition string `gorm:"column:condition"`
+		Effect    string `gorm:"column:effect"`
 	}
 	var rpList []RolePerm
-	a.db.Table("sys_role_permission rp").
-		Select("r.code as role_code, rp.tenant_id, p.code as perm_code, rp.data_scope").
-		Joins("left join sys_role r on rp.role_id = r.id").
-		Joins("left join sys_permission p on rp.permission_id = p.id").
-		Scan(&rpList)
+	if err := db.Table("sys_role_permission rp").
+		// condition 为 MySQL 保留字，由 GORM 按方言加引号
+		Select("r.code as role_code, rp.tenant_id, p.code as perm_code, rp.data_scope, ?, rp.effect", clause.Column{Table: "rp", Name: "condition"}).
+		Joins("join sys_role r on rp.role_id = r.id").
+		Joins("join sys_permission p on rp.permission_id = p.id").
+		Where("rp.deleted_at IS NULL AND r.deleted_at IS NULL AND p.deleted_at IS NULL").
+		Scan(&rpList).Error; err != nil {
+		return fmt.Errorf("load role permissions: %w", err)
+	}
 
 	for _, rp := range rpList {
-		// 对应 p(sub, dom, obj, act, scope)
-		persist.LoadPolicyLine(fmt.Sprintf("p, %s, %s, %s, V, %s", rp.RoleCode, rp.TenantID, rp.PermCode, rp.DataScope), m)
+		// 对应 p(sub, dom, obj, act, scope, cond, eft)
+		// 条件表达式可能包含逗号，不能按行解析
+		if err := persist.LoadPolicyArray([]string{
+			"p", rp.RoleCode, rp.TenantID, rp.PermCode, "V", rp.DataScope, toCasbinCondition(rp.Condition), toCasbinEffect(rp.Effect),
+		}, m); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// 以下方法留空，因为权限由业务 Service 管理
+// 以下方法将 Casbin 的增量变更“翻译”回业务表：
+//   - g, 用户ID, 角色编码, 租户ID                        -> sys_user_role
+//   - g, 角色编码, 父角色编码, 租户ID                    -> sys_role_inherit（sub 不是数字时视为角色）
+//   - p, 角色编码, 租户ID, 权限码, V, 数据范围, 条件, 效果 -> sys_role_permission
+// 关联表均为物理删除，与 LoadPolicy 的查询保持一致
+
+// SavePolicy 以内存中的策略全量覆盖业务表
+func (a *SysPermissionAdapter) SavePolicy(m model.Model) error {
+	return a.db.WithContext(a.ctx()).Transaction(func(tx *gorm.DB) error {
+		for _, table := range []interface{}{&dataModel.SysUserRole{}, &dataModel.SysRoleInherit{}, &dataModel.SysRolePermission{}} {
+			if err := tx.Unscoped().Where("1 = 1").Delete(table).Error; err != nil {
+				return err
+			}
+		}
+		for ptype, ast := range m["g"] {
+			for _, rule := range ast.Policy {
+				if err := a.addPolicy(tx, "g", ptype, rule); err != nil {
+					return err
+				}
+			}
+		}
+		for ptype, ast := range m["p"] {
+			for _, rule := range ast.Policy {
+				if err := a.addPolicy(tx, "p", ptype, rule); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (a *SysPermissionAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.addPolicy(a.db.WithContext(a.ctx()), sec, ptype, rule)
+}
+
+func (a *SysPermissionAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	return a.db.WithContext(a.ctx()).Transaction(func(tx *gorm.DB) error {
+		for _, rule := range rules {
+			if err := a.addPolicy(tx, sec, ptype, rule); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
 
-func (a *SysPermissionAdapter) SavePolicy(m model.Model) error                          { return nil }
-func (a *SysPermissionAdapter) AddPolicy(sec string, ptype string, rule []string) error { return nil }
 func (a *SysPermissionAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
-	return nil
+	return a.RemoveFilteredPolicy(sec, ptype, 0, rule...)
 }
+
+func (a *SysPermissionAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return a.db.WithContext(a.ctx()).Transaction(func(tx *gorm.DB) error {
+		for _, rule := range rules {
+			if err := a.removeFilteredPolicy(tx, sec, ptype, 0, rule...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (a *SysPermissionAdapter) RemoveFilteredPolicy(sec string, ptype string, f int, v ...string) error {
-	return nil
+	return a.removeFilteredPolicy(a.db.WithContext(a.ctx()), sec, ptype, f, v...)
+}
+
+// ctx 适配器按规则中的租户显式读写，不经过数据权限插件
+func (a *SysPermissionAdapter) ctx() context.Context {
+	return auth.WithSkipDataScope(context.Background())
+}
+
+func (a *SysPermissionAdapter) addPolicy(tx *gorm.DB, sec, ptype string, rule []string) error {
+	switch {
+	case sec == "g" && ptype == "g" && len(rule) >= 3:
+		tenantID, err := strconv.ParseInt(rule[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tenant id %q: %w", rule[2], err)
+		}
+		parentID, err := a.roleID(tx, tenantID, rule[1])
+		if err != nil {
+			return err
+		}
+		userID, err := strconv.ParseInt(rule[0], 10, 64)
+		if err != nil {
+			// 角色继承
+			roleID, err := a.roleID(tx, tenantID, rule[0])
+			if err != nil {
+				return err
+			}
+			return tx.Create(&dataModel.SysRoleInherit{TenantID: tenantID, RoleID: roleID, ParentID: parentID}).Error
+		}
+		ur := &dataModel.SysUserRole{UserID: userID, RoleID: parentID}
+		ur.TenantID = tenantID
+		return tx.Create(ur).Error
+	case sec == "p" && ptype == "p" && len(rule) >= 7:
+		tenantID, err := strconv.ParseInt(rule[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tenant id %q: %w", rule[1], err)
+		}
+		roleID, err := a.roleID(tx, tenantID, rule[0])
+		if err != nil {
+			return err
+		}
+		permID, err := a.permissionID(tx, rule[2])
+		if err != nil {
+			return err
+		}
+		rp := &dataModel.SysRolePermission{
+			RoleID:       roleID,
+			PermissionID: permID,
+			DataScope:    rule[4],
+			Condition:    fromCasbinCondition(rule[5]),
+			Effect:       rule[6],
+		}
+		rp.TenantID = tenantID
+		return tx.Create(rp).Error
+	}
+	return fmt.Errorf("unsupported policy %s/%s: %v", sec, ptype, rule)
+}
+
+func (a *SysPermissionAdapter) removeFilteredPolicy(tx *gorm.DB, sec, ptype string, f int, v ...string) error {
+	// 字段下标对应的过滤条件，空值表示不限
+	field := func(i int) string {
+		if i < f || i-f >= len(v) {
+			return ""
+		}
+		return v[i-f]
+	}
+
+	switch {
+	case sec == "g" && ptype == "g":
+		sub, role, tenantID := field(0), field(1), field(2)
+		if sub == "" && role == "" && tenantID == "" {
+			return errors.New("remove grouping policy without filter")
+		}
+		if tenantID != "" {
+			if _, err := strconv.ParseInt(tenantID, 10, 64); err != nil {
+				return fmt.Errorf("invalid tenant id %q: %w", tenantID, err)
+			}
+		}
+		// sub 为空时同时清理用户角色与角色继承
+		if sub == "" || isUserSubject(sub) {
+			db := tx.Unscoped().Model(&dataModel.SysUserRole{})
+			if userID, err := strconv.ParseInt(sub, 10, 64); err == nil {
+				db = db.Where("user_id = ?", userID)
+			}
+			if err := a.whereGrouping(db, tx, tenantID, "role_id", role).Delete(&dataModel.SysUserRole{}).Error; err != nil {
+				return err
+			}
+		}
+		if sub == "" || !isUserSubject(sub) {
+			db := tx.Unscoped().Model(&dataModel.SysRoleInherit{})
+			if sub != "" {
+				db = db.Where("role_id IN (?)", a.roleIDs(tx, tenantID, sub))
+			}
+			if err := a.whereGrouping(db, tx, tenantID, "parent_id", role).Delete(&dataModel.SysRoleInherit{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	case sec == "p" && ptype == "p":
+		role, tenantID, perm, scope, cond, eft := field(0), field(1), field(2), field(4), field(5), field(6)
+		db := tx.Unscoped().Model(&dataModel.SysRolePermission{})
+		if role == "" && tenantID == "" && perm == "" {
+			return errors.New("remove policy without filter")
+		}
+		if tenantID != "" {
+			id, err := strconv.ParseInt(tenantID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tenant id %q: %w", tenantID, err)
+			}
+			db = db.Where("tenant_id = ?", id)
+		}
+		if role != "" {
+			db = db.Where("role_id IN (?)", a.roleIDs(tx, tenantID, role))
+		}
+		if perm != "" {
+			db = db.Where("permission_id IN (?)", tx.Session(&gorm.Session{NewDB: true}).
+				Table("sys_permission").Select("id").Where("code = ?", perm))
+		}
+		if scope != "" {
+			db = db.Where("data_scope = ?", scope)
+		}
+		if cond != "" {
+			db = db.Where(clause.Eq{Column: "condition", Value: fromCasbinCondition(cond)})
+		}
+		if eft != "" {
+			db = db.Where("effect = ?", eft)
+		}
+		return db.Delete(&dataModel.SysRolePermission{}).Error
+	}
+	return fmt.Errorf("unsupported policy %s/%s", sec, ptype)
+}
+
+// whereGrouping 追加租户及角色条件，column 为角色所在的列
+func (a *SysPermissionAdapter) whereGrouping(db, tx *gorm.DB, tenantID, column, role string) *gorm.DB {
+	if id, err := strconv.ParseInt(tenantID, 10, 64); err == nil {
+		db = db.Where("tenant_id = ?", id)
+	}
+	if role != "" {
+		db = db.Where(column+" IN (?)", a.roleIDs(tx, tenantID, role))
+	}
+	return db
+}
+
+// isUserSubject g 规则的 sub 为数字时是用户 ID，否则是角色编码
+func isUserSubject(sub string) bool {
+	_, err := strconv.ParseInt(sub, 10, 64)
+	return err == nil
+}
+
+// roleID 按租户与编码查询角色 ID
+func (a *SysPermissionAdapter) roleID(tx *gorm.DB, tenantID int64, code string) (int64, error) {
+	var ids []int64
+	err := tx.Session(&gorm.Session{NewDB: true}).Table("sys_role").
+		Where("tenant_id = ? AND code = ? AND deleted_at IS NULL", tenantID, code).
+		Limit(1).Pluck("id", &ids).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("role %q not found in tenant %d", code, tenantID)
+	}
+	return ids[0], nil
+}
+
+// roleIDs 角色 ID 子查询，tenantID 为空时不限租户（调用方已校验格式）
+func (a *SysPermissionAdapter) roleIDs(tx *gorm.DB, tenantID, code string) *gorm.DB {
+	db := tx.Session(&gorm.Session{NewDB: true}).Table("sys_role").Select("id").Where("code = ?", code)
+	if id, err := strconv.ParseInt(tenantID, 10, 64); err == nil {
+		db = db.Where("tenant_id = ?", id)
+	}
+	return db
+}
+
+// permissionID 按编码查询权限 ID
+func (a *SysPermissionAdapter) permissionID(tx *gorm.DB, code string) (int64, error) {
+	var ids []int64
+	err := tx.Session(&gorm.Session{NewDB: true}).Table("sys_permission").
+		Where("code = ? AND deleted_at IS NULL", code).
+		Limit(1).Pluck("id", &ids).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("permission %q not found", code)
+	}
+	return ids[0], nil
+}
+
+// toCasbinCondition 业务表中空条件对应策略中的 true
+func toCasbinCondition(cond string) string {
+	if cond == "" {
+		return pkgCasbin.NoCondition
+	}
+	return cond
+}
+
+// toCasbinEffect 业务表中空效果视为允许
+func toCasbinEffect(eft string) string {
+	if eft == "" {
+		return pkgCasbin.EffectAllow
+	}
+	return eft
+}
+
+func fromCasbinCondition(cond string) string {
+	if cond == pkgCasbin.NoCondition {
+		return ""
+	}
+	return cond
 }
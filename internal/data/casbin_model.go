@@ -1,32 +1,52 @@
 package data
 
 import (
+	"fmt"
+
 	"github.com/casbin/casbin/v3/model"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
 )
 
-// NewCasbinModel 使用 v3 编程式构建模型
-func NewCasbinModel() (model.Model, error) {
+// NewCasbinModel 配置了模型文件时从文件加载，文件无法读取或解析时返回错误；未配置时使用内置模型
+// 相对路径在加载配置时已转换为相对于配置文件所在目录
+func NewCasbinModel(c *conf.Data, logger log.Logger) (model.Model, error) {
+	l := log.NewHelper(log.With(logger, "module", "casbin"))
+	path := c.GetCasbin().GetModelPath()
+	if path == "" {
+		return newBuiltinCasbinModel(), nil
+	}
+	m, err := model.NewModelFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load casbin model %s: %w", path, err)
+	}
+	l.Infof("load casbin model from %s", path)
+	return m, nil
+}
+
+// newBuiltinCasbinModel 使用 v3 编程式构建模型，与 configs/casbin_model.conf 保持一致
+func newBuiltinCasbinModel() model.Model {
 	m := model.NewModel()
 
-	// 1. 请求定义: sub(用户), dom(租户), obj(权限码), act(动作)
-	m.AddDef("r", "r", "sub, dom, obj, act")
+	// 1. 请求定义: sub(用户), dom(租户), obj(权限码), act(动作), attr(请求属性，见 pkg/casbin.Attributes)
+	m.AddDef("r", "r", "sub, dom, obj, act, attr")
 
-	// 2. 策略定义: sub(角色), dom(租户), obj(权限码), act(动作), scope(数据权限)
-	m.AddDef("p", "p", "sub, dom, obj, act, scope")
+	// 2. 策略定义: sub(角色), dom(租户), obj(权限码), act(动作), scope(数据权限), cond(条件表达式，无条件为 true), eft(allow/deny)
+	m.AddDef("p", "p", "sub, dom, obj, act, scope, cond, eft")
 
-	// 3. 角色定义: sub, role, dom (域 RBAC)
+	// 3. 角色定义: sub, role, dom (域 RBAC)，sub 为用户 ID 或角色编码（角色继承）
 	m.AddDef("g", "g", "_, _, _")
 
-	// 4. 策略效果: 只要有一条匹配成功就通过
-	m.AddDef("e", "e", "some(where (p.eft == allow))")
+	// 4. 策略效果: 至少一条允许，且没有任何拒绝
+	m.AddDef("e", "e", "some(where (p.eft == allow)) && !some(where (p.eft == deny))")
 
-	// 5. 匹配器: 角色匹配、租户匹配、权限码匹配、动作匹配
+	// 5. 匹配器: 角色匹配、租户匹配、权限码匹配、动作匹配、条件满足
 	//  [matchers]
 	//	# 逻辑：
-	//	# 1. 如果用户在 '1' 租户下拥有 'admin' 角色，直接放行 (忽略 r.dom 和 p.dom)
+	//	# 1. 如果用户在 '1' 租户下拥有 'admin' 角色，直接放行 (忽略 r.dom 和 p.dom，且不受拒绝策略影响)
 	//	#    '1' 租户在单租户模式下代表 'default' 租户，在多租户模式下代表 'system' 租户
-	//	# 2. 否则，走正常的租户 RBAC 匹配
-	m.AddDef("m", "m", "g(r.sub, 'admin', '1') || (g(r.sub, p.sub, r.dom) && r.dom == p.dom && r.obj == p.obj && r.act == p.act)")
+	//	# 2. 否则，走正常的租户 RBAC 匹配（含继承的角色），并校验策略条件
+	m.AddDef("m", "m", "(g(r.sub, 'admin', '1') && p.eft != 'deny') || (g(r.sub, p.sub, r.dom) && r.dom == p.dom && r.obj == p.obj && r.act == p.act && eval(p.cond))")
 
-	return m, nil
+	return m
 }
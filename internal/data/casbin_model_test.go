@@ -0,0 +1,36 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+)
+
+func TestNewCasbinModel(t *testing.T) {
+	d := newTestData(t)
+	load := func(path string) error {
+		_, err := NewCasbinModel(&conf.Data{Casbin: &conf.Data_Casbin{ModelPath: path}}, d.logger)
+		return err
+	}
+
+	if err := load(""); err != nil {
+		t.Fatalf("built-in model: %v", err)
+	}
+	if err := load("../../configs/casbin_model.conf"); err != nil {
+		t.Fatalf("configured model: %v", err)
+	}
+	// 配置了模型文件但无法读取或解析时不回退到内置模型
+	dir := t.TempDir()
+	if err := load(filepath.Join(dir, "missing.conf")); err == nil {
+		t.Fatal("missing model file accepted")
+	}
+	broken := filepath.Join(dir, "broken.conf")
+	if err := os.WriteFile(broken, []byte("[request_definition]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := load(broken); err == nil {
+		t.Fatal("broken model file accepted")
+	}
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+)
+
+var (
+	_ biz.ConfigRepo        = (*configRepo)(nil)
+	_ provider.ConfigLoader = (*configRepo)(nil)
+)
+
+type configRepo struct {
+	BaseRepo
+}
+
+func newConfigRepo(data *Data, logger log.Logger) *configRepo {
+	return &configRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+func NewConfigRepo(data *Data, logger log.Logger) biz.ConfigRepo {
+	return newConfigRepo(data, logger)
+}
+
+func NewConfigLoader(data *Data, logger log.Logger) provider.ConfigLoader {
+	return newConfigRepo(data, logger)
+}
+
+func (r *configRepo) LoadAllConfigs(ctx context.Context) ([]provider.ConfigEntry, error) {
+	// 缓存在变更后立即重新加载，读取主库
+	ctx = biz.WithPrimary(ctx)
+	var list []model.SysConfig
+	if err := r.data.DB(ctx).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	result := make([]provider.ConfigEntry, 0, len(list))
+	for _, c := range list {
+		result = append(result, provider.ConfigEntry{
+			TenantID:    c.TenantID,
+			Key:         c.Key,
+			ConfigValue: provider.ConfigValue{Type: c.ValueType, Value: c.Value},
+		})
+	}
+	return result, nil
+}
+
+func (r *configRepo) ListConfigs(ctx context.Context, tenantID int64, keyword string, page, pageSize int) ([]*biz.SysConfig, int64, error) {
+	db := r.data.DB(ctx).Model(&model.SysConfig{}).Where("tenant_id IN ?", visibleTenantIDs(tenantID))
+	if keyword != "" {
+		like := "%" + keyword + "%"
+		db = db.Where("config_key LIKE ? OR name LIKE ?", like, like)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.SysConfig
+	if err := db.Order("config_key ASC, tenant_id ASC").Scopes(r.Paginate(page, pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	result := make([]*biz.SysConfig, 0, len(list))
+	for _, c := range list {
+		result = append(result, toBizConfig(c))
+	}
+	return result, total, nil
+}
+
+func (r *configRepo) GetConfig(ctx context.Context, id int64) (*biz.SysConfig, error) {
+	return r.first(r.data.DB(ctx).Where("id = ?", id))
+}
+
+func (r *configRepo) FindConfig(ctx context.Context, tenantID int64, key string) (*biz.SysConfig, error) {
+	return r.first(r.data.DB(ctx).Where("tenant_id = ? AND config_key = ?", tenantID, key))
+}
+
+func (r *configRepo) first(db *gorm.DB) (*biz.SysConfig, error) {
+	var c model.SysConfig
+	if err := db.First(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrConfigNotFound
+		}
+		return nil, err
+	}
+	return toBizConfig(&c), nil
+}
+
+func (r *configRepo) CountOverrides(ctx context.Context, key string) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysConfig{}).
+		Where("config_key = ? AND tenant_id <> 0", key).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *configRepo) CreateConfig(ctx context.Context, c *biz.SysConfig) error {
+	m := &model.SysConfig{
+		TenantID:  c.TenantID,
+		Key:       c.Key,
+		Name:      c.Name,
+		ValueType: c.ValueType,
+		Value:     c.Value,
+		Remark:    c.Remark,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	c.ID = m.ID
+	c.Version = m.Version
+	return nil
+}
+
+func (r *configRepo) UpdateConfig(ctx context.Context, c *biz.SysConfig) error {
+	m := &model.SysConfig{BaseModel: model.BaseModel{ID: c.ID, Version: c.Version}}
+	err := r.data.DB(ctx).Model(m).Updates(map[string]interface{}{
+		"name":   c.Name,
+		"value":  c.Value,
+		"remark": c.Remark,
+	}).Error
+	if err != nil {
+		return err
+	}
+	c.Version = m.Version
+	return nil
+}
+
+func (r *configRepo) DeleteConfig(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Where("id = ?", id).Delete(&model.SysConfig{}).Error
+}
+
+func (r *configRepo) CreateConfigLog(ctx context.Context, l *biz.ConfigLog) error {
+	return r.data.DB(ctx).Create(&model.SysConfigLog{
+		TenantID:  l.TenantID,
+		ConfigKey: l.Key,
+		Action:    l.Action,
+		OldValue:  l.OldValue,
+		NewValue:  l.NewValue,
+		CreatedBy: l.CreatedBy,
+	}).Error
+}
+
+func (r *configRepo) ListConfigLogs(ctx context.Context, tenantID int64, key string, page, pageSize int) ([]*biz.ConfigLog, int64, error) {
+	db := r.data.DB(ctx).Model(&model.SysConfigLog{}).Where("tenant_id = ?", tenantID)
+	if key != "" {
+		db = db.Where("config_key = ?", key)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.SysConfigLog
+	if err := db.Scopes(r.SortBy("id", false), r.Paginate(page, pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	result := make([]*biz.ConfigLog, 0, len(list))
+	for _, l := range list {
+		result = append(result, &biz.ConfigLog{
+			ID:        l.ID,
+			TenantID:  l.TenantID,
+			Key:       l.ConfigKey,
+			Action:    l.Action,
+			OldValue:  l.OldValue,
+			NewValue:  l.NewValue,
+			CreatedBy: l.CreatedBy,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+	return result, total, nil
+}
+
+func toBizConfig(c *model.SysConfig) *biz.SysConfig {
+	return &biz.SysConfig{
+		ID:        c.ID,
+		TenantID:  c.TenantID,
+		Key:       c.Key,
+		Name:      c.Name,
+		ValueType: c.ValueType,
+		Value:     c.Value,
+		Remark:    c.Remark,
+		UpdatedBy: c.UpdatedBy,
+		UpdatedAt: c.UpdatedAt,
+		Version:   c.Version,
+	}
+}
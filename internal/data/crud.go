@@ -0,0 +1,234 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrUnsupportedCondition ListOptions.Where 中的条件无法转换为 SQL 表达式
+var ErrUnsupportedCondition = errors.New("unsupported query condition")
+
+// 更新时不允许通过字段掩码修改的列，租户及创建信息由 BaseAuthModel 维护，删除信息由回收站维护
+var protectedColumns = []string{"id", "tenant_id", "created_by", "created_at", "deleted_at", deletedByColumn}
+
+// RepoConfig 通用仓库配置
+type RepoConfig[M, B any] struct {
+	ToBiz   func(*M) *B
+	ToModel func(*B) *M // 仅 Create / Update 使用
+	// NotFound 记录不存在（或不在当前租户及数据范围内）时返回的错误，默认 gorm.ErrRecordNotFound
+	NotFound error
+}
+
+// ListOptions 列表查询条件，条件及排序使用 gorm gen 生成的字段
+type ListOptions struct {
+	Where    []field.Expr                 // 如 query.SysNotice.Title.Like("%通知%")
+	Scopes   []func(db *gorm.DB) *gorm.DB // 字段表达式无法描述的条件，如子查询
+	Order    []field.Expr                 // 如 query.SysNotice.ID.Desc()，默认按 id 倒序
+	Page     int
+	PageSize int
+}
+
+// Repo 通用的增删改查仓库，M 为 gorm gen 模型（需嵌入 model.BaseModel 或 model.BaseAuthModel），B 为领域对象
+//
+// 语句均经 Data.DB(ctx) 执行：加入 Context 中的事务，嵌入 BaseAuthModel 的模型由 DataScopePlugin
+// 追加租户隔离与数据范围条件，带版本号更新时由 OptimisticLockPlugin 校验版本
+type Repo[M, B any] struct {
+	BaseRepo
+	conf         RepoConfig[M, B]
+	hasDeletedBy bool
+}
+
+// NewRepo 构造函数，模型无法解析时 Fatal
+func NewRepo[M, B any](data *Data, logger log.Logger, c RepoConfig[M, B]) *Repo[M, B] {
+	if c.NotFound == nil {
+		c.NotFound = gorm.ErrRecordNotFound
+	}
+	stmt := &gorm.Statement{DB: data.db}
+	if err := stmt.Parse(new(M)); err != nil {
+		log.NewHelper(logger).Fatalf("failed parsing model %T: %v", new(M), err)
+	}
+	return &Repo[M, B]{
+		BaseRepo:     NewBaseRepo(data, logger),
+		conf:         c,
+		hasDeletedBy: stmt.Schema.LookUpField(deletedByColumn) != nil,
+	}
+}
+
+func (r *Repo[M, B]) db(ctx context.Context) *gorm.DB {
+	return r.data.DB(ctx).Model(new(M))
+}
+
+// Get 按 ID 查询
+func (r *Repo[M, B]) Get(ctx context.Context, id int64) (*B, error) {
+	var m M
+	if err := r.db(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		return nil, r.notFound(err)
+	}
+	return r.conf.ToBiz(&m), nil
+}
+
+// List 分页查询，返回当前页及总数
+func (r *Repo[M, B]) List(ctx context.Context, opts ListOptions) ([]*B, int64, error) {
+	// 统计与查询分别追加数据范围条件
+	db := r.filter(ctx, opts).Session(&gorm.Session{})
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*M
+	if err := db.Scopes(r.order(opts.Order), r.Paginate(opts.Page, opts.PageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return r.toBizList(list), total, nil
+}
+
+// Count 统计符合条件的记录数，忽略排序及分页
+func (r *Repo[M, B]) Count(ctx context.Context, opts ListOptions) (int64, error) {
+	var total int64
+	err := r.filter(ctx, opts).Count(&total).Error
+	return total, err
+}
+
+// Create 创建，返回写入后的记录（含 ID、版本号及自动填充的租户、创建人等字段）
+func (r *Repo[M, B]) Create(ctx context.Context, b *B) (*B, error) {
+	m := r.conf.ToModel(b)
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return r.conf.ToBiz(m), nil
+}
+
+// Update 按 ID 更新 fields 指定的字段，返回更新后的记录
+// fields 为空时与 GORM 相同只更新非零值字段；领域对象的版本号不为 0 时校验版本
+func (r *Repo[M, B]) Update(ctx context.Context, b *B, fields ...field.Expr) (*B, error) {
+	m := r.conf.ToModel(b)
+	db := r.data.DB(ctx).Model(m)
+	if len(fields) > 0 {
+		columns := make([]string, 0, len(fields))
+		for _, f := range fields {
+			columns = append(columns, string(f.ColumnName()))
+		}
+		db = db.Select(columns)
+	}
+	if err := db.Omit(protectedColumns...).Updates(m).Error; err != nil {
+		return nil, err
+	}
+	// 重新读取完整记录，同时确认记录存在且在当前数据范围内
+	if err := r.data.DB(biz.WithPrimary(ctx)).First(m).Error; err != nil {
+		return nil, r.notFound(err)
+	}
+	return r.conf.ToBiz(m), nil
+}
+
+// Delete 逻辑删除，返回删除的记录数
+func (r *Repo[M, B]) Delete(ctx context.Context, ids ...int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := r.data.DB(ctx).Where("id IN ?", ids).Delete(new(M))
+	return res.RowsAffected, res.Error
+}
+
+// Restore 恢复逻辑删除的记录，返回恢复的记录数
+// 不检查唯一约束冲突及关联数据，需要时使用回收站（biz.RecycleUseCase）
+func (r *Repo[M, B]) Restore(ctx context.Context, ids ...int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	values := map[string]interface{}{"deleted_at": nil}
+	if r.hasDeletedBy {
+		values[deletedByColumn] = 0
+	}
+	res := r.db(ctx).Scopes(r.OnlyTrashed).Where("id IN ?", ids).Updates(values)
+	return res.RowsAffected, res.Error
+}
+
+// filter 追加查询条件，无法转换为 SQL 表达式的条件返回错误，避免静默丢弃后扩大查询范围
+func (r *Repo[M, B]) filter(ctx context.Context, opts ListOptions) *gorm.DB {
+	db := r.db(ctx)
+	if len(opts.Where) > 0 {
+		exprs := make([]clause.Expression, 0, len(opts.Where))
+		for _, w := range opts.Where {
+			if err := w.CondError(); err != nil {
+				_ = db.AddError(err)
+				return db
+			}
+			e, ok := w.BeCond().(clause.Expression)
+			if !ok {
+				_ = db.AddError(fmt.Errorf("%w: %T", ErrUnsupportedCondition, w.BeCond()))
+				return db
+			}
+			exprs = append(exprs, e)
+		}
+		db = db.Clauses(clause.Where{Exprs: exprs})
+	}
+	return db.Scopes(opts.Scopes...)
+}
+
+func (r *Repo[M, B]) order(columns []field.Expr) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(columns) == 0 {
+			return db.Scopes(r.SortBy("id", false))
+		}
+		exprs := make([]clause.Expression, 0, len(columns))
+		for _, c := range columns {
+			exprs = append(exprs, c)
+		}
+		return db.Clauses(clause.OrderBy{Expression: clause.CommaExpression{Exprs: exprs}})
+	}
+}
+
+func (r *Repo[M, B]) notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r.conf.NotFound
+	}
+	return err
+}
+
+func (r *Repo[M, B]) toBizList(list []*M) []*B {
+	result := make([]*B, 0, len(list))
+	for _, m := range list {
+		result = append(result, r.conf.ToBiz(m))
+	}
+	return result
+}
+
+/* 使用示例：新增公告模板模块
+type noticeTplRepo struct {
+	*Repo[model.SysNoticeTpl, biz.NoticeTpl]
+}
+
+func NewNoticeTplRepo(data *Data, logger log.Logger) biz.NoticeTplRepo {
+	return &noticeTplRepo{NewRepo(data, logger, RepoConfig[model.SysNoticeTpl, biz.NoticeTpl]{
+		ToBiz: func(m *model.SysNoticeTpl) *biz.NoticeTpl {
+			return &biz.NoticeTpl{ID: m.ID, Name: m.Name, Content: m.Content, Version: m.Version}
+		},
+		ToModel: func(t *biz.NoticeTpl) *model.SysNoticeTpl {
+			return &model.SysNoticeTpl{BaseAuthModel: model.BaseAuthModel{BaseModel: model.BaseModel{ID: t.ID, Version: t.Version}},
+				Name: t.Name, Content: t.Content}
+		},
+		NotFound: biz.ErrNoticeTplNotFound,
+	})}
+}
+
+func (r *noticeTplRepo) ListNoticeTpls(ctx context.Context, keyword string, page, pageSize int) ([]*biz.NoticeTpl, int64, error) {
+	q := r.data.query.SysNoticeTpl
+	opts := ListOptions{Order: []field.Expr{q.Name}, Page: page, PageSize: pageSize}
+	if keyword != "" {
+		opts.Where = append(opts.Where, q.Name.Like("%"+keyword+"%"))
+	}
+	return r.List(ctx, opts)
+}
+
+func (r *noticeTplRepo) UpdateNoticeTpl(ctx context.Context, t *biz.NoticeTpl) (*biz.NoticeTpl, error) {
+	q := r.data.query.SysNoticeTpl
+	return r.Update(ctx, t, q.Name, q.Content)
+}
+*/
@@ -0,0 +1,130 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gen/field"
+)
+
+// rawCond BeCond 返回的不是 SQL 表达式的条件
+type rawCond struct {
+	field.Expr
+}
+
+func (rawCond) BeCond() interface{} { return "code = 'gender'" }
+
+func TestRepoFilter(t *testing.T) {
+	d := newTestData(t)
+	repo := NewDictRepo(d, d.logger).(*dictRepo)
+	ctx := auth.WithSkipDataScope(context.Background())
+	q := d.query.SysDictType
+
+	for _, code := range []string{"gender", "status"} {
+		if err := repo.CreateDictType(ctx, &biz.DictType{Code: code, Name: code, Enabled: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := ListOptions{Where: []field.Expr{q.Code.Eq("gender")}}
+	if list, total, err := repo.types.List(ctx, opts); err != nil || total != 1 || len(list) != 1 {
+		t.Fatalf("list = %v, %d, %v", list, total, err)
+	}
+
+	// 无法转换的条件返回错误，不能被忽略后查出全部记录
+	opts = ListOptions{Where: []field.Expr{q.Enabled.Is(true), rawCond{q.Code}}}
+	if _, _, err := repo.types.List(ctx, opts); !errors.Is(err, ErrUnsupportedCondition) {
+		t.Fatalf("list with unsupported condition: %v", err)
+	}
+	if _, err := repo.types.Count(ctx, opts); !errors.Is(err, ErrUnsupportedCondition) {
+		t.Fatalf("count with unsupported condition: %v", err)
+	}
+}
+
+func TestDictTypeRepo(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		repo := NewDictRepo(d, d.logger)
+		ctx := tenantContext(1, 1, 0, auth.ScopeAll)
+
+		for _, code := range []string{"status", "gender", "notice_level"} {
+			ty := &biz.DictType{Code: code, Name: code + " name", Enabled: true}
+			if err := repo.CreateDictType(ctx, ty); err != nil {
+				t.Fatal(err)
+			}
+			if ty.ID == 0 || ty.Version == 0 {
+				t.Fatalf("created = %+v", ty)
+			}
+		}
+
+		t.Run("list", func(t *testing.T) {
+			list, total, err := repo.ListDictTypes(ctx, "", 1, 2)
+			if err != nil || total != 3 || len(list) != 2 || list[0].Code != "gender" || list[1].Code != "notice_level" {
+				t.Fatalf("page 1 = %v, %d, %v", list, total, err)
+			}
+			// 关键字匹配编码或名称
+			list, total, err = repo.ListDictTypes(ctx, "stat", 1, 10)
+			if err != nil || total != 1 || list[0].Code != "status" {
+				t.Fatalf("keyword = %v, %d, %v", list, total, err)
+			}
+			if list, total, err = repo.ListDictTypes(ctx, "level name", 1, 10); err != nil || total != 1 {
+				t.Fatalf("keyword in name = %v, %d, %v", list, total, err)
+			}
+		})
+
+		t.Run("exists", func(t *testing.T) {
+			if ok, err := repo.ExistsDictType(ctx, "gender"); err != nil || !ok {
+				t.Fatalf("exists gender = %v, %v", ok, err)
+			}
+			if ok, err := repo.ExistsDictType(ctx, "missing"); err != nil || ok {
+				t.Fatalf("exists missing = %v, %v", ok, err)
+			}
+		})
+
+		t.Run("update", func(t *testing.T) {
+			list, _, err := repo.ListDictTypes(ctx, "gender", 1, 10)
+			if err != nil || len(list) != 1 {
+				t.Fatal(list, err)
+			}
+			ty := list[0]
+			version := ty.Version
+			// 零值同样更新，编码不可修改
+			if err := repo.UpdateDictType(ctx, &biz.DictType{ID: ty.ID, Code: "changed", Name: "性别", Enabled: false, Version: version}); err != nil {
+				t.Fatal(err)
+			}
+			got, err := repo.GetDictType(ctx, ty.ID)
+			if err != nil || got.Code != "gender" || got.Name != "性别" || got.Enabled || got.Version != version+1 {
+				t.Fatalf("updated = %+v, %v", got, err)
+			}
+			// 过期的版本号
+			err = repo.UpdateDictType(ctx, &biz.DictType{ID: ty.ID, Name: "x", Version: version})
+			if !errors.Is(err, biz.ErrVersionConflict) {
+				t.Fatalf("stale version: %v", err)
+			}
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			list, _, err := repo.ListDictTypes(ctx, "status", 1, 10)
+			if err != nil || len(list) != 1 {
+				t.Fatal(list, err)
+			}
+			if err := repo.DeleteDictType(ctx, list[0].ID); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := repo.GetDictType(ctx, list[0].ID); !errors.Is(err, biz.ErrDictTypeNotFound) {
+				t.Fatalf("get deleted: %v", err)
+			}
+			if err := repo.UpdateDictType(ctx, &biz.DictType{ID: list[0].ID, Name: "x"}); !errors.Is(err, biz.ErrDictTypeNotFound) {
+				t.Fatalf("update deleted: %v", err)
+			}
+			// 删除为逻辑删除，记录仍保留在表中
+			var deleted model.SysDictType
+			if err := d.DB(ctx).Unscoped().Where("id = ?", list[0].ID).First(&deleted).Error; err != nil || !deleted.DeletedAt.Valid {
+				t.Fatalf("soft deleted = %+v, %v", deleted, err)
+			}
+		})
+	})
+}
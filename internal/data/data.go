@@ -2,21 +2,28 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"sync"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/casbin/casbin/v3"
 	casbinModel "github.com/casbin/casbin/v3/model"
 	"github.com/casbin/casbin/v3/persist"
+	"github.com/glebarez/sqlite"
 	"github.com/redis/go-redis/v9"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/query"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/audit"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/idgen"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/idgen/snowflake"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -26,6 +33,7 @@ import (
 var ProviderSet = wire.NewSet(
 	NewData,
 	NewDB,
+	NewDataSources,
 	NewRedis,
 	// ID Generator
 	NewIDGenerator,
@@ -39,10 +47,32 @@ var ProviderSet = wire.NewSet(
 	NewSysPermissionAdapter,
 	NewCasbinModel,
 	NewCasbinEnforcer,
+	NewPolicyRepo,
+	NewAuthzWatcher,
+	wire.Bind(new(biz.AuthzNotifier), new(*AuthzWatcher)),
+	// 操作日志
+	NewOperLogWriter,
+	wire.Bind(new(audit.Writer), new(*OperLogWriter)),
 	// 数据存储
 	NewSysUserRepo,
 	NewPermissionRepo,
+	NewPermissionLoader,
 	NewTenantRepo,
+	NewPackageLoader,
+	NewTenantLoader,
+	NewRoleRepo,
+	NewDataScopeLoader,
+	NewOperLogRepo,
+	NewDeptRepo,
+	NewUserJobRepo,
+	NewRecycleRepo,
+	NewDictRepo,
+	NewRedisDictCache,
+	NewConfigRepo,
+	NewConfigLoader,
+	NewNoticeRepo,
+	NewSeedRepo,
+	NewAdminRepo,
 	// Mock
 	NewChatRepo,
 )
@@ -54,6 +84,8 @@ type Data struct {
 	rdb   *redis.Client
 	query *query.Query
 	//oss   biz.OSS
+	logger log.Logger
+	caches sync.Map // 缓存名称 -> 共享的缓存实例，见 sharedCache
 }
 
 // NewData .
@@ -72,78 +104,227 @@ func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, _
 		rdb:   rdb,
 		query: query.Use(db),
 		//oss:   oss,
+		logger: logger,
 	}, cleanup, nil
 }
 
-// NewDB 初始化数据库 (GORM)
+// 表结构维护方式，见 conf.Data.Database.migration
+const (
+	MigrationAuto      = "auto"
+	MigrationVersioned = "versioned"
+	MigrationNone      = "none"
+)
+
+// NewDB 初始化数据库 (GORM)，并按配置维护表结构
 func NewDB(c *conf.Data, l log.Logger) *gorm.DB {
-	var dialector gorm.Dialector
-	switch c.Database.Driver {
+	db := OpenDB(c, l)
+	// 表结构维护读取的元数据及迁移记录需来自主库
+	primary := db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+
+	switch c.Database.Migration {
+	case MigrationAuto, "":
+		if err := autoMigrate(primary); err != nil {
+			log.NewHelper(l).Fatalf("failed auto migrating database: %v", err)
+		}
+	case MigrationVersioned:
+		m, err := NewMigrator(primary, l)
+		if err != nil {
+			log.NewHelper(l).Fatalf("failed loading migrations: %v", err)
+		}
+		if _, err := m.Up(context.Background(), 0); err != nil {
+			log.NewHelper(l).Fatalf("failed migrating database: %v", err)
+		}
+	case MigrationNone:
+	default:
+		log.NewHelper(l).Fatalf("unknown database migration mode: %s", c.Database.Migration)
+	}
+	return db
+}
+
+// OpenDB 连接主数据源并注册插件，不维护表结构
+func OpenDB(c *conf.Data, l log.Logger) *gorm.DB {
+	return openDatabase(DefaultSource, c.Database, l)
+}
+
+// openDialector 按驱动创建方言
+func openDialector(driver, source string) gorm.Dialector {
+	switch driver {
 	case "postgres":
-		dialector = postgres.Open(c.Database.Source)
+		return postgres.Open(source)
 	case "mysql":
-		// 预留 MySQL 适配
-		dialector = mysql.Open(c.Database.Source)
+		// MySQL 8，DSN 需带 parseTime=true 以读取时间字段
+		return mysql.Open(source)
+	case "sqlite":
+		// 纯 Go 实现的 SQLite，仅用于本地开发及测试，source 为 :memory: 时使用内存数据库
+		return sqlite.Open(source)
 	default:
 		// 默认使用 Postgres
-		dialector = postgres.Open(c.Database.Source)
+		return postgres.Open(source)
 	}
+}
 
-	db, err := gorm.Open(dialector, &gorm.Config{
+// openDatabase 连接数据源（含只读副本）并注册插件
+func openDatabase(name string, c *conf.Data_Database, l log.Logger) *gorm.DB {
+	db, err := gorm.Open(openDialector(c.Driver, c.Source), &gorm.Config{
 		// 使用自定义的 Kratos 日志适配器 (前面步骤中定义的 NewGormLogger)
 		Logger: NewGormLogger(l),
 	})
 	if err != nil {
-		log.NewHelper(l).Fatalf("failed opening connection to database: %v", err)
+		log.NewHelper(l).Fatalf("failed opening connection to database %s: %v", name, err)
 	}
 
-	// 初始化完成后调用 AutoMigrate
-	if err := db.AutoMigrate(
-		&model.SysDept{},
-		&model.SysPackage{},
-		&model.SysPackagePermission{},
-		&model.SysPermission{},
-		&model.SysRole{},
-		&model.SysRolePermission{},
-		&model.SysTenant{},
-		&model.SysUser{},
-		&model.SysUserRole{},
-	); err != nil {
-		log.NewHelper(l).Error(err)
+	// 租户隔离与数据权限插件
+	if err := db.Use(DataScopePlugin{}); err != nil {
+		log.NewHelper(l).Fatalf("failed registering data scope plugin: %v", err)
+	}
+	// 操作日志数据变更对比插件
+	if err := db.Use(AuditPlugin{}); err != nil {
+		log.NewHelper(l).Fatalf("failed registering audit plugin: %v", err)
+	}
+	// 逻辑删除记录删除人插件
+	if err := db.Use(SoftDeletePlugin{}); err != nil {
+		log.NewHelper(l).Fatalf("failed registering soft delete plugin: %v", err)
+	}
+	// 乐观锁及更新人插件
+	if err := db.Use(OptimisticLockPlugin{}); err != nil {
+		log.NewHelper(l).Fatalf("failed registering optimistic lock plugin: %v", err)
+	}
+
+	// 读写分离
+	var replicas map[gorm.ConnPool]int
+	if len(c.Replicas) > 0 {
+		if replicas, err = useReplicas(db, name, c); err != nil {
+			log.NewHelper(l).Fatalf("failed opening replicas of database %s: %v", name, err)
+		}
+		log.NewHelper(l).Infof("database %s: reads are routed to %d replica(s)", name, len(c.Replicas))
 	}
 
-	// 配置连接池
+	// 配置连接池，副本与主库使用相同的配置
 	sqlDB, err := db.DB()
 	if err != nil {
 		log.NewHelper(l).Fatalf("failed to get sql DB from gorm: %v", err)
 	}
+	setPool(sqlDB, c)
+	for pool := range replicas {
+		if p, ok := pool.(*sql.DB); ok {
+			setPool(p, c)
+		}
+	}
+
+	// SQLite 同一时间只允许一个写入，内存数据库的每个连接又是独立的数据库，固定使用一个不过期的连接
+	if db.Dialector.Name() == "sqlite" {
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+	}
 
+	return db
+}
+
+// setPool 配置连接池
+func setPool(sqlDB *sql.DB, c *conf.Data_Database) {
 	// 设置最大空闲连接数
-	if c.Database.MaxIdleConns > 0 {
-		sqlDB.SetMaxIdleConns(int(c.Database.MaxIdleConns))
+	if c.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(int(c.MaxIdleConns))
 	} else {
 		sqlDB.SetMaxIdleConns(10) // 默认值
 	}
 
 	// 设置最大打开连接数
-	if c.Database.MaxOpenConns > 0 {
-		sqlDB.SetMaxOpenConns(int(c.Database.MaxOpenConns))
+	if c.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(int(c.MaxOpenConns))
 	} else {
 		sqlDB.SetMaxOpenConns(100) // 默认值
 	}
 
 	// 设置连接最大生命周期
-	if c.Database.ConnMaxLifetime != nil {
-		sqlDB.SetConnMaxLifetime(c.Database.ConnMaxLifetime.AsDuration())
+	if c.ConnMaxLifetime != nil {
+		sqlDB.SetConnMaxLifetime(c.ConnMaxLifetime.AsDuration())
 	} else {
 		sqlDB.SetConnMaxLifetime(time.Hour) // 默认值
 	}
+}
 
-	return db
+// migrateModels 自动迁移维护的模型
+var migrateModels = []interface{}{
+	&model.SysConfig{},
+	&model.SysConfigLog{},
+	&model.SysDept{},
+	&model.SysDictItem{},
+	&model.SysDictType{},
+	&model.SysNotice{},
+	&model.SysNoticeInbox{},
+	&model.SysOperLog{},
+	&model.SysOperLogChain{},
+	&model.SysPackage{},
+	&model.SysPackagePermission{},
+	&model.SysPermission{},
+	&model.SysRole{},
+	&model.SysRolePermission{},
+	&model.SysRoleDept{},
+	&model.SysRoleInherit{},
+	&model.SysTenant{},
+	&model.SysUser{},
+	&model.SysUserJob{},
+	&model.SysUserRole{},
 }
 
-// NewRedis 初始化 Redis 客户端
-func NewRedis(c *conf.Data, l log.Logger) *redis.Client {
+// autoMigrate 按模型同步表结构，仅用于开发环境及 SQLite，生产环境使用版本化迁移
+func autoMigrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(migrateModels...); err != nil {
+		return err
+	}
+	return createSoftUniqueIndexes(db)
+}
+
+// softUniqueIndexes 逻辑删除表的唯一索引，只约束未删除的数据，与版本化迁移中的定义一致
+var softUniqueIndexes = []struct {
+	table   string
+	name    string
+	columns string
+}{
+	{"sys_tenant", "uk_tenant_code", "code"},
+	{"sys_permission", "uk_perm_code", "code"},
+	{"sys_user", "uk_user_tenant_name", "tenant_id, username"},
+	{"sys_role", "uk_role_tenant_code", "tenant_id, code"},
+	{"sys_dict_type", "uk_dict_type_code", "code"},
+	{"sys_dict_item", "uk_dict_item_value", "tenant_id, type_code, value"},
+	{"sys_config", "uk_config_key", "tenant_id, config_key"},
+}
+
+// createSoftUniqueIndexes 创建缺少的逻辑删除唯一索引
+// MySQL 不支持部分索引，以函数索引列 IF(deleted_at IS NULL, 1, NULL) 代替：已删除的行该列为 NULL，不参与唯一约束
+func createSoftUniqueIndexes(db *gorm.DB) error {
+	for _, idx := range softUniqueIndexes {
+		if db.Migrator().HasIndex(idx.table, idx.name) {
+			continue
+		}
+		var ddl string
+		switch db.Dialector.Name() {
+		case "mysql":
+			ddl = fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s, (IF(deleted_at IS NULL, 1, NULL)))", idx.name, idx.table, idx.columns)
+		default:
+			ddl = fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s) WHERE deleted_at IS NULL", idx.name, idx.table, idx.columns)
+		}
+		if err := db.Exec(ddl).Error; err != nil {
+			return fmt.Errorf("create index %s: %w", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// NewRedis 初始化 Redis 客户端，配置 embedded 时连接进程内的 miniredis
+func NewRedis(c *conf.Data, l log.Logger) (*redis.Client, func()) {
+	addr, cleanup := c.Redis.Addr, func() {}
+	if c.Redis.Embedded {
+		mr, err := miniredis.Run()
+		if err != nil {
+			log.NewHelper(l).Fatalf("failed starting embedded redis: %v", err)
+		}
+		log.NewHelper(l).Warnf("using embedded redis at %s, data is not persisted", mr.Addr())
+		addr, cleanup = mr.Addr(), mr.Close
+	}
+
 	var readTimeout, writeTimeout time.Duration
 	if c.Redis.ReadTimeout != nil {
 		readTimeout = c.Redis.ReadTimeout.AsDuration()
@@ -158,7 +339,7 @@ func NewRedis(c *conf.Data, l log.Logger) *redis.Client {
 	}
 
 	rdb := redis.NewClient(&redis.Options{
-		Addr:         c.Redis.Addr,
+		Addr:         addr,
 		Password:     c.Redis.Password,
 		DB:           int(c.Redis.Database),
 		ReadTimeout:  readTimeout,
@@ -175,7 +356,7 @@ func NewRedis(c *conf.Data, l log.Logger) *redis.Client {
 		pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
 		if err := rdb.Ping(pingCtx).Err(); err == nil {
 			cancel()
-			return rdb
+			return rdb, cleanup
 		}
 		cancel()
 		helper.Infof("failed connecting to redis, retrying... (%d/3)", i+1)
@@ -189,28 +370,67 @@ func NewRedis(c *conf.Data, l log.Logger) *redis.Client {
 		helper.Fatalf("failed connecting to redis: %v", err)
 	}
 
-	return rdb
+	return rdb, cleanup
 }
 
 // contextTxKey 事务在 Context 中的 Key
 type contextTxKey struct{}
 
-// InTx 事务包装器实现 (biz.Transaction 接口)
+// InTx 事务包装器实现 (biz.Transaction 接口)，已处于事务中时加入该事务
 func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 将事务对象注入 Context
-		ctx = context.WithValue(ctx, contextTxKey{}, tx)
+	if _, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
 		return fn(ctx)
+	}
+	return d.transaction(ctx, d.db.WithContext(ctx), false, fn)
+}
+
+// InNestedTx 已处于事务中时以保存点执行，否则开启新事务
+func (d *Data) InNestedTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		// 在事务连接上调用 Transaction，GORM 使用 SAVEPOINT / ROLLBACK TO SAVEPOINT
+		return d.transaction(ctx, tx.WithContext(ctx), true, fn)
+	}
+	return d.transaction(ctx, d.db.WithContext(ctx), false, fn)
+}
+
+// transaction 执行事务或保存点，结束后触发 biz.AfterCommit / biz.AfterRollback 注册的回调
+func (d *Data) transaction(ctx context.Context, db *gorm.DB, nested bool, fn func(ctx context.Context) error) (err error) {
+	txCtx, hooks := biz.WithTxHooks(ctx, nested)
+	committed := false
+	defer func() {
+		// fn 出错、提交失败或 panic 时执行回滚回调
+		if !committed {
+			hooks.Rollback(ctx)
+		}
+	}()
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// 将事务对象注入 Context
+		return fn(context.WithValue(txCtx, contextTxKey{}, tx))
 	})
+	if err != nil {
+		return err
+	}
+	committed = true
+	hooks.Commit(ctx)
+	return nil
 }
 
 // getDB 内部私有方法：处理事务判断
 func (d *Data) getDB(ctx context.Context) *gorm.DB {
 	tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB)
 	if ok {
-		return tx
+		// 使用当前 Context，使事务内的 WithSkipDataScope 等标记对插件生效
+		return tx.WithContext(ctx)
 	}
-	return d.db.WithContext(ctx)
+	return withRoute(ctx, d.db.WithContext(ctx))
+}
+
+// withRoute 按 Context 标记强制使用主库，未配置副本时不影响
+func withRoute(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if biz.IsPrimary(ctx) {
+		return db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+	}
+	return db
 }
 
 // DB 返回原始 GORM 实例（支持事务）
@@ -220,12 +440,7 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 
 // Q 返回 GORM Gen 类型安全查询实例（支持事务）
 func (d *Data) Q(ctx context.Context) *query.Query {
-	db := d.db
-	tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB)
-	if ok {
-		db = tx
-	}
-	return query.Use(db.WithContext(ctx))
+	return query.Use(d.getDB(ctx))
 }
 
 // RDB 返回 Redis 客户端
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"fmt"
+	"reflect"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+var ErrCrossTenantWrite = kerrors.Forbidden("CROSS_TENANT_WRITE", "禁止跨租户写入数据")
+
+// DataScopePlugin GORM 插件：对嵌入 BaseAuthModel 的模型，
+// 在查询、更新、删除时自动追加租户隔离与数据范围条件，在创建时校验租户归属。
+//
+// 规则：
+//   - Context 通过 auth.WithSkipDataScope 标记时不做任何处理（系统任务、全量加载）
+//   - 租户 ID 为 0 时拒绝访问任何数据
+//   - 数据范围为空时只做租户隔离（未经权限中间件的接口，如登录）
+//   - 仅对能解析出模型的语句生效，Table/Raw 拼接的 SQL 需手动使用 BaseRepo.DataScope
+type DataScopePlugin struct{}
+
+func (DataScopePlugin) Name() string {
+	return "data_scope"
+}
+
+func (p DataScopePlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	if err := cb.Create().Before("gorm:create").Register("data_scope:create", p.checkCreate); err != nil {
+		return err
+	}
+	if err := cb.Query().Before("gorm:query").Register("data_scope:query", p.filter); err != nil {
+		return err
+	}
+	if err := cb.Row().Before("gorm:row").Register("data_scope:row", p.filter); err != nil {
+		return err
+	}
+	if err := cb.Update().Before("gorm:update").Register("data_scope:update", p.filterWrite); err != nil {
+		return err
+	}
+	return cb.Delete().Before("gorm:delete").Register("data_scope:delete", p.filterWrite)
+}
+
+// filter 查询：追加租户与数据范围条件
+func (p DataScopePlugin) filter(db *gorm.DB) {
+	m, ok := p.scopedModel(db)
+	if !ok {
+		return
+	}
+	deptColumn, selfColumn := m.DataScopeColumns()
+	exprs := dataScopeExprs(auth.GetContextInfo(db.Statement.Context), clause.CurrentTable, deptColumn, selfColumn)
+	db.Statement.AddClause(clause.Where{Exprs: exprs})
+}
+
+// filterWrite 更新/删除：与查询相同，但保留 GORM 对无条件批量写入的保护
+func (p DataScopePlugin) filterWrite(db *gorm.DB) {
+	if _, ok := p.scopedModel(db); !ok {
+		return
+	}
+	if !db.Statement.AllowGlobalUpdate && !hasConditions(db.Statement) {
+		// 交由 GORM 返回 ErrMissingWhereClause
+		return
+	}
+	p.filter(db)
+}
+
+// checkCreate 创建：写入的租户必须与当前租户一致
+func (p DataScopePlugin) checkCreate(db *gorm.DB) {
+	if _, ok := p.scopedModel(db); !ok {
+		return
+	}
+	tenantID := auth.GetTenantID(db.Statement.Context)
+	if tenantID == 0 {
+		// 无租户上下文时由调用方显式指定租户（如注册、初始化）
+		return
+	}
+	field := db.Statement.Schema.LookUpField("tenant_id")
+	if field == nil {
+		return
+	}
+
+	check := func(rv reflect.Value) {
+		v, zero := field.ValueOf(db.Statement.Context, rv)
+		if zero {
+			_ = field.Set(db.Statement.Context, rv, tenantID)
+			return
+		}
+		if id, ok := v.(int64); ok && id != tenantID {
+			_ = db.AddError(ErrCrossTenantWrite)
+		}
+	}
+
+	rv := reflect.Indirect(db.Statement.ReflectValue)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			check(reflect.Indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		check(rv)
+	}
+}
+
+// scopedModel 判断当前语句是否需要过滤
+func (DataScopePlugin) scopedModel(db *gorm.DB) (model.AuthScoped, bool) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil || auth.IsSkipDataScope(stmt.Context) {
+		return nil, false
+	}
+	m, ok := reflect.New(stmt.Schema.ModelType).Interface().(model.AuthScoped)
+	return m, ok
+}
+
+// hasConditions 语句是否带有条件（显式 Where 或模型主键）
+func hasConditions(stmt *gorm.Statement) bool {
+	if _, ok := stmt.Clauses["WHERE"]; ok {
+		return true
+	}
+	if stmt.ReflectValue.IsValid() && len(stmt.Schema.PrimaryFields) > 0 {
+		_, values := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, stmt.Schema.PrimaryFields)
+		return len(values) > 0
+	}
+	return false
+}
+
+// dataScopeExprs 根据权限信息生成过滤条件，table 为空时列名不加表名限定
+func dataScopeExprs(info auth.ContextInfo, table, deptColumn, selfColumn string) []clause.Expression {
+	column := func(name string) clause.Column {
+		return clause.Column{Table: table, Name: name}
+	}
+	deny := clause.Expr{SQL: "1 = 0"}
+
+	// 1. 租户物理隔离
+	if info.TenantID == 0 {
+		return []clause.Expression{deny}
+	}
+	exprs := []clause.Expression{clause.Eq{Column: column("tenant_id"), Value: info.TenantID}}
+
+	// 2. 数据范围
+	switch info.DataScope {
+	case "", auth.ScopeAll:
+	case auth.ScopeDeptSub:
+		exprs = append(exprs, clause.Expr{
+			SQL:  "? IN (SELECT id FROM sys_dept WHERE " + subDeptSQL + ")",
+			Vars: append([]interface{}{column(deptColumn)}, subDeptArgs(info.DeptID)...),
+		})
+	case auth.ScopeDept:
+		exprs = append(exprs, clause.Eq{Column: column(deptColumn), Value: info.DeptID})
+	case auth.ScopeSelf:
+		exprs = append(exprs, clause.Eq{Column: column(selfColumn), Value: info.UserID})
+	case auth.ScopeCustom:
+		var or []clause.Expression
+		if len(info.ScopeDeptIDs) > 0 {
+			or = append(or, clause.IN{Column: column(deptColumn), Values: toValues(info.ScopeDeptIDs)})
+		}
+		if info.ScopeSelf {
+			or = append(or, clause.Eq{Column: column(selfColumn), Value: info.UserID})
+		}
+		switch len(or) {
+		case 0:
+			exprs = append(exprs, deny)
+		case 1:
+			// 单个条件的 clause.Or 会与前一个条件以 OR 拼接，需直接追加
+			exprs = append(exprs, or[0])
+		default:
+			exprs = append(exprs, clause.Or(or...))
+		}
+	default:
+		// 未知范围一律拒绝，防止逻辑漏洞
+		exprs = append(exprs, deny)
+	}
+	return exprs
+}
+
+func toValues(ids []int64) []interface{} {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return values
+}
+
+// subDeptSQL 部门本身及其全部下级部门的条件，配合 subDeptArgs 使用
+// ancestors 形如 0,1,5，下级部门的 ancestors 以 ,<id> 结尾或包含 ,<id>,，只用 LIKE 而不依赖各数据库不同的字符串拼接语法
+const subDeptSQL = "id = ? OR ancestors LIKE ? OR ancestors LIKE ?"
+
+func subDeptArgs(deptID int64) []interface{} {
+	return []interface{}{deptID, fmt.Sprintf("%%,%d", deptID), fmt.Sprintf("%%,%d,%%", deptID)}
+}
@@ -0,0 +1,208 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+// scopeFixture 租户 1：部门 root > child，部门 other；租户 2：部门 foreign
+type scopeFixture struct {
+	root, child, other, foreign int64 // 部门
+	alice, bob, carol, dave     int64 // 用户，分别属于 root、child、other、foreign
+}
+
+func newScopeFixture(t *testing.T, d *Data) *scopeFixture {
+	t.Helper()
+	db := d.DB(auth.WithSkipDataScope(context.Background()))
+	dept := func(tenantID int64, name, ancestors string) int64 {
+		m := &model.SysDept{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}}, Name: name, Ancestors: ancestors}
+		if err := db.Create(m).Error; err != nil {
+			t.Fatalf("create dept %s: %v", name, err)
+		}
+		return m.ID
+	}
+	user := func(tenantID, deptID int64, name string) int64 {
+		m := &model.SysUser{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID, DeptID: deptID}}, Username: name, Name: name}
+		if err := db.Create(m).Error; err != nil {
+			t.Fatalf("create user %s: %v", name, err)
+		}
+		return m.ID
+	}
+
+	f := &scopeFixture{}
+	f.root = dept(1, "root", "0")
+	f.child = dept(1, "child", fmt.Sprintf("0,%d", f.root))
+	f.other = dept(1, "other", "0")
+	f.foreign = dept(2, "foreign", "0")
+	f.alice = user(1, f.root, "alice")
+	f.bob = user(1, f.child, "bob")
+	f.carol = user(1, f.other, "carol")
+	f.dave = user(2, f.foreign, "dave")
+	return f
+}
+
+func userNames(t *testing.T, db *gorm.DB) []string {
+	t.Helper()
+	var names []string
+	if err := db.Model(&model.SysUser{}).Pluck("username", &names).Error; err != nil {
+		t.Fatalf("pluck usernames: %v", err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func assertNames(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDataScopeTenantIsolation(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		f := newScopeFixture(t, d)
+		ctx := tenantContext(1, f.alice, f.root, auth.ScopeAll)
+
+		t.Run("find", func(t *testing.T) {
+			assertNames(t, userNames(t, d.DB(ctx)), "alice", "bob", "carol")
+		})
+
+		t.Run("count", func(t *testing.T) {
+			var count int64
+			if err := d.DB(ctx).Model(&model.SysUser{}).Count(&count).Error; err != nil || count != 3 {
+				t.Fatalf("count = %d, %v; want 3", count, err)
+			}
+		})
+
+		t.Run("first", func(t *testing.T) {
+			var u model.SysUser
+			err := d.DB(ctx).Where("id = ?", f.dave).First(&u).Error
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				t.Fatalf("read other tenant's user: %v", err)
+			}
+		})
+
+		t.Run("update", func(t *testing.T) {
+			res := d.DB(ctx).Model(&model.SysUser{}).Where("id = ?", f.dave).Update("name", "hacked")
+			if res.Error != nil || res.RowsAffected != 0 {
+				t.Fatalf("update other tenant's user: rows %d, %v", res.RowsAffected, res.Error)
+			}
+			assertUserName(t, d, f.dave, "dave")
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			res := d.DB(ctx).Where("id = ?", f.dave).Delete(&model.SysUser{})
+			if res.Error != nil || res.RowsAffected != 0 {
+				t.Fatalf("delete other tenant's user: rows %d, %v", res.RowsAffected, res.Error)
+			}
+			assertUserName(t, d, f.dave, "dave")
+		})
+
+		t.Run("save", func(t *testing.T) {
+			var u model.SysUser
+			if err := d.DB(auth.WithSkipDataScope(ctx)).First(&u, f.dave).Error; err != nil {
+				t.Fatal(err)
+			}
+			u.Name = "hacked"
+			// 带版本号时更新不到记录视为版本冲突
+			if err := d.DB(ctx).Save(&u).Error; !errors.Is(err, biz.ErrVersionConflict) {
+				t.Fatalf("save other tenant's user: %v", err)
+			}
+			// 不带版本号时更新不到记录，Save 转为 upsert，由创建校验拦截
+			u.Version = 0
+			if err := d.DB(ctx).Save(&u).Error; !errors.Is(err, ErrCrossTenantWrite) {
+				t.Fatalf("save other tenant's user without version: %v", err)
+			}
+			assertUserName(t, d, f.dave, "dave")
+		})
+
+		t.Run("create", func(t *testing.T) {
+			foreign := &model.SysUser{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 2}}, Username: "mallory"}
+			if err := d.DB(ctx).Create(foreign).Error; !errors.Is(err, ErrCrossTenantWrite) {
+				t.Fatalf("create in other tenant: %v", err)
+			}
+			own := &model.SysUser{Username: "erin"}
+			if err := d.DB(ctx).Create(own).Error; err != nil {
+				t.Fatal(err)
+			}
+			if own.TenantID != 1 {
+				t.Fatalf("tenant_id = %d, want 1", own.TenantID)
+			}
+		})
+
+		t.Run("no tenant", func(t *testing.T) {
+			assertNames(t, userNames(t, d.DB(context.Background())))
+		})
+
+		t.Run("skip data scope", func(t *testing.T) {
+			assertNames(t, userNames(t, d.DB(auth.WithSkipDataScope(ctx))), "alice", "bob", "carol", "dave", "erin")
+		})
+	})
+}
+
+func assertUserName(t *testing.T, d *Data, id int64, want string) {
+	t.Helper()
+	var u model.SysUser
+	if err := d.DB(auth.WithSkipDataScope(context.Background())).First(&u, id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != want {
+		t.Fatalf("name = %q, want %q", u.Name, want)
+	}
+}
+
+func TestDataScopeRanges(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		f := newScopeFixture(t, d)
+		custom := func(deptIDs []int64, self bool) context.Context {
+			return auth.NewContext(context.Background(), auth.ContextInfo{
+				TenantID: 1, UserID: f.carol, DeptID: f.other, DataScope: auth.ScopeCustom, ScopeDeptIDs: deptIDs, ScopeSelf: self,
+			})
+		}
+
+		tests := []struct {
+			name string
+			ctx  context.Context
+			want []string
+		}{
+			{"dept", tenantContext(1, f.alice, f.root, auth.ScopeDept), []string{"alice"}},
+			{"dept and sub depts", tenantContext(1, f.alice, f.root, auth.ScopeDeptSub), []string{"alice", "bob"}},
+			{"self", tenantContext(1, f.bob, f.child, auth.ScopeSelf), []string{"bob"}},
+			{"custom", custom([]int64{f.child}, false), []string{"bob"}},
+			{"custom with self", custom([]int64{f.child}, true), []string{"bob", "carol"}},
+			{"custom without depts", custom(nil, false), nil},
+			{"unknown scope", tenantContext(1, f.alice, f.root, "UNKNOWN"), nil},
+			{"custom dept of other tenant", custom([]int64{f.foreign}, false), nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assertNames(t, userNames(t, d.DB(tt.ctx)), tt.want...)
+			})
+		}
+	})
+}
+
+func TestBaseRepoDataScope(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		f := newScopeFixture(t, d)
+		r := NewBaseRepo(d, d.logger)
+		ctx := tenantContext(1, f.alice, f.root, auth.ScopeDeptSub)
+
+		// Table 查询无法解析模型，插件不生效，需手动追加
+		var names []string
+		err := d.DB(ctx).Table("sys_user").Scopes(r.DataScope(ctx)).
+			Where("deleted_at IS NULL").Order("username").Pluck("username", &names).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertNames(t, names, "alice", "bob")
+	})
+}
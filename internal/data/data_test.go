@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+// newTestData 使用临时 SQLite 数据库及内嵌 Redis 创建 Data，表结构由 AutoMigrate 创建
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+	c := &conf.Data{
+		Database: &conf.Data_Database{Driver: "sqlite", Source: filepath.Join(t.TempDir(), "test.db")},
+		Redis:    &conf.Data_Redis{Embedded: true},
+	}
+	return openTestData(t, c)
+}
+
+// 设置后仓库测试同时在对应数据库上运行，如 TEST_MYSQL_DSN="root:secret@tcp(127.0.0.1:3306)/bubble_test?parseTime=true"
+// 数据库需为测试专用：表结构由内嵌的版本化迁移创建，每个测试开始前清空全部表
+const (
+	testMySQLDSNEnv    = "TEST_MYSQL_DSN"
+	testPostgresDSNEnv = "TEST_POSTGRES_DSN"
+)
+
+// forEachDatabase 依次在 SQLite 及已配置的 MySQL、PostgreSQL 上运行 fn，外部数据库在运行前清空
+func forEachDatabase(t *testing.T, fn func(t *testing.T, d *Data)) {
+	t.Run("sqlite", func(t *testing.T) {
+		fn(t, newTestData(t))
+	})
+	for _, db := range []struct{ driver, env string }{
+		{"mysql", testMySQLDSNEnv},
+		{"postgres", testPostgresDSNEnv},
+	} {
+		t.Run(db.driver, func(t *testing.T) {
+			dsn := os.Getenv(db.env)
+			if dsn == "" {
+				t.Skipf("%s not set", db.env)
+			}
+			d := openTestData(t, &conf.Data{
+				Database: &conf.Data_Database{Driver: db.driver, Source: dsn, Migration: MigrationVersioned},
+				Redis:    &conf.Data_Redis{Embedded: true},
+			})
+			truncateTables(t, d.db)
+			fn(t, d)
+		})
+	}
+}
+
+// truncateTables 清空模型对应的全部表，迁移记录保留
+func truncateTables(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	for _, m := range migrateModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Exec("DELETE FROM " + stmt.Quote(stmt.Schema.Table)).Error; err != nil {
+			t.Fatalf("clear %s: %v", stmt.Schema.Table, err)
+		}
+	}
+}
+
+func openTestData(t *testing.T, c *conf.Data) *Data {
+	t.Helper()
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelWarn))
+	// 与应用相同使用雪花 ID，部分表的主键不是自增列
+	NewIDGenerator(&conf.App{WorkerId: 1})
+	db := NewDB(c, logger)
+	rdb, closeRedis := NewRedis(c, logger)
+	d, cleanup, err := NewData(c, logger, db, rdb, nil)
+	if err != nil {
+		t.Fatalf("NewData: %v", err)
+	}
+	t.Cleanup(func() {
+		cleanup()
+		closeRedis()
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return d
+}
+
+// tenantContext 已登录用户的 Context，数据范围为空时只做租户隔离
+func tenantContext(tenantID, userID, deptID int64, scope string) context.Context {
+	return auth.NewContext(context.Background(), auth.ContextInfo{
+		TenantID:  tenantID,
+		UserID:    userID,
+		DeptID:    deptID,
+		DataScope: scope,
+	})
+}
+
+// newTestWatcher 使用内置 Casbin 模型创建 Enforcer 及权限广播
+func newTestWatcher(t *testing.T, d *Data) (*casbin.SyncedEnforcer, *AuthzWatcher) {
+	t.Helper()
+	m, err := NewCasbinModel(&conf.Data{}, d.logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewCasbinEnforcer(m, NewSysPermissionAdapter(d.db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, cleanup, err := NewAuthzWatcher(d, e, d.logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return e, w
+}
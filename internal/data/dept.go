@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+var _ biz.DeptRepo = (*deptRepo)(nil)
+
+type deptRepo struct {
+	BaseRepo
+	data *Data
+	log  *log.Helper
+}
+
+func NewDeptRepo(data *Data, logger log.Logger) biz.DeptRepo {
+	return &deptRepo{
+		BaseRepo: NewBaseRepo(data, logger),
+		data:     data,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (r *deptRepo) ListDepts(ctx context.Context) ([]*biz.SysDept, error) {
+	var list []*model.SysDept
+	err := r.data.DB(auth.WithSkipDataScope(ctx)).
+		Scopes(r.TenantScope(ctx), r.SortBy("sort", true)).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	depts := make([]*biz.SysDept, 0, len(list))
+	for _, d := range list {
+		depts = append(depts, &biz.SysDept{ID: d.ID, ParentID: d.ParentID, Name: d.Name})
+	}
+	return depts, nil
+}
+
+func (r *deptRepo) ListScopedDeptIDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	err := r.data.DB(ctx).Model(&model.SysDept{}).Pluck("id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,308 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+)
+
+var (
+	_ biz.DictRepo  = (*dictRepo)(nil)
+	_ biz.DictCache = (*redisDictCache)(nil)
+)
+
+type dictRepo struct {
+	BaseRepo
+	types *Repo[model.SysDictType, biz.DictType]
+}
+
+func NewDictRepo(data *Data, logger log.Logger) biz.DictRepo {
+	return &dictRepo{
+		BaseRepo: NewBaseRepo(data, logger),
+		types: NewRepo(data, logger, RepoConfig[model.SysDictType, biz.DictType]{
+			ToBiz:    toBizDictType,
+			ToModel:  toModelDictType,
+			NotFound: biz.ErrDictTypeNotFound,
+		}),
+	}
+}
+
+func (r *dictRepo) ListDictTypes(ctx context.Context, keyword string, page, pageSize int) ([]*biz.DictType, int64, error) {
+	q := r.data.query.SysDictType
+	opts := ListOptions{Order: []field.Expr{q.Code}, Page: page, PageSize: pageSize}
+	if keyword != "" {
+		like := "%" + keyword + "%"
+		opts.Where = append(opts.Where, field.Or(q.Code.Like(like), q.Name.Like(like)))
+	}
+	return r.types.List(ctx, opts)
+}
+
+func (r *dictRepo) GetDictType(ctx context.Context, id int64) (*biz.DictType, error) {
+	return r.types.Get(ctx, id)
+}
+
+func (r *dictRepo) ExistsDictType(ctx context.Context, code string) (bool, error) {
+	q := r.data.query.SysDictType
+	count, err := r.types.Count(ctx, ListOptions{Where: []field.Expr{q.Code.Eq(code)}})
+	return count > 0, err
+}
+
+func (r *dictRepo) CreateDictType(ctx context.Context, t *biz.DictType) error {
+	created, err := r.types.Create(ctx, t)
+	if err != nil {
+		return err
+	}
+	t.ID = created.ID
+	t.Version = created.Version
+	t.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+// UpdateDictType 编码不可修改，只更新名称、备注及启用状态
+func (r *dictRepo) UpdateDictType(ctx context.Context, t *biz.DictType) error {
+	q := r.data.query.SysDictType
+	updated, err := r.types.Update(ctx, t, q.Name, q.Remark, q.Enabled)
+	if err != nil {
+		return err
+	}
+	t.Version = updated.Version
+	t.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *dictRepo) DeleteDictType(ctx context.Context, id int64) error {
+	_, err := r.types.Delete(ctx, id)
+	return err
+}
+
+func (r *dictRepo) CountDictItems(ctx context.Context, typeCode string) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysDictItem{}).Where("type_code = ?", typeCode).Count(&count).Error
+	return count, err
+}
+
+func (r *dictRepo) ListDictItems(ctx context.Context, tenantID int64, typeCode string) ([]*biz.DictItem, error) {
+	var list []*model.SysDictItem
+	err := r.data.DB(ctx).
+		Where("type_code = ? AND tenant_id IN ?", typeCode, visibleTenantIDs(tenantID)).
+		Order("sort ASC, id ASC").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return toBizDictItems(list), nil
+}
+
+func (r *dictRepo) ListEnabledDictItems(ctx context.Context, tenantID int64, typeCodes []string) ([]*biz.DictItem, error) {
+	var list []*model.SysDictItem
+	err := r.data.DB(ctx).
+		Where("type_code IN (?)", r.data.DB(ctx).Model(&model.SysDictType{}).
+			Select("code").Where("code IN ? AND enabled = ?", typeCodes, true)).
+		Where("tenant_id IN ?", visibleTenantIDs(tenantID)).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return toBizDictItems(list), nil
+}
+
+func (r *dictRepo) GetDictItem(ctx context.Context, id int64) (*biz.DictItem, error) {
+	var item model.SysDictItem
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrDictItemNotFound
+		}
+		return nil, err
+	}
+	return toBizDictItem(&item), nil
+}
+
+func (r *dictRepo) ExistsDictItem(ctx context.Context, tenantID int64, typeCode, value string, excludeID int64) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysDictItem{}).
+		Where("tenant_id = ? AND type_code = ? AND value = ? AND id <> ?", tenantID, typeCode, value, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *dictRepo) CreateDictItem(ctx context.Context, item *biz.DictItem) error {
+	m := &model.SysDictItem{
+		TenantID: item.TenantID,
+		TypeCode: item.TypeCode,
+		Label:    item.Label,
+		Value:    item.Value,
+		Sort:     item.Sort,
+		Color:    item.Color,
+		Enabled:  item.Enabled,
+		Remark:   item.Remark,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	item.ID = m.ID
+	item.Version = m.Version
+	return nil
+}
+
+func (r *dictRepo) UpdateDictItem(ctx context.Context, item *biz.DictItem) error {
+	m := &model.SysDictItem{BaseModel: model.BaseModel{ID: item.ID, Version: item.Version}}
+	err := r.data.DB(ctx).Model(m).Updates(map[string]interface{}{
+		"label":   item.Label,
+		"value":   item.Value,
+		"sort":    item.Sort,
+		"color":   item.Color,
+		"enabled": item.Enabled,
+		"remark":  item.Remark,
+	}).Error
+	if err != nil {
+		return err
+	}
+	item.Version = m.Version
+	return nil
+}
+
+func (r *dictRepo) DeleteDictItem(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Where("id = ?", id).Delete(&model.SysDictItem{}).Error
+}
+
+// visibleTenantIDs 租户可见的字典项、系统参数所属租户：全局及本租户
+func visibleTenantIDs(tenantID int64) []int64 {
+	if tenantID == 0 {
+		return []int64{0}
+	}
+	return []int64{0, tenantID}
+}
+
+func toBizDictType(t *model.SysDictType) *biz.DictType {
+	return &biz.DictType{
+		ID:        t.ID,
+		Code:      t.Code,
+		Name:      t.Name,
+		Remark:    t.Remark,
+		Enabled:   t.Enabled,
+		Version:   t.Version,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func toModelDictType(t *biz.DictType) *model.SysDictType {
+	return &model.SysDictType{
+		BaseModel: model.BaseModel{ID: t.ID, Version: t.Version},
+		Code:      t.Code,
+		Name:      t.Name,
+		Remark:    t.Remark,
+		Enabled:   t.Enabled,
+	}
+}
+
+func toBizDictItem(item *model.SysDictItem) *biz.DictItem {
+	return &biz.DictItem{
+		ID:       item.ID,
+		TenantID: item.TenantID,
+		TypeCode: item.TypeCode,
+		Label:    item.Label,
+		Value:    item.Value,
+		Sort:     item.Sort,
+		Color:    item.Color,
+		Enabled:  item.Enabled,
+		Remark:   item.Remark,
+		Version:  item.Version,
+	}
+}
+
+func toBizDictItems(list []*model.SysDictItem) []*biz.DictItem {
+	result := make([]*biz.DictItem, 0, len(list))
+	for _, item := range list {
+		result = append(result, toBizDictItem(item))
+	}
+	return result
+}
+
+const (
+	dictCacheKeyPrefix = "dict:"
+	dictCacheTTL       = 24 * time.Hour
+)
+
+// dictCacheName 指标 cache.lookups 中的缓存名称
+const dictCacheName = "dict"
+
+// redisDictCache 每个字典类型一个 Hash：key 为 dict:<类型编码>，field 为租户 ID，value 为该租户生效字典项的 JSON
+// 字典变更时删除整个 Hash，所有租户同时失效
+type redisDictCache struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRedisDictCache(data *Data) biz.DictCache {
+	return &redisDictCache{
+		data: data,
+		log:  log.NewHelper(log.With(data.logger, "module", "data/cache", "cache", dictCacheName)),
+	}
+}
+
+func (c *redisDictCache) GetDicts(ctx context.Context, tenantID int64, typeCodes []string) (map[string][]*biz.DictItem, error) {
+	field := strconv.FormatInt(tenantID, 10)
+	pipe := c.data.RDB().Pipeline()
+	for _, code := range typeCodes {
+		pipe.HGet(ctx, dictCacheKeyPrefix+code, field)
+	}
+	cmds, err := pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	dicts := make(map[string][]*biz.DictItem, len(typeCodes))
+	for i, cmd := range cmds {
+		raw, err := cmd.(*redis.StringCmd).Bytes()
+		if err != nil {
+			countLookup(ctx, dictCacheName, cacheMiss)
+			continue
+		}
+		var items []*biz.DictItem
+		if err := json.Unmarshal(raw, &items); err != nil {
+			countLookup(ctx, dictCacheName, cacheMiss)
+			continue
+		}
+		countLookup(ctx, dictCacheName, cacheHit)
+		dicts[typeCodes[i]] = items
+	}
+	return dicts, nil
+}
+
+func (c *redisDictCache) SetDicts(ctx context.Context, tenantID int64, dicts map[string][]*biz.DictItem) error {
+	field := strconv.FormatInt(tenantID, 10)
+	pipe := c.data.RDB().Pipeline()
+	for code, items := range dicts {
+		// 空列表同样缓存，避免不存在的类型反复回源
+		raw, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		key := dictCacheKeyPrefix + code
+		pipe.HSet(ctx, key, field, raw)
+		pipe.Expire(ctx, key, dictCacheTTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DeleteDicts ctx 处于事务中时在提交后删除，此时删除失败只记录日志
+func (c *redisDictCache) DeleteDicts(ctx context.Context, typeCode string) error {
+	var err error
+	biz.AfterCommit(ctx, func(ctx context.Context) {
+		err = c.data.RDB().Del(context.WithoutCancel(ctx), dictCacheKeyPrefix+typeCode).Err()
+		if err != nil {
+			c.log.WithContext(ctx).Errorf("delete %s failed: %v", typeCode, err)
+		}
+	})
+	return err
+}
@@ -41,21 +41,21 @@ func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (stri
 	// 使用 WithContext 确保 TraceID 能被提取并打印
 	logger := log.WithContext(ctx, l.logger)
 
+	kvs := []interface{}{
+		"kind", "sql",
+		"elapsed", float64(elapsed.Nanoseconds()) / 1e6,
+		"rows", rows,
+		"sql", sql,
+	}
+	// 配置了只读副本时记录语句使用的连接
+	if route, ok := routeFromContext(ctx); ok {
+		kvs = append(kvs, "db", route.String())
+	}
+
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Log(log.LevelError,
-			"kind", "sql",
-			"elapsed", float64(elapsed.Nanoseconds())/1e6,
-			"rows", rows,
-			"sql", sql,
-			"err", err,
-		)
+		logger.Log(log.LevelError, append(kvs, "err", err)...)
 		return
 	}
 
-	logger.Log(log.LevelDebug,
-		"kind", "sql",
-		"elapsed", float64(elapsed.Nanoseconds())/1e6,
-		"rows", rows,
-		"sql", sql,
-	)
+	logger.Log(log.LevelDebug, kvs...)
 }
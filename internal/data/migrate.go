@@ -0,0 +1,28 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/migrations"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/migrate"
+	"gorm.io/gorm"
+)
+
+// NewMigrator 按数据库类型加载内嵌的迁移脚本
+func NewMigrator(db *gorm.DB, logger log.Logger) (*migrate.Migrator, error) {
+	driver := db.Dialector.Name()
+	dialect := migrate.DialectOf(driver)
+	if dialect == nil {
+		return nil, fmt.Errorf("migrate: unsupported database driver %s", driver)
+	}
+	list, err := migrate.Load(migrations.FS, driver)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	return migrate.New(sqlDB, dialect, list, logger), nil
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/migrations"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/migrate"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// TestMigrationVersions 各数据库的迁移脚本版本一致且均有回滚脚本
+func TestMigrationVersions(t *testing.T) {
+	var versions []int64
+	for _, driver := range []string{"postgres", "mysql"} {
+		list, err := migrate.Load(migrations.FS, driver)
+		if err != nil {
+			t.Fatalf("load %s: %v", driver, err)
+		}
+		var got []int64
+		for _, m := range list {
+			if m.Down == "" {
+				t.Errorf("%s %d has no down script", driver, m.Version)
+			}
+			got = append(got, m.Version)
+		}
+		if versions != nil && !reflect.DeepEqual(got, versions) {
+			t.Errorf("%s versions = %v, want %v", driver, got, versions)
+		}
+		versions = got
+	}
+}
+
+// TestVersionedSchema 版本化迁移创建的表结构覆盖模型：列、可空列及索引均存在
+func TestVersionedSchema(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		if d.db.Dialector.Name() == "sqlite" {
+			t.Skip("sqlite only supports auto migration")
+		}
+		m := d.db.Migrator()
+		for _, model := range migrateModels {
+			stmt := &gorm.Statement{DB: d.db}
+			if err := stmt.Parse(model); err != nil {
+				t.Fatal(err)
+			}
+			table := stmt.Schema.Table
+			types, err := m.ColumnTypes(model)
+			if err != nil {
+				t.Fatalf("columns of %s: %v", table, err)
+			}
+			columns := make(map[string]gorm.ColumnType, len(types))
+			for _, ct := range types {
+				columns[ct.Name()] = ct
+			}
+			for _, f := range stmt.Schema.Fields {
+				if f.DBName == "" {
+					continue
+				}
+				ct, ok := columns[f.DBName]
+				if !ok {
+					t.Errorf("column %s.%s missing", table, f.DBName)
+					continue
+				}
+				if nullable, ok := ct.Nullable(); ok && !nullable && nullableField(f) {
+					t.Errorf("column %s.%s is NOT NULL, model writes NULL", table, f.DBName)
+				}
+			}
+			for _, idx := range stmt.Schema.ParseIndexes() {
+				if !m.HasIndex(model, idx.Name) {
+					t.Errorf("index %s on %s missing", idx.Name, table)
+				}
+			}
+		}
+	})
+}
+
+// nullableField 模型会写入 NULL 的字段：指针及逻辑删除时间
+func nullableField(f *schema.Field) bool {
+	return f.FieldType.Kind() == reflect.Ptr || f.FieldType == reflect.TypeOf(gorm.DeletedAt{})
+}
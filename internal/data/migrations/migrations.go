@@ -0,0 +1,9 @@
+// Package migrations 内嵌的版本化迁移脚本，每种数据库一个目录，版本号与文件名保持一致
+//
+// 新增迁移时在 postgres 与 mysql 目录下同时添加同版本号的 up/down 脚本，已发布的脚本不允许修改
+package migrations
+
+import "embed"
+
+//go:embed postgres/*.sql mysql/*.sql
+var FS embed.FS
@@ -0,0 +1,19 @@
+DROP TABLE IF EXISTS sys_notice_inbox;
+DROP TABLE IF EXISTS sys_notice;
+DROP TABLE IF EXISTS sys_config_log;
+DROP TABLE IF EXISTS sys_config;
+DROP TABLE IF EXISTS sys_dict_item;
+DROP TABLE IF EXISTS sys_dict_type;
+DROP TABLE IF EXISTS sys_user_job;
+DROP TABLE IF EXISTS sys_oper_log;
+DROP TABLE IF EXISTS sys_role_inherit;
+DROP TABLE IF EXISTS sys_role_dept;
+DROP TABLE IF EXISTS sys_role_permission;
+DROP TABLE IF EXISTS sys_user_role;
+DROP TABLE IF EXISTS sys_role;
+DROP TABLE IF EXISTS sys_user;
+DROP TABLE IF EXISTS sys_dept;
+DROP TABLE IF EXISTS sys_package_permission;
+DROP TABLE IF EXISTS sys_permission;
+DROP TABLE IF EXISTS sys_tenant;
+DROP TABLE IF EXISTS sys_package;
@@ -0,0 +1 @@
+ALTER TABLE sys_role_permission MODIFY COLUMN `condition` VARCHAR(512) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- 与 PostgreSQL 一致补充条件列的说明，条件中的 r.attr.Owner 已移除
+ALTER TABLE sys_role_permission MODIFY COLUMN `condition` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '生效条件: Casbin 表达式，可引用 r.attr.IP / Clock / Weekday / UserID，空为无条件';
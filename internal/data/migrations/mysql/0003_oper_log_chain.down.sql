@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS sys_oper_log_chain;
+ALTER TABLE sys_oper_log DROP COLUMN seq;
+ALTER TABLE sys_oper_log COMMENT = '操作日志(只追加)：hash = sha256(prev_hash + 日志内容)，同一节点的日志构成哈希链，修改或删除中间记录可被校验发现';
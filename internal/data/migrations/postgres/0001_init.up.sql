@@ -0,0 +1,397 @@
+-- =========================================================
+-- 初始表结构 (PostgreSQL 15)
+-- 与 internal/data/model 中的模型保持一致，索引名称与 GORM AutoMigrate 生成的名称相同
+-- 逻辑删除的表使用部分唯一索引 (WHERE deleted_at IS NULL)
+-- =========================================================
+
+-- 1. 租户套餐表
+CREATE TABLE sys_package (
+    id BIGINT PRIMARY KEY,
+    name VARCHAR(128) NOT NULL,
+    status SMALLINT DEFAULT 1,      -- 状态 (1:正常, 2:禁用)
+    remark VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_package_deleted_at ON sys_package(deleted_at);
+COMMENT ON TABLE sys_package IS '租户套餐表';
+
+-- 2. 租户表
+CREATE TABLE sys_tenant (
+    id BIGINT PRIMARY KEY,
+    created_by BIGINT,
+    code VARCHAR(64) NOT NULL,      -- 租户编码
+    name VARCHAR(128) NOT NULL,
+    package_id BIGINT,
+    expire_time TIMESTAMP WITH TIME ZONE,
+    status SMALLINT DEFAULT 1,      -- 状态 (1:正常, 2:禁用)
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_tenant_deleted_at ON sys_tenant(deleted_at);
+CREATE INDEX idx_sys_tenant_created_by ON sys_tenant(created_by);
+CREATE UNIQUE INDEX uk_tenant_code ON sys_tenant(code) WHERE deleted_at IS NULL;
+COMMENT ON TABLE sys_tenant IS '租户表';
+
+-- 3. 权限/菜单表
+CREATE TABLE sys_permission (
+    id BIGINT PRIMARY KEY,
+    parent_id BIGINT DEFAULT 0,
+    name VARCHAR(64) NOT NULL,
+    code VARCHAR(64) NOT NULL,      -- 权限码 (如: user:list)
+    type VARCHAR(20) NOT NULL,      -- MENU, BUTTON, API
+    api_path VARCHAR(255),          -- Kratos Operation (如: /api.user.v1.User/ListUsers)
+    api_method VARCHAR(20) DEFAULT 'V',
+    sort INT DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by BIGINT DEFAULT 0
+);
+CREATE INDEX idx_sys_permission_deleted_at ON sys_permission(deleted_at);
+CREATE UNIQUE INDEX uk_perm_code ON sys_permission(code) WHERE deleted_at IS NULL;
+COMMENT ON COLUMN sys_permission.api_path IS 'Kratos内部路径/API路径';
+
+-- 4. 套餐权限关联表
+CREATE TABLE sys_package_permission (
+    id BIGINT PRIMARY KEY,
+    package_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE sys_package_permission IS '套餐与权限码关联表(定义套餐功能边界)';
+
+-- 5. 部门表
+CREATE TABLE sys_dept (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,
+    dept_id BIGINT,
+    parent_id BIGINT DEFAULT 0,
+    name VARCHAR(128) NOT NULL,
+    ancestors VARCHAR(512),         -- 祖先路径 (0,1,2)
+    sort INT DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by BIGINT DEFAULT 0
+);
+CREATE INDEX idx_sys_dept_deleted_at ON sys_dept(deleted_at);
+CREATE INDEX idx_sys_dept_tenant_id ON sys_dept(tenant_id);
+CREATE INDEX idx_sys_dept_created_by ON sys_dept(created_by);
+CREATE INDEX idx_sys_dept_dept_id ON sys_dept(dept_id);
+
+-- 6. 用户表
+CREATE TABLE sys_user (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,
+    dept_id BIGINT,
+    username VARCHAR(64) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    name VARCHAR(64),
+    mobile VARCHAR(20),
+    email VARCHAR(128),
+    avatar VARCHAR(255),
+    status SMALLINT DEFAULT 1,
+    login_failed_count INT DEFAULT 0,
+    last_login_failed_at TIMESTAMP WITH TIME ZONE,
+    notify_channels VARCHAR(32),      -- 离线通知渠道 (email/sms，逗号分隔)
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by BIGINT DEFAULT 0
+);
+CREATE INDEX idx_sys_user_deleted_at ON sys_user(deleted_at);
+CREATE INDEX idx_sys_user_tenant_id ON sys_user(tenant_id);
+CREATE INDEX idx_sys_user_created_by ON sys_user(created_by);
+CREATE INDEX idx_sys_user_dept_id ON sys_user(dept_id);
+CREATE UNIQUE INDEX uk_user_tenant_name ON sys_user(tenant_id, username) WHERE deleted_at IS NULL;
+
+-- 7. 角色表
+CREATE TABLE sys_role (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,
+    dept_id BIGINT,
+    name VARCHAR(64) NOT NULL,
+    code VARCHAR(64) NOT NULL,      -- 角色标识 (如: admin)
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by BIGINT DEFAULT 0
+);
+CREATE INDEX idx_sys_role_deleted_at ON sys_role(deleted_at);
+CREATE INDEX idx_sys_role_tenant_id ON sys_role(tenant_id);
+CREATE INDEX idx_sys_role_created_by ON sys_role(created_by);
+CREATE INDEX idx_sys_role_dept_id ON sys_role(dept_id);
+CREATE UNIQUE INDEX uk_role_tenant_code ON sys_role(tenant_id, code) WHERE deleted_at IS NULL;
+
+-- 8. 用户角色关联表
+CREATE TABLE sys_user_role (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,
+    dept_id BIGINT,
+    user_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    expire_at TIMESTAMP WITH TIME ZONE, -- 为空表示永久
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_user_role_deleted_at ON sys_user_role(deleted_at);
+CREATE INDEX idx_sys_user_role_tenant_id ON sys_user_role(tenant_id);
+CREATE INDEX idx_sys_user_role_created_by ON sys_user_role(created_by);
+CREATE INDEX idx_sys_user_role_dept_id ON sys_user_role(dept_id);
+COMMENT ON COLUMN sys_user_role.expire_at IS '到期时间，为空表示永久，到期后由定时任务回收';
+
+-- 9. 角色权限关联表
+CREATE TABLE sys_role_permission (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,
+    dept_id BIGINT,
+    role_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    data_scope VARCHAR(20) DEFAULT 'SELF', -- SELF, DEPT, DEPT_SUB, CUSTOM, ALL
+    condition VARCHAR(512) NOT NULL DEFAULT '',
+    effect VARCHAR(10) NOT NULL DEFAULT 'allow', -- allow, deny
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_role_permission_deleted_at ON sys_role_permission(deleted_at);
+CREATE INDEX idx_sys_role_permission_tenant_id ON sys_role_permission(tenant_id);
+CREATE INDEX idx_sys_role_permission_created_by ON sys_role_permission(created_by);
+CREATE INDEX idx_sys_role_permission_dept_id ON sys_role_permission(dept_id);
+COMMENT ON COLUMN sys_role_permission.data_scope IS '数据范围: SELF(个人), DEPT(本部门), DEPT_SUB(本部门及下级), CUSTOM(自定义部门), ALL(全租户)';
+COMMENT ON COLUMN sys_role_permission.condition IS '生效条件: Casbin 表达式，可引用 r.attr.IP / Clock / Weekday / UserID / Owner，空为无条件';
+COMMENT ON COLUMN sys_role_permission.effect IS '效果: allow(允许), deny(拒绝，优先于任何允许)';
+
+-- 10. 角色自定义数据范围表
+CREATE TABLE sys_role_dept (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    dept_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_sys_role_dept_tenant_id ON sys_role_dept(tenant_id);
+CREATE INDEX idx_sys_role_dept_role_id ON sys_role_dept(role_id);
+CREATE UNIQUE INDEX uk_role_dept ON sys_role_dept(role_id, dept_id);
+COMMENT ON TABLE sys_role_dept IS '角色自定义数据范围(data_scope 为 CUSTOM 时生效)';
+
+-- 11. 角色继承表
+CREATE TABLE sys_role_inherit (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    parent_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_sys_role_inherit_tenant_id ON sys_role_inherit(tenant_id);
+CREATE INDEX idx_sys_role_inherit_role_id ON sys_role_inherit(role_id);
+CREATE UNIQUE INDEX uk_role_inherit ON sys_role_inherit(role_id, parent_id);
+COMMENT ON TABLE sys_role_inherit IS '角色继承(同租户内，角色拥有父角色的全部授权)';
+
+-- 12. 操作日志表
+CREATE TABLE sys_oper_log (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,                -- 操作人
+    dept_id BIGINT,                   -- 操作人部门
+    ip VARCHAR(64),
+    operation VARCHAR(255) NOT NULL,  -- 接口 Operation
+    name VARCHAR(64),
+    perm_code VARCHAR(255),           -- 接口关联的权限码，逗号分隔
+    method VARCHAR(10),
+    path VARCHAR(255),
+    request TEXT,                     -- 请求参数（已脱敏）
+    code INT NOT NULL,                -- 结果码，200 为成功
+    reason VARCHAR(64),
+    latency BIGINT,                   -- 耗时（毫秒）
+    diff TEXT,                        -- 数据变更前后对比 (JSON)
+    node VARCHAR(128) NOT NULL,       -- 写入节点
+    prev_hash CHAR(64),
+    hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_oper_log_deleted_at ON sys_oper_log(deleted_at);
+CREATE INDEX idx_sys_oper_log_tenant_id ON sys_oper_log(tenant_id);
+CREATE INDEX idx_sys_oper_log_created_by ON sys_oper_log(created_by);
+CREATE INDEX idx_sys_oper_log_dept_id ON sys_oper_log(dept_id);
+CREATE INDEX idx_sys_oper_log_operation ON sys_oper_log(operation);
+CREATE INDEX idx_sys_oper_log_node ON sys_oper_log(node);
+CREATE INDEX idx_oper_log_tenant_time ON sys_oper_log(tenant_id, created_at);
+CREATE INDEX idx_oper_log_node ON sys_oper_log(node, id);
+COMMENT ON TABLE sys_oper_log IS '操作日志(只追加)：hash = sha256(prev_hash + 日志内容)，同一节点的日志构成哈希链，修改或删除中间记录可被校验发现';
+
+-- 13. 用户导入导出任务表
+CREATE TABLE sys_user_job (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    created_by BIGINT,                -- 发起人
+    dept_id BIGINT,
+    type SMALLINT NOT NULL,           -- 1=导入，2=导出
+    status SMALLINT NOT NULL DEFAULT 1, -- 1=排队中，2=执行中，3=成功，4=失败
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    format VARCHAR(8) NOT NULL,       -- csv/xlsx
+    file_key VARCHAR(255),
+    result_key VARCHAR(255),
+    params TEXT,                      -- 导出筛选条件 (JSON)
+    total INT DEFAULT 0,
+    succeeded INT DEFAULT 0,
+    failed INT DEFAULT 0,
+    message VARCHAR(255),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_user_job_deleted_at ON sys_user_job(deleted_at);
+CREATE INDEX idx_sys_user_job_tenant_id ON sys_user_job(tenant_id);
+CREATE INDEX idx_sys_user_job_created_by ON sys_user_job(created_by);
+CREATE INDEX idx_sys_user_job_dept_id ON sys_user_job(dept_id);
+CREATE INDEX idx_user_job_creator ON sys_user_job(tenant_id, created_by);
+CREATE INDEX idx_user_job_status ON sys_user_job(status, updated_at);
+COMMENT ON TABLE sys_user_job IS '用户导入导出任务(异步执行，结果文件存放在对象存储)';
+
+-- 14. 字典类型表
+CREATE TABLE sys_dict_type (
+    id BIGINT PRIMARY KEY,
+    code VARCHAR(64) NOT NULL,        -- 类型编码 (如: sys_user_status)
+    name VARCHAR(64) NOT NULL,
+    remark VARCHAR(255),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_dict_type_deleted_at ON sys_dict_type(deleted_at);
+CREATE UNIQUE INDEX uk_dict_type_code ON sys_dict_type(code) WHERE deleted_at IS NULL;
+COMMENT ON TABLE sys_dict_type IS '字典类型(全局，由平台租户维护)';
+
+-- 15. 字典项表
+CREATE TABLE sys_dict_item (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL DEFAULT 0, -- 0 表示全局字典项
+    type_code VARCHAR(64) NOT NULL,
+    label VARCHAR(64) NOT NULL,
+    value VARCHAR(64) NOT NULL,
+    sort INT DEFAULT 0,
+    color VARCHAR(32),                -- 标签颜色 (如: success/warning/#1890ff)
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    remark VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_dict_item_deleted_at ON sys_dict_item(deleted_at);
+CREATE INDEX idx_sys_dict_item_tenant_id ON sys_dict_item(tenant_id);
+CREATE UNIQUE INDEX uk_dict_item_value ON sys_dict_item(tenant_id, type_code, value) WHERE deleted_at IS NULL;
+COMMENT ON TABLE sys_dict_item IS '字典项：租户字典项按字典值覆盖同类型的全局字典项';
+
+-- 16. 系统参数表
+CREATE TABLE sys_config (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL DEFAULT 0, -- 0 表示全局参数
+    config_key VARCHAR(128) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    value_type VARCHAR(16) NOT NULL,  -- string/int/bool/duration/json
+    value TEXT,
+    remark VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_config_deleted_at ON sys_config(deleted_at);
+CREATE INDEX idx_sys_config_tenant_id ON sys_config(tenant_id);
+CREATE UNIQUE INDEX uk_config_key ON sys_config(tenant_id, config_key) WHERE deleted_at IS NULL;
+COMMENT ON TABLE sys_config IS '系统参数：平台租户定义全局参数，租户参数按参数键覆盖全局参数，修改后各节点热加载';
+
+-- 17. 系统参数变更记录表
+CREATE TABLE sys_config_log (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL DEFAULT 0,
+    config_key VARCHAR(128) NOT NULL,
+    action VARCHAR(16) NOT NULL,      -- create/update/delete
+    old_value TEXT,
+    new_value TEXT,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_config_log_deleted_at ON sys_config_log(deleted_at);
+CREATE INDEX idx_config_log_key ON sys_config_log(tenant_id, config_key);
+COMMENT ON TABLE sys_config_log IS '系统参数变更记录(只追加)';
+
+-- 18. 通知公告表
+CREATE TABLE sys_notice (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL DEFAULT 0, -- 0 表示全局公告
+    title VARCHAR(128) NOT NULL,
+    content TEXT,
+    level VARCHAR(16) NOT NULL,       -- info/warning/urgent
+    target_type VARCHAR(16) NOT NULL, -- all/tenant/dept/user
+    target_ids TEXT,                  -- 接收部门或用户ID，逗号分隔
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_notice_deleted_at ON sys_notice(deleted_at);
+CREATE INDEX idx_sys_notice_tenant_id ON sys_notice(tenant_id);
+COMMENT ON TABLE sys_notice IS '通知公告：发布时按接收范围展开到收件箱';
+
+-- 19. 通知收件箱表
+CREATE TABLE sys_notice_inbox (
+    id BIGINT PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    notice_id BIGINT NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE, -- 为空表示未读
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_sys_notice_inbox_deleted_at ON sys_notice_inbox(deleted_at);
+CREATE UNIQUE INDEX uk_notice_inbox_user ON sys_notice_inbox(user_id, notice_id);
+COMMENT ON TABLE sys_notice_inbox IS '用户通知收件箱(已读/未读)';
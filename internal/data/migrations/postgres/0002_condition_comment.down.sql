@@ -0,0 +1 @@
+COMMENT ON COLUMN sys_role_permission.condition IS '生效条件: Casbin 表达式，可引用 r.attr.IP / Clock / Weekday / UserID / Owner，空为无条件';
@@ -0,0 +1,2 @@
+-- 条件中的 r.attr.Owner 已移除（取自客户端参数，无法作为授权依据）
+COMMENT ON COLUMN sys_role_permission.condition IS '生效条件: Casbin 表达式，可引用 r.attr.IP / Clock / Weekday / UserID，空为无条件';
@@ -0,0 +1,15 @@
+-- 操作日志哈希链按节点序号校验，链的起点及进度登记在 sys_oper_log_chain
+-- 本版本之前写入的日志没有序号及登记，校验时报告为断点，超出保留期后由清理任务删除
+ALTER TABLE sys_oper_log ADD COLUMN seq BIGINT NOT NULL DEFAULT 0;
+COMMENT ON COLUMN sys_oper_log.seq IS '节点内序号，链的第一条为 1';
+COMMENT ON TABLE sys_oper_log IS '操作日志(只追加)：hash = sha256(prev_hash + 日志内容)，同一节点的日志按序号构成哈希链，修改或删除任意记录可被校验发现';
+
+CREATE TABLE sys_oper_log_chain (
+    node VARCHAR(128) PRIMARY KEY,             -- 写入节点
+    last_seq BIGINT NOT NULL DEFAULT 0,        -- 最后写入的序号
+    pruned_seq BIGINT NOT NULL DEFAULT 0,      -- 保留期清理删除的最后序号
+    pruned_hash CHAR(64) NOT NULL DEFAULT '',  -- 保留期清理删除的最后一条日志的哈希
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE sys_oper_log_chain IS '操作日志哈希链登记：节点的第一条日志写入前创建，校验从清理锚点开始到最后写入的序号结束';
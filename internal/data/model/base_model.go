@@ -8,11 +8,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// BaseModel 公共字段，更新人及版本号由 OptimisticLockPlugin 在更新时维护
 type BaseModel struct {
 	ID        int64          `gorm:"column:id;primaryKey"`
 	CreatedAt time.Time      `gorm:"column:created_at"`
 	UpdatedAt time.Time      `gorm:"column:updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	UpdatedBy int64          `gorm:"column:updated_by;default:0;comment:更新者ID" json:"updated_by"`
+	Version   int64          `gorm:"column:version;not null;default:1;comment:乐观锁版本号" json:"version"`
 }
 
 var globalIDGen idgen.IDGenerator
@@ -27,6 +30,9 @@ func NextID() (int64, error) {
 }
 
 func (m *BaseModel) BeforeCreate(_ *gorm.DB) error {
+	if m.Version == 0 {
+		m.Version = 1
+	}
 	if m.ID != 0 {
 		return nil
 	}
@@ -51,6 +57,18 @@ type BaseAuthModel struct {
 	AuthField
 }
 
+// AuthScoped 嵌入 BaseAuthModel 的模型均实现该接口，
+// 数据权限插件据此自动追加租户隔离与数据范围条件
+type AuthScoped interface {
+	// DataScopeColumns 返回部门过滤列与个人过滤列
+	DataScopeColumns() (deptColumn, selfColumn string)
+}
+
+// DataScopeColumns 默认按所属部门和创建者过滤，模型可覆盖
+func (BaseAuthModel) DataScopeColumns() (string, string) {
+	return "dept_id", "created_by"
+}
+
 func (m *BaseAuthModel) BeforeCreate(tx *gorm.DB) error {
 	// 1. 调用BaseModel的BeforeCreate方法处理ID生成
 	if err := m.BaseModel.BeforeCreate(tx); err != nil {
@@ -93,14 +111,3 @@ func (d *Data) Query(ctx context.Context) *query.Query {
 }
 */
 
-/*
-func (m *BaseAuthModel) BeforeUpdate(tx *gorm.DB) error {
-	ctx := tx.Statement.Context
-	if ctx != nil {
-		uid, _, _ := auth.GetAuthInfo(ctx)
-		// 假设你有一个 UpdatedBy 字段
-		// tx.Statement.SetColumn("updated_by", uid)
-	}
-	return nil
-}
-*/
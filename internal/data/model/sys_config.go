@@ -0,0 +1,17 @@
+package model
+
+// SysConfig 系统参数表
+// TenantID 为 0 表示全局参数（由平台租户定义）；租户参数按参数键覆盖全局参数，不参与数据权限过滤
+type SysConfig struct {
+	BaseModel
+	TenantID  int64  `gorm:"column:tenant_id;type:bigint;not null;default:0;index;comment:租户ID，0 表示全局" json:"tenant_id"`
+	Key       string `gorm:"column:config_key;type:varchar(128);not null;comment:参数键" json:"config_key"`
+	Name      string `gorm:"column:name;type:varchar(64);not null;comment:参数名称" json:"name"`
+	ValueType string `gorm:"column:value_type;type:varchar(16);not null;comment:值类型 (string/int/bool/duration/json)" json:"value_type"`
+	Value     string `gorm:"column:value;type:text;comment:参数值" json:"value"`
+	Remark    string `gorm:"column:remark;type:varchar(255);comment:备注" json:"remark"`
+}
+
+func (*SysConfig) TableName() string {
+	return "sys_config"
+}
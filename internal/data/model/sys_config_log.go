@@ -0,0 +1,16 @@
+package model
+
+// SysConfigLog 系统参数变更记录表（只追加）
+type SysConfigLog struct {
+	BaseModel
+	TenantID  int64  `gorm:"column:tenant_id;type:bigint;not null;default:0;index:idx_config_log_key,priority:1;comment:租户ID，0 表示全局" json:"tenant_id"`
+	ConfigKey string `gorm:"column:config_key;type:varchar(128);not null;index:idx_config_log_key,priority:2;comment:参数键" json:"config_key"`
+	Action    string `gorm:"column:action;type:varchar(16);not null;comment:操作 (create/update/delete)" json:"action"`
+	OldValue  string `gorm:"column:old_value;type:text;comment:修改前的值" json:"old_value"`
+	NewValue  string `gorm:"column:new_value;type:text;comment:修改后的值" json:"new_value"`
+	CreatedBy int64  `gorm:"column:created_by;comment:操作人ID" json:"created_by"`
+}
+
+func (*SysConfigLog) TableName() string {
+	return "sys_config_log"
+}
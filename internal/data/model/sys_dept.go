@@ -7,8 +7,14 @@ type SysDept struct {
 	Name      string `gorm:"column:name;type:varchar(128);not null;comment:部门名称" json:"name"`
 	Ancestors string `gorm:"column:ancestors;type:varchar(512);comment:祖先路径" json:"ancestors"`
 	Sort      int32  `gorm:"column:sort;type:int;default:0;comment:排序序号" json:"sort"`
+	DeletedBy int64  `gorm:"column:deleted_by;type:bigint;default:0;comment:删除人ID" json:"deleted_by"`
 }
 
 func (*SysDept) TableName() string {
 	return "sys_dept"
 }
+
+// DataScopeColumns 部门按自身 ID 过滤
+func (SysDept) DataScopeColumns() (string, string) {
+	return "id", "created_by"
+}
@@ -0,0 +1,19 @@
+package model
+
+// SysDictItem 字典项表
+// TenantID 为 0 表示全局字典项；租户字典项按值覆盖同类型的全局字典项，不参与数据权限过滤
+type SysDictItem struct {
+	BaseModel
+	TenantID int64  `gorm:"column:tenant_id;type:bigint;not null;default:0;index;comment:租户ID，0 表示全局" json:"tenant_id"`
+	TypeCode string `gorm:"column:type_code;type:varchar(64);not null;comment:字典类型编码" json:"type_code"`
+	Label    string `gorm:"column:label;type:varchar(64);not null;comment:显示文本" json:"label"`
+	Value    string `gorm:"column:value;type:varchar(64);not null;comment:字典值" json:"value"`
+	Sort     int32  `gorm:"column:sort;type:int;default:0;comment:排序" json:"sort"`
+	Color    string `gorm:"column:color;type:varchar(32);comment:标签颜色 (如 success/warning/#1890ff)" json:"color"`
+	Enabled  bool   `gorm:"column:enabled;type:boolean;not null;comment:是否启用" json:"enabled"`
+	Remark   string `gorm:"column:remark;type:varchar(255);comment:备注" json:"remark"`
+}
+
+func (*SysDictItem) TableName() string {
+	return "sys_dict_item"
+}
@@ -0,0 +1,14 @@
+package model
+
+// SysDictType 字典类型表（全局，由平台租户维护）
+type SysDictType struct {
+	BaseModel
+	Code    string `gorm:"column:code;type:varchar(64);not null;comment:类型编码" json:"code"`
+	Name    string `gorm:"column:name;type:varchar(64);not null;comment:类型名称" json:"name"`
+	Remark  string `gorm:"column:remark;type:varchar(255);comment:备注" json:"remark"`
+	Enabled bool   `gorm:"column:enabled;type:boolean;not null;comment:是否启用" json:"enabled"`
+}
+
+func (*SysDictType) TableName() string {
+	return "sys_dict_type"
+}
@@ -0,0 +1,18 @@
+package model
+
+// SysNotice 通知公告表
+// TenantID 为 0 表示平台发布的全局公告；接收人在发布时展开写入 sys_notice_inbox，不参与数据权限过滤
+type SysNotice struct {
+	BaseModel
+	TenantID   int64  `gorm:"column:tenant_id;type:bigint;not null;default:0;index;comment:租户ID，0 表示全局公告" json:"tenant_id"`
+	Title      string `gorm:"column:title;type:varchar(128);not null;comment:标题" json:"title"`
+	Content    string `gorm:"column:content;type:text;comment:内容" json:"content"`
+	Level      string `gorm:"column:level;type:varchar(16);not null;comment:级别 (info/warning/urgent)" json:"level"`
+	TargetType string `gorm:"column:target_type;type:varchar(16);not null;comment:接收范围 (all/tenant/dept/user)" json:"target_type"`
+	TargetIDs  string `gorm:"column:target_ids;type:text;comment:接收部门或用户ID，逗号分隔" json:"target_ids"`
+	CreatedBy  int64  `gorm:"column:created_by;comment:发布人ID" json:"created_by"`
+}
+
+func (*SysNotice) TableName() string {
+	return "sys_notice"
+}
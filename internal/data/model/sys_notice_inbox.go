@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// SysNoticeInbox 用户通知收件箱，每个接收人一条，ReadAt 为空表示未读
+type SysNoticeInbox struct {
+	BaseModel
+	TenantID int64      `gorm:"column:tenant_id;type:bigint;not null;comment:接收人所属租户ID" json:"tenant_id"`
+	UserID   int64      `gorm:"column:user_id;type:bigint;not null;uniqueIndex:uk_notice_inbox_user,priority:1;comment:接收人ID" json:"user_id"`
+	NoticeID int64      `gorm:"column:notice_id;type:bigint;not null;uniqueIndex:uk_notice_inbox_user,priority:2;comment:通知ID" json:"notice_id"`
+	ReadAt   *time.Time `gorm:"column:read_at;comment:阅读时间" json:"read_at"`
+}
+
+func (*SysNoticeInbox) TableName() string {
+	return "sys_notice_inbox"
+}
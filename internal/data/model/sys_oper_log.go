@@ -0,0 +1,26 @@
+package model
+
+// SysOperLog 操作日志表（只追加，按节点以哈希链防篡改，链的登记见 SysOperLogChain）
+// CreatedBy 为操作人，DeptID 为操作人部门
+type SysOperLog struct {
+	BaseAuthModel
+	IP        string `gorm:"column:ip;type:varchar(64);comment:客户端 IP" json:"ip"`
+	Operation string `gorm:"column:operation;type:varchar(255);not null;index;comment:接口 Operation" json:"operation"`
+	Name      string `gorm:"column:name;type:varchar(64);comment:操作名称" json:"name"`
+	PermCode  string `gorm:"column:perm_code;type:varchar(255);comment:接口关联的权限码，逗号分隔" json:"perm_code"`
+	Method    string `gorm:"column:method;type:varchar(10);comment:HTTP 方法" json:"method"`
+	Path      string `gorm:"column:path;type:varchar(255);comment:请求路径" json:"path"`
+	Request   string `gorm:"column:request;type:text;comment:请求参数（已脱敏）" json:"request"`
+	Code      int32  `gorm:"column:code;type:int;not null;comment:结果码，200 为成功" json:"code"`
+	Reason    string `gorm:"column:reason;type:varchar(64);comment:错误原因" json:"reason"`
+	Latency   int64  `gorm:"column:latency;type:bigint;comment:耗时（毫秒）" json:"latency"`
+	Diff      string `gorm:"column:diff;type:text;comment:数据变更前后对比 (JSON)" json:"diff"`
+	Node      string `gorm:"column:node;type:varchar(128);not null;index;comment:写入节点，同一节点内按序号构成哈希链" json:"node"`
+	Seq       int64  `gorm:"column:seq;type:bigint;not null;default:0;comment:节点内序号，链的第一条为 1" json:"seq"`
+	PrevHash  string `gorm:"column:prev_hash;type:char(64);comment:同节点上一条日志的哈希" json:"prev_hash"`
+	Hash      string `gorm:"column:hash;type:char(64);not null;comment:本条日志的哈希" json:"hash"`
+}
+
+func (*SysOperLog) TableName() string {
+	return "sys_oper_log"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// SysOperLogChain 操作日志哈希链登记表，每个节点一行，在链的第一条日志写入前创建
+// 校验时以 PrunedSeq/PrunedHash 为起点、LastSeq 为终点，链头或链尾的记录被删除均可发现
+type SysOperLogChain struct {
+	Node       string    `gorm:"column:node;type:varchar(128);primaryKey;comment:写入节点" json:"node"`
+	LastSeq    int64     `gorm:"column:last_seq;type:bigint;not null;default:0;comment:最后写入的序号" json:"last_seq"`
+	PrunedSeq  int64     `gorm:"column:pruned_seq;type:bigint;not null;default:0;comment:保留期清理删除的最后序号" json:"pruned_seq"`
+	PrunedHash string    `gorm:"column:pruned_hash;type:char(64);not null;default:'';comment:保留期清理删除的最后一条日志的哈希" json:"pruned_hash"`
+	CreatedAt  time.Time `gorm:"column:created_at;comment:链起始时间" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (*SysOperLogChain) TableName() string {
+	return "sys_oper_log_chain"
+}
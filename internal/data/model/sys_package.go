@@ -1,8 +1,9 @@
 package model
 
 // SysPackage 租户套餐表
+// 套餐由平台统一维护、所有租户共用，不按租户隔离，因此只嵌入 BaseModel（表结构中也没有 tenant_id 等字段）
 type SysPackage struct {
-	BaseAuthModel
+	BaseModel
 	Name   string `gorm:"column:name;type:varchar(128);not null;comment:套餐名称" json:"name"`
 	Status int16  `gorm:"column:status;type:smallint;default:1;comment:状态" json:"status"`
 	Remark string `gorm:"column:remark;type:varchar(255);comment:备注" json:"remark"`
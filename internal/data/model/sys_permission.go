@@ -1,8 +1,9 @@
 package model
 
 // SysPermission 权限/菜单表
+// 权限由接口定义同步、所有租户共用，不按租户隔离，因此只嵌入 BaseModel（表结构中也没有 tenant_id 等字段）
 type SysPermission struct {
-	BaseAuthModel
+	BaseModel
 	ParentID  int64  `gorm:"column:parent_id;type:bigint;default:0;comment:父权限 ID" json:"parent_id"`
 	Name      string `gorm:"column:name;type:varchar(64);not null;comment:权限名称" json:"name"`
 	Code      string `gorm:"column:code;type:varchar(64);not null;comment:权限编码" json:"code"`
@@ -10,6 +11,7 @@ type SysPermission struct {
 	APIPath   string `gorm:"column:api_path;type:varchar(255);comment:Kratos内部路径/API路径" json:"api_path"`
 	APIMethod string `gorm:"column:api_method;type:varchar(20);default:V;comment:API方法" json:"api_method"`
 	Sort      int32  `gorm:"column:sort;type:int;default:0;comment:排序" json:"sort"`
+	DeletedBy int64  `gorm:"column:deleted_by;type:bigint;default:0;comment:删除人ID" json:"deleted_by"`
 }
 
 func (*SysPermission) TableName() string {
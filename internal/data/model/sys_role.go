@@ -3,8 +3,9 @@ package model
 // SysRole 角色表
 type SysRole struct {
 	BaseAuthModel
-	Name string `gorm:"column:name;type:varchar(64);not null;comment:角色名称" json:"name"`
-	Code string `gorm:"column:code;type:varchar(64);not null;comment:角色编码" json:"code"`
+	Name      string `gorm:"column:name;type:varchar(64);not null;comment:角色名称" json:"name"`
+	Code      string `gorm:"column:code;type:varchar(64);not null;comment:角色编码" json:"code"`
+	DeletedBy int64  `gorm:"column:deleted_by;type:bigint;default:0;comment:删除人ID" json:"deleted_by"`
 }
 
 func (*SysRole) TableName() string {
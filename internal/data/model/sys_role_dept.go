@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SysRoleDept 角色自定义数据范围关联表（data_scope 为 CUSTOM 时生效）
+type SysRoleDept struct {
+	ID        int64     `gorm:"column:id;type:bigint;primaryKey" json:"id"`
+	TenantID  int64     `gorm:"column:tenant_id;type:bigint;not null;index;comment:租户ID" json:"tenant_id"`
+	RoleID    int64     `gorm:"column:role_id;type:bigint;not null;index;comment:角色 ID" json:"role_id"`
+	DeptID    int64     `gorm:"column:dept_id;type:bigint;not null;comment:部门 ID" json:"dept_id"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (*SysRoleDept) TableName() string {
+	return "sys_role_dept"
+}
+
+func (m *SysRoleDept) BeforeCreate(_ *gorm.DB) error {
+	if m.ID != 0 {
+		return nil
+	}
+	id, err := NextID()
+	if err != nil {
+		return err
+	}
+	m.ID = id
+	return nil
+}
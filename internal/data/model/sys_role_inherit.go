@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SysRoleInherit 角色继承关系表（同一租户内，角色继承父角色的全部授权）
+type SysRoleInherit struct {
+	ID        int64     `gorm:"column:id;type:bigint;primaryKey" json:"id"`
+	TenantID  int64     `gorm:"column:tenant_id;type:bigint;not null;index;comment:租户ID" json:"tenant_id"`
+	RoleID    int64     `gorm:"column:role_id;type:bigint;not null;index;comment:角色 ID" json:"role_id"`
+	ParentID  int64     `gorm:"column:parent_id;type:bigint;not null;comment:父角色 ID" json:"parent_id"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (*SysRoleInherit) TableName() string {
+	return "sys_role_inherit"
+}
+
+func (m *SysRoleInherit) BeforeCreate(_ *gorm.DB) error {
+	if m.ID != 0 {
+		return nil
+	}
+	id, err := NextID()
+	if err != nil {
+		return err
+	}
+	m.ID = id
+	return nil
+}
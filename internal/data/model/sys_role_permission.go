@@ -5,7 +5,9 @@ type SysRolePermission struct {
 	BaseAuthModel
 	RoleID       int64  `gorm:"column:role_id;type:bigint;not null;comment:角色 ID" json:"role_id"`
 	PermissionID int64  `gorm:"column:permission_id;type:bigint;not null;comment:权限 ID" json:"permission_id"`
-	DataScope    string `gorm:"column:data_scope;type:varchar(20);default:SELF;comment:数据范围: SELF(个人), DEPT(本部门), DEPT_SUB(本部门及下级), ALL(全租户)" json:"data_scope"`
+	DataScope    string `gorm:"column:data_scope;type:varchar(20);default:SELF;comment:数据范围: SELF(个人), DEPT(本部门), DEPT_SUB(本部门及下级), CUSTOM(自定义部门), ALL(全租户)" json:"data_scope"`
+	Condition    string `gorm:"column:condition;type:varchar(512);not null;default:'';comment:生效条件 (Casbin 表达式，空为无条件)" json:"condition"`
+	Effect       string `gorm:"column:effect;type:varchar(10);not null;default:allow;comment:效果: allow(允许), deny(拒绝，优先于允许)" json:"effect"`
 }
 
 func (*SysRolePermission) TableName() string {
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// SysUserJob 用户导入导出任务表
+// CreatedBy 为发起人，任务只对发起人可见
+type SysUserJob struct {
+	BaseAuthModel
+	Type       int16      `gorm:"column:type;type:smallint;not null;comment:任务类型：1=导入，2=导出" json:"type"`
+	Status     int16      `gorm:"column:status;type:smallint;not null;default:1;comment:状态：1=排队中，2=执行中，3=成功，4=失败" json:"status"`
+	DryRun     bool       `gorm:"column:dry_run;type:boolean;not null;default:false;comment:是否仅校验不写入（导入）" json:"dry_run"`
+	Format     string     `gorm:"column:format;type:varchar(8);not null;comment:文件格式：csv/xlsx" json:"format"`
+	FileKey    string     `gorm:"column:file_key;type:varchar(255);comment:导入文件的存储路径" json:"file_key"`
+	ResultKey  string     `gorm:"column:result_key;type:varchar(255);comment:错误报告或导出文件的存储路径" json:"result_key"`
+	Params     string     `gorm:"column:params;type:text;comment:导出筛选条件 (JSON)" json:"params"`
+	Total      int        `gorm:"column:total;type:int;default:0;comment:总行数" json:"total"`
+	Succeeded  int        `gorm:"column:succeeded;type:int;default:0;comment:成功行数" json:"succeeded"`
+	Failed     int        `gorm:"column:failed;type:int;default:0;comment:失败行数" json:"failed"`
+	Message    string     `gorm:"column:message;type:varchar(255);comment:失败原因" json:"message"`
+	FinishedAt *time.Time `gorm:"column:finished_at;comment:完成时间" json:"finished_at"`
+}
+
+func (*SysUserJob) TableName() string {
+	return "sys_user_job"
+}
@@ -1,10 +1,13 @@
 package model
 
+import "time"
+
 // SysUserRole 用户角色关联表
 type SysUserRole struct {
 	BaseAuthModel
-	UserID int64 `gorm:"column:user_id;type:bigint;not null;comment:用户 ID" json:"user_id"`
-	RoleID int64 `gorm:"column:role_id;type:bigint;not null;comment:角色 ID" json:"role_id"`
+	UserID   int64      `gorm:"column:user_id;type:bigint;not null;comment:用户 ID" json:"user_id"`
+	RoleID   int64      `gorm:"column:role_id;type:bigint;not null;comment:角色 ID" json:"role_id"`
+	ExpireAt *time.Time `gorm:"column:expire_at;comment:到期时间，为空表示永久" json:"expire_at"`
 }
 
 func (*SysUserRole) TableName() string {
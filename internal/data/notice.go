@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var _ biz.NoticeRepo = (*noticeRepo)(nil)
+
+// 收件箱每批写入的条数
+const noticeInboxBatchSize = 500
+
+type noticeRepo struct {
+	BaseRepo
+}
+
+func NewNoticeRepo(data *Data, logger log.Logger) biz.NoticeRepo {
+	return &noticeRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+func (r *noticeRepo) CreateNotice(ctx context.Context, n *biz.Notice) error {
+	m := &model.SysNotice{
+		TenantID:   n.TenantID,
+		Title:      n.Title,
+		Content:    n.Content,
+		Level:      n.Level,
+		TargetType: n.TargetType,
+		TargetIDs:  joinIDs(n.TargetIDs),
+		CreatedBy:  n.CreatedBy,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	n.ID = m.ID
+	n.CreatedAt = m.CreatedAt
+	return nil
+}
+
+func (r *noticeRepo) ListRecipients(ctx context.Context, n *biz.Notice) ([]*biz.NoticeRecipient, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	db := r.data.DB(ctx).Model(&model.SysUser{}).
+		Select("id", "tenant_id", "mobile", "email", "notify_channels").
+		Where("status = ?", biz.UserStatusEnabled)
+	switch n.TargetType {
+	case biz.NoticeTargetAll:
+	case biz.NoticeTargetTenant:
+		db = db.Where("tenant_id = ?", n.TenantID)
+	case biz.NoticeTargetDept:
+		// 指定部门及其下级部门
+		cond := r.data.DB(ctx).Where("id IN ?", n.TargetIDs)
+		for _, id := range n.TargetIDs {
+			cond = cond.Or(subDeptSQL, subDeptArgs(id)...)
+		}
+		depts := r.data.DB(ctx).Table("sys_dept").Select("id").
+			Where("tenant_id = ? AND deleted_at IS NULL", n.TenantID).
+			Where(cond)
+		db = db.Where("tenant_id = ? AND dept_id IN (?)", n.TenantID, depts)
+	case biz.NoticeTargetUser:
+		db = db.Where("tenant_id = ? AND id IN ?", n.TenantID, n.TargetIDs)
+	default:
+		return nil, nil
+	}
+
+	var users []*model.SysUser
+	if err := db.Find(&users).Error; err != nil {
+		return nil, err
+	}
+	result := make([]*biz.NoticeRecipient, 0, len(users))
+	for _, u := range users {
+		result = append(result, &biz.NoticeRecipient{
+			UserID:   u.ID,
+			TenantID: u.TenantID,
+			Phone:    u.Mobile,
+			Email:    u.Email,
+			Channels: splitChannels(u.NotifyChannels),
+		})
+	}
+	return result, nil
+}
+
+func (r *noticeRepo) CreateInboxes(ctx context.Context, noticeID int64, recipients []*biz.NoticeRecipient) error {
+	list := make([]*model.SysNoticeInbox, 0, len(recipients))
+	for _, u := range recipients {
+		list = append(list, &model.SysNoticeInbox{
+			TenantID: u.TenantID,
+			UserID:   u.UserID,
+			NoticeID: noticeID,
+		})
+	}
+	return r.data.DB(ctx).CreateInBatches(list, noticeInboxBatchSize).Error
+}
+
+// userNoticeRow 收件箱与通知的联表查询结果
+type userNoticeRow struct {
+	model.SysNotice
+	ReadAt *time.Time `gorm:"column:read_at"`
+}
+
+func (r *noticeRepo) inbox(ctx context.Context, userID int64) *gorm.DB {
+	return r.data.DB(ctx).Table("sys_notice_inbox i").
+		Joins("JOIN sys_notice n ON n.id = i.notice_id AND n.deleted_at IS NULL").
+		Where("i.user_id = ? AND i.deleted_at IS NULL", userID)
+}
+
+func (r *noticeRepo) ListUserNotices(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*biz.UserNotice, int64, error) {
+	db := r.inbox(ctx, userID)
+	if unreadOnly {
+		db = db.Where("i.read_at IS NULL")
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var rows []*userNoticeRow
+	err := db.Select("n.*, i.read_at").
+		Order("n.created_at DESC, n.id DESC").
+		Scopes(r.Paginate(page, pageSize)).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*biz.UserNotice, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &biz.UserNotice{
+			Notice: biz.Notice{
+				ID:         row.ID,
+				TenantID:   row.TenantID,
+				Title:      row.Title,
+				Content:    row.Content,
+				Level:      row.Level,
+				TargetType: row.TargetType,
+				TargetIDs:  splitIDs(row.TargetIDs),
+				CreatedBy:  row.CreatedBy,
+				CreatedAt:  row.CreatedAt,
+			},
+			ReadAt: row.ReadAt,
+		})
+	}
+	return result, total, nil
+}
+
+func (r *noticeRepo) CountUnread(ctx context.Context, userID int64) (map[string]int64, error) {
+	var rows []struct {
+		Level string
+		Total int64
+	}
+	err := r.inbox(ctx, userID).
+		Where("i.read_at IS NULL").
+		Select("n.level AS level, COUNT(*) AS total").
+		Group("n.level").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		result[row.Level] = row.Total
+	}
+	return result, nil
+}
+
+func (r *noticeRepo) MarkRead(ctx context.Context, userID int64, noticeIDs []int64) error {
+	db := r.data.DB(ctx).Model(&model.SysNoticeInbox{}).
+		Where("user_id = ? AND read_at IS NULL", userID)
+	if len(noticeIDs) > 0 {
+		db = db.Where("notice_id IN ?", noticeIDs)
+	}
+	return db.Update("read_at", time.Now()).Error
+}
+
+func (r *noticeRepo) GetNotifyChannels(ctx context.Context, userID int64) ([]string, error) {
+	var channels []string
+	err := r.data.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysUser{}).
+		Where("id = ?", userID).
+		Pluck("COALESCE(notify_channels, '')", &channels).Error
+	if err != nil || len(channels) == 0 {
+		return nil, err
+	}
+	return splitChannels(channels[0]), nil
+}
+
+func (r *noticeRepo) UpdateNotifyChannels(ctx context.Context, userID int64, channels []string) error {
+	err := r.data.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysUser{}).
+		Where("id = ?", userID).
+		Update("notify_channels", strings.Join(channels, ",")).Error
+	if err != nil {
+		return err
+	}
+	// 更新时间随之变化
+	userCache(r.data).Delete(ctx, userCacheKeys(userID)...)
+	return nil
+}
+
+func splitChannels(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func joinIDs(ids []int64) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(s, ",")
+}
+
+func splitIDs(s string) []int64 {
+	if s == "" {
+		return nil
+	}
+	var ids []int64
+	for _, v := range strings.Split(s, ",") {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,433 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/audit"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var (
+	_ audit.Writer    = (*OperLogWriter)(nil)
+	_ biz.OperLogRepo = (*operLogRepo)(nil)
+)
+
+var (
+	// errChainBroken 哈希链校验失败，用于提前结束分批遍历
+	errChainBroken = errors.New("oper log chain broken")
+	// errChainConflict 链登记的序号已被其他进程推进，通常是多个节点配置了相同的 worker_id
+	errChainConflict = errors.New("oper log chain advanced by another writer")
+)
+
+// OperLogWriter 操作日志异步批量写入
+// 同一节点的日志按序号构成哈希链：hash = sha256(prev_hash + 日志内容)，链的起点及进度登记在 sys_oper_log_chain
+type OperLogWriter struct {
+	data     *Data
+	ch       chan *audit.Record
+	done     chan struct{}
+	batch    int
+	interval time.Duration
+	node     string
+	seq      int64
+	prevHash string
+	log      *log.Helper
+}
+
+func NewOperLogWriter(data *Data, c *conf.App, logger log.Logger) (*OperLogWriter, func(), error) {
+	bufferSize, batch, interval := 1024, 100, time.Second
+	if a := c.GetAudit(); a != nil {
+		if a.BufferSize > 0 {
+			bufferSize = int(a.BufferSize)
+		}
+		if a.BatchSize > 0 {
+			batch = int(a.BatchSize)
+		}
+		if a.FlushInterval != nil {
+			interval = a.FlushInterval.AsDuration()
+		}
+	}
+	w := &OperLogWriter{
+		data:     data,
+		ch:       make(chan *audit.Record, bufferSize),
+		done:     make(chan struct{}),
+		batch:    batch,
+		interval: interval,
+		// 节点取配置的 worker_id（各节点唯一且重启后不变），重启后接续上一次的哈希链
+		node: fmt.Sprintf("node-%d", c.WorkerId),
+		log:  log.NewHelper(logger),
+	}
+	if err := w.resume(); err != nil {
+		return nil, nil, err
+	}
+
+	go w.run()
+	return w, w.close, nil
+}
+
+// resume 读取节点的链登记及最后一条日志，节点首次写入时登记新链，第一条日志的序号为 1
+func (w *OperLogWriter) resume() error {
+	db := w.data.DB(auth.WithSkipDataScope(context.Background()))
+	chain := model.SysOperLogChain{Node: w.node}
+	if err := db.Where("node = ?", w.node).FirstOrCreate(&chain).Error; err != nil {
+		return err
+	}
+	var last model.SysOperLog
+	if err := db.Where("node = ?", w.node).Order("seq DESC").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+	w.seq = chain.LastSeq
+	switch {
+	case last.ID != 0 && last.Seq == chain.LastSeq:
+		w.prevHash = last.Hash
+	case chain.LastSeq == chain.PrunedSeq:
+		w.prevHash = chain.PrunedHash
+	default:
+		// 链尾的日志已被删除，继续按登记的序号写入，校验时在断点处报告
+		w.log.Errorf("oper log chain of node %s is missing records after seq %d", w.node, last.Seq)
+		w.prevHash = last.Hash
+	}
+	return nil
+}
+
+// Write 写入缓冲队列，队列满时丢弃，不阻塞请求
+func (w *OperLogWriter) Write(r *audit.Record) {
+	select {
+	case w.ch <- r:
+	default:
+		w.log.Errorf("oper log buffer full, dropped: operation=%s user=%d tenant=%d", r.Operation, r.UserID, r.TenantID)
+	}
+}
+
+// close 停止接收并写入剩余日志
+func (w *OperLogWriter) close() {
+	close(w.ch)
+	<-w.done
+}
+
+func (w *OperLogWriter) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	buf := make([]*audit.Record, 0, w.batch)
+	for {
+		select {
+		case r, ok := <-w.ch:
+			if !ok {
+				w.flush(buf)
+				return
+			}
+			buf = append(buf, r)
+			if len(buf) >= w.batch {
+				w.flush(buf)
+				buf = buf[:0]
+			}
+		case <-ticker.C:
+			if len(buf) > 0 {
+				w.flush(buf)
+				buf = buf[:0]
+			}
+		}
+	}
+}
+
+func (w *OperLogWriter) flush(records []*audit.Record) {
+	if len(records) == 0 {
+		return
+	}
+	list := make([]*model.SysOperLog, 0, len(records))
+	for _, r := range records {
+		m, err := w.toModel(r)
+		if err != nil {
+			w.log.Errorf("build oper log failed: %v", err)
+			continue
+		}
+		list = append(list, m)
+	}
+	if len(list) == 0 {
+		return
+	}
+	err := w.append(list)
+	if errors.Is(err, errChainConflict) {
+		w.log.Errorf("oper log chain of node %s was advanced by another writer, check that app.worker_id is unique", w.node)
+		if err = w.resume(); err == nil {
+			err = w.append(list)
+		}
+	}
+	if err != nil {
+		// 写入失败时不推进哈希链，下一批从上次成功的位置继续
+		w.log.Errorf("write %d oper logs failed: %v", len(list), err)
+	}
+}
+
+// append 为日志分配序号及哈希，与链登记的进度在同一事务中写入
+func (w *OperLogWriter) append(list []*model.SysOperLog) error {
+	seq, prev := w.seq, w.prevHash
+	for _, m := range list {
+		seq++
+		m.Seq = seq
+		m.PrevHash = prev
+		m.Hash = audit.ChainHash(prev, operLogContent(m))
+		prev = m.Hash
+	}
+	err := w.data.InTx(auth.WithSkipDataScope(context.Background()), func(ctx context.Context) error {
+		if err := w.data.DB(ctx).Create(&list).Error; err != nil {
+			return err
+		}
+		res := w.data.DB(ctx).Model(&model.SysOperLogChain{}).
+			Where("node = ? AND last_seq = ?", w.node, w.seq).
+			Update("last_seq", seq)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errChainConflict
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	w.seq, w.prevHash = seq, prev
+	return nil
+}
+
+func (w *OperLogWriter) toModel(r *audit.Record) (*model.SysOperLog, error) {
+	id, err := model.NextID()
+	if err != nil {
+		return nil, err
+	}
+	var diff string
+	if len(r.Diffs) > 0 {
+		b, err := json.Marshal(r.Diffs)
+		if err != nil {
+			return nil, err
+		}
+		diff = string(b)
+	}
+	m := &model.SysOperLog{
+		IP:        truncate(r.IP, 64),
+		Operation: truncate(r.Operation, 255),
+		Name:      truncate(r.Name, 64),
+		PermCode:  truncate(strings.Join(r.PermCodes, ","), 255),
+		Method:    truncate(r.Method, 10),
+		Path:      truncate(r.Path, 255),
+		Request:   r.Request,
+		Code:      r.Code,
+		Reason:    truncate(r.Reason, 64),
+		Latency:   r.Latency.Milliseconds(),
+		Diff:      diff,
+		Node:      w.node,
+	}
+	m.ID = id
+	m.TenantID = r.TenantID
+	m.CreatedBy = r.UserID
+	m.DeptID = r.DeptID
+	// 数据库时间精度不一，参与哈希的时间精确到秒
+	m.CreatedAt = r.CreatedAt.Truncate(time.Second)
+	return m, nil
+}
+
+// operLogContent 参与哈希计算的日志内容，字段顺序固定
+func operLogContent(m *model.SysOperLog) []byte {
+	b, _ := json.Marshal([]interface{}{
+		m.ID, m.TenantID, m.CreatedBy, m.DeptID, m.IP, m.Operation, m.Name, m.PermCode,
+		m.Method, m.Path, m.Request, m.Code, m.Reason, m.Latency, m.Diff, m.Node, m.CreatedAt.Unix(), m.Seq,
+	})
+	return b
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+type operLogRepo struct {
+	BaseRepo
+}
+
+func NewOperLogRepo(data *Data, logger log.Logger) biz.OperLogRepo {
+	return &operLogRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+func (r *operLogRepo) ListOperLogs(ctx context.Context, filter *biz.OperLogFilter, page, pageSize int) ([]*biz.OperLog, int64, error) {
+	var total int64
+	db := r.data.DB(ctx).Model(&model.SysOperLog{}).Scopes(r.filter(filter))
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.SysOperLog
+	if err := db.Scopes(r.SortBy("id", false), r.Paginate(page, pageSize)).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return toBizOperLogs(list), total, nil
+}
+
+func (r *operLogRepo) CountOperLogs(ctx context.Context, filter *biz.OperLogFilter) (int64, error) {
+	var total int64
+	err := r.data.DB(ctx).Model(&model.SysOperLog{}).Scopes(r.filter(filter)).Count(&total).Error
+	return total, err
+}
+
+func (r *operLogRepo) FindOperLogs(ctx context.Context, filter *biz.OperLogFilter, limit int) ([]*biz.OperLog, error) {
+	var list []*model.SysOperLog
+	err := r.data.DB(ctx).Scopes(r.filter(filter), r.SortBy("id", false)).Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return toBizOperLogs(list), nil
+}
+
+// DeleteOperLogsBefore 各节点从链头删除到早于指定时间的最后一条日志，并登记为链的新起点，保证剩余日志仍可校验
+func (r *operLogRepo) DeleteOperLogsBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx = auth.WithSkipDataScope(ctx)
+	var total int64
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		var chains []model.SysOperLogChain
+		if err := r.data.DB(ctx).Find(&chains).Error; err != nil {
+			return err
+		}
+		for _, c := range chains {
+			var last model.SysOperLog
+			err := r.data.DB(ctx).Unscoped().Where("node = ? AND created_at < ?", c.Node, before).
+				Order("seq DESC").Limit(1).Find(&last).Error
+			if err != nil {
+				return err
+			}
+			if last.ID == 0 {
+				continue
+			}
+			res := r.data.DB(ctx).Unscoped().Where("node = ? AND seq <= ?", c.Node, last.Seq).Delete(&model.SysOperLog{})
+			if res.Error != nil {
+				return res.Error
+			}
+			total += res.RowsAffected
+			err = r.data.DB(ctx).Model(&model.SysOperLogChain{}).Where("node = ?", c.Node).
+				Updates(map[string]interface{}{"pruned_seq": last.Seq, "pruned_hash": last.Hash}).Error
+			if err != nil {
+				return err
+			}
+		}
+		// 未登记的节点（登记表引入前写入的日志）按时间清理
+		res := r.data.DB(ctx).Unscoped().
+			Where("created_at < ? AND node NOT IN (?)", before, r.data.DB(ctx).Model(&model.SysOperLogChain{}).Select("node")).
+			Delete(&model.SysOperLog{})
+		total += res.RowsAffected
+		return res.Error
+	})
+	return total, err
+}
+
+// ListOperLogNodes 包含已登记但日志已全部缺失的节点
+func (r *operLogRepo) ListOperLogNodes(ctx context.Context) ([]string, error) {
+	var logged, registered []string
+	if err := r.data.DB(ctx).Model(&model.SysOperLog{}).Unscoped().Distinct("node").Pluck("node", &logged).Error; err != nil {
+		return nil, err
+	}
+	if err := r.data.DB(ctx).Model(&model.SysOperLogChain{}).Pluck("node", &registered).Error; err != nil {
+		return nil, err
+	}
+	nodes := append(logged, registered...)
+	slices.Sort(nodes)
+	return slices.Compact(nodes), nil
+}
+
+// VerifyOperLogChain 从链登记的起点（保留期清理后为清理锚点）按序号校验到登记的最后序号
+// 链头、中间的日志缺失或被修改时报告断点，链尾缺失时报告缺失条数
+func (r *operLogRepo) VerifyOperLogChain(ctx context.Context, node string) (*biz.OperLogChain, error) {
+	chain := &biz.OperLogChain{Node: node}
+	var reg model.SysOperLogChain
+	if err := r.data.DB(ctx).Where("node = ?", node).Limit(1).Find(&reg).Error; err != nil {
+		return nil, err
+	}
+	// 未登记的节点没有可信的起点，第一条即视为断点
+	registered := reg.Node != ""
+	seq, prev := reg.PrunedSeq, reg.PrunedHash
+	var batch []*model.SysOperLog
+	// 同一节点的 ID 与序号同序递增
+	err := r.data.DB(ctx).Unscoped().Where("node = ?", node).
+		FindInBatches(&batch, 1000, func(_ *gorm.DB, _ int) error {
+			for _, m := range batch {
+				if !registered || m.Seq != seq+1 || m.PrevHash != prev || m.Hash != audit.ChainHash(prev, operLogContent(m)) {
+					chain.BrokenID = m.ID
+					return errChainBroken
+				}
+				seq, prev = m.Seq, m.Hash
+				chain.Checked++
+			}
+			return nil
+		}).Error
+	if err != nil && !errors.Is(err, errChainBroken) {
+		return nil, err
+	}
+	if chain.BrokenID == 0 && seq < reg.LastSeq {
+		chain.Missing = reg.LastSeq - seq
+	}
+	return chain, nil
+}
+
+func (r *operLogRepo) filter(f *biz.OperLogFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f == nil {
+			return db
+		}
+		if f.UserID > 0 {
+			db = db.Where("created_by = ?", f.UserID)
+		}
+		if f.Operation != "" {
+			like := "%" + f.Operation + "%"
+			db = db.Where("operation LIKE ? OR name LIKE ?", like, like)
+		}
+		switch f.Status {
+		case biz.OperLogStatusSuccess:
+			db = db.Where("code = ?", 200)
+		case biz.OperLogStatusFailed:
+			db = db.Where("code <> ?", 200)
+		}
+		if !f.Start.IsZero() {
+			db = db.Where("created_at >= ?", f.Start)
+		}
+		if !f.End.IsZero() {
+			db = db.Where("created_at < ?", f.End)
+		}
+		return db
+	}
+}
+
+func toBizOperLogs(list []*model.SysOperLog) []*biz.OperLog {
+	result := make([]*biz.OperLog, 0, len(list))
+	for _, m := range list {
+		result = append(result, &biz.OperLog{
+			ID:        m.ID,
+			TenantID:  m.TenantID,
+			UserID:    m.CreatedBy,
+			DeptID:    m.DeptID,
+			IP:        m.IP,
+			Operation: m.Operation,
+			Name:      m.Name,
+			PermCode:  m.PermCode,
+			Method:    m.Method,
+			Path:      m.Path,
+			Request:   m.Request,
+			Code:      m.Code,
+			Reason:    m.Reason,
+			Latency:   m.Latency,
+			Diff:      m.Diff,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return result
+}
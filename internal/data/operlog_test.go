@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/audit"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// writeOperLogs 启动一次写入器写入 n 条日志后关闭，模拟一次节点运行
+func writeOperLogs(t *testing.T, d *Data, n int, createdAt time.Time) {
+	t.Helper()
+	w, closeWriter, err := NewOperLogWriter(d, &conf.App{WorkerId: 7}, d.logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		w.Write(&audit.Record{TenantID: 1, UserID: 1, Operation: "/api.user.v1.User/UpdateUser", Code: 200, CreatedAt: createdAt})
+	}
+	closeWriter()
+}
+
+func TestOperLogChain(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		repo := NewOperLogRepo(d, d.logger)
+		ctx := auth.WithSkipDataScope(context.Background())
+		db := d.DB(ctx)
+		old := time.Now().AddDate(0, 0, -10)
+
+		verify := func(t *testing.T) *biz.OperLogChain {
+			t.Helper()
+			chain, err := repo.VerifyOperLogChain(ctx, "node-7")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return chain
+		}
+		records := func() []*model.SysOperLog {
+			var list []*model.SysOperLog
+			if err := db.Where("node = ?", "node-7").Order("seq").Find(&list).Error; err != nil {
+				t.Fatal(err)
+			}
+			return list
+		}
+
+		// 重启后接续同一条链，不产生新的起点
+		writeOperLogs(t, d, 3, old)
+		writeOperLogs(t, d, 2, time.Now())
+		list := records()
+		if len(list) != 5 || list[0].Seq != 1 || list[0].PrevHash != "" || list[4].Seq != 5 || list[3].PrevHash != list[2].Hash {
+			t.Fatalf("chain = %+v", list)
+		}
+		if c := verify(t); c.Checked != 5 || c.BrokenID != 0 || c.Missing != 0 {
+			t.Fatalf("verify = %+v", c)
+		}
+		if got, err := repo.ListOperLogNodes(ctx); err != nil || len(got) != 1 || got[0] != "node-7" {
+			t.Fatalf("nodes = %v, %v", got, err)
+		}
+
+		// 保留期清理后从清理锚点继续校验
+		if n, err := repo.DeleteOperLogsBefore(ctx, time.Now().AddDate(0, 0, -1)); err != nil || n != 3 {
+			t.Fatalf("cleanup = %d, %v", n, err)
+		}
+		if c := verify(t); c.Checked != 2 || c.BrokenID != 0 || c.Missing != 0 {
+			t.Fatalf("verify after cleanup = %+v", c)
+		}
+
+		// 删除链尾：报告缺失条数，重启后继续写入时在断点处报告
+		list = records()
+		if err := db.Unscoped().Delete(list[1]).Error; err != nil {
+			t.Fatal(err)
+		}
+		if c := verify(t); c.Checked != 1 || c.BrokenID != 0 || c.Missing != 1 {
+			t.Fatalf("verify truncated tail = %+v", c)
+		}
+		writeOperLogs(t, d, 1, time.Now())
+		list = records()
+		if c := verify(t); c.BrokenID != list[len(list)-1].ID {
+			t.Fatalf("verify after restart = %+v", c)
+		}
+
+		// 删除链头：第一条现存日志与登记的起点不衔接
+		if err := db.Unscoped().Delete(list[0]).Error; err != nil {
+			t.Fatal(err)
+		}
+		if c := verify(t); c.BrokenID != list[1].ID {
+			t.Fatalf("verify truncated head = %+v", c)
+		}
+	})
+}
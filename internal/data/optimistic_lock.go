@@ -0,0 +1,119 @@
+package data
+
+import (
+	"reflect"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	versionColumn   = "version"
+	updatedByColumn = "updated_by"
+
+	optimisticLockSetKey     = "optimistic_lock:set"
+	optimisticLockCheckedKey = "optimistic_lock:checked"
+)
+
+// OptimisticLockPlugin GORM 插件：更新包含 version 列的模型时自增版本号并记录更新人
+//
+// 规则：
+//   - 每次更新都执行 version = version + 1，并在登录状态下写入 updated_by
+//   - 以带版本号的模型更新（如 db.Model(&model.SysTenant{BaseModel: model.BaseModel{ID: id, Version: v}})）时，
+//     追加 WHERE version = v，未更新到任何记录时返回 biz.ErrVersionConflict，成功后模型的版本号同步加一
+//   - 以零值模型更新时不校验版本
+//
+// gorm:update 在已存在 SET 子句时不再转换更新字段，因此这里提前完成转换并追加上述字段
+type OptimisticLockPlugin struct{}
+
+func (OptimisticLockPlugin) Name() string {
+	return "optimistic_lock"
+}
+
+func (p OptimisticLockPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback().Update()
+	if err := cb.Before("gorm:update").Register("optimistic_lock:before_update", p.before); err != nil {
+		return err
+	}
+	return cb.After("gorm:update").Register("optimistic_lock:after_update", p.after)
+}
+
+func (p OptimisticLockPlugin) before(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil || stmt.SQL.Len() > 0 {
+		return
+	}
+	field := stmt.Schema.LookUpField(versionColumn)
+	if field == nil {
+		return
+	}
+	if _, ok := stmt.Clauses["SET"]; ok {
+		return
+	}
+
+	// 先读取期望的版本号，转换更新字段时可能会改写模型的值
+	var expected int64
+	if stmt.ReflectValue.Kind() == reflect.Struct {
+		if v, zero := field.ValueOf(stmt.Context, stmt.ReflectValue); !zero {
+			expected, _ = v.(int64)
+		}
+	}
+
+	set := callbacks.ConvertToAssignments(stmt)
+	if db.Error != nil || len(set) == 0 {
+		return
+	}
+	// UpdateColumn 不更新 updated_at，同样不记录更新人
+	var uid int64
+	if !stmt.SkipHooks && stmt.Schema.LookUpField(updatedByColumn) != nil {
+		uid = auth.GetUserID(stmt.Context)
+	}
+	assignments := make(clause.Set, 0, len(set)+2)
+	for _, a := range set {
+		if a.Column.Name == versionColumn || (uid != 0 && a.Column.Name == updatedByColumn) {
+			continue
+		}
+		assignments = append(assignments, a)
+	}
+	assignments = append(assignments, clause.Assignment{
+		Column: clause.Column{Name: versionColumn},
+		Value:  clause.Expr{SQL: "? + 1", Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: versionColumn}}},
+	})
+	if uid != 0 {
+		assignments = append(assignments, clause.Assignment{Column: clause.Column{Name: updatedByColumn}, Value: uid})
+	}
+	stmt.AddClause(assignments)
+	db.InstanceSet(optimisticLockSetKey, true)
+
+	if expected != 0 {
+		stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: versionColumn}, Value: expected},
+		}})
+		db.InstanceSet(optimisticLockCheckedKey, expected)
+	}
+}
+
+func (p OptimisticLockPlugin) after(db *gorm.DB) {
+	stmt := db.Statement
+	if _, ok := db.InstanceGet(optimisticLockSetKey); !ok {
+		return
+	}
+	// 与 gorm:update 一致，执行后移除 SET 子句，避免复用 Statement 时残留
+	delete(stmt.Clauses, "SET")
+
+	v, ok := db.InstanceGet(optimisticLockCheckedKey)
+	if !ok || db.Error != nil || db.DryRun {
+		return
+	}
+	if db.RowsAffected == 0 {
+		_ = db.AddError(biz.ErrVersionConflict)
+		return
+	}
+	if stmt.ReflectValue.CanAddr() {
+		field := stmt.Schema.LookUpField(versionColumn)
+		_ = field.Set(stmt.Context, stmt.ReflectValue, v.(int64)+1)
+	}
+}
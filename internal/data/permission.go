@@ -2,33 +2,145 @@ package data
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/migrate"
+)
+
+const permissionTypeAPI = "API"
+
+var (
+	_ biz.PermissionRepo        = (*permissionRepo)(nil)
+	_ provider.PermissionLoader = (*permissionRepo)(nil)
 )
 
 type permissionRepo struct {
-	data *Data
-	log  *log.Helper
+	data  *Data
+	perms *Cache[map[string][]string]
+	log   *log.Helper
 }
 
-func NewPermissionRepo(data *Data, logger log.Logger) provider.PermissionLoader {
+func newPermissionRepo(data *Data, logger log.Logger) *permissionRepo {
 	return &permissionRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:  data,
+		perms: apiPermissionCache(data),
+		log:   log.NewHelper(logger),
 	}
 }
 
+// apiPermissionKey 接口权限映射在缓存中的 key，全部节点共用一份
+const apiPermissionKey = "all"
+
+// apiPermissionCache 接口权限映射，节点启动时读取，避免多个节点同时启动时各自全量查询
+// 变更通知触发的重新加载以 biz.WithPrimary 直接读库；过期时间较短，限制同步期间写入旧数据的影响
+func apiPermissionCache(data *Data) *Cache[map[string][]string] {
+	return sharedCache(data, "api_permission", CacheConfig[map[string][]string]{
+		TTL: time.Minute,
+	})
+}
+
+func NewPermissionRepo(data *Data, logger log.Logger) biz.PermissionRepo {
+	return newPermissionRepo(data, logger)
+}
+
+func NewPermissionLoader(data *Data, logger log.Logger) provider.PermissionLoader {
+	return newPermissionRepo(data, logger)
+}
+
 func (r *permissionRepo) LoadAllApiPermissions(ctx context.Context) (map[string][]string, error) {
-	var list []model.SysPermission
-	err := r.data.DB(ctx).Where("type = ?", "API").Find(&list).Error
+	return r.perms.Get(ctx, apiPermissionKey, func(ctx context.Context) (map[string][]string, error) {
+		// 缓存在变更后立即重新加载，读取主库
+		ctx = biz.WithPrimary(ctx)
+		var list []model.SysPermission
+		err := r.data.DB(ctx).Where("type = ?", permissionTypeAPI).Find(&list).Error
+		if err != nil {
+			return nil, err
+		}
+		results := make(map[string][]string)
+		for _, p := range list {
+			results[p.APIPath] = append(results[p.APIPath], p.Code)
+		}
+		return results, nil
+	})
+}
+
+// SyncApiPermissions 多个节点同时启动时持有迁移锁依次同步，避免重复插入同一权限码
+func (r *permissionRepo) SyncApiPermissions(ctx context.Context, perms []*biz.ApiPermission) error {
+	sqlDB, err := r.data.db.DB()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	results := make(map[string][]string)
-	for _, p := range list {
-		results[p.APIPath] = append(results[p.APIPath], p.Code)
-	}
-	return results, err
+	dialect := migrate.DialectOf(r.data.db.Dialector.Name())
+	return migrate.WithLock(ctx, sqlDB, dialect, func() error {
+		return r.syncApiPermissions(ctx, perms)
+	})
+}
+
+func (r *permissionRepo) syncApiPermissions(ctx context.Context, perms []*biz.ApiPermission) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+
+		var list []model.SysPermission
+		if err := db.Where("type = ?", permissionTypeAPI).Find(&list).Error; err != nil {
+			return err
+		}
+		existing := make(map[string]model.SysPermission, len(list))
+		for _, p := range list {
+			existing[p.Code] = p
+		}
+
+		for _, perm := range perms {
+			old, ok := existing[perm.Code]
+			delete(existing, perm.Code)
+			if !ok {
+				r.log.Infof("add api permission %s -> %s", perm.Code, perm.Operation)
+				if err := db.Create(&model.SysPermission{
+					Name:      perm.Name,
+					Code:      perm.Code,
+					Type:      permissionTypeAPI,
+					APIPath:   perm.Operation,
+					APIMethod: "V",
+				}).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if old.APIPath == perm.Operation && old.Name == perm.Name {
+				continue
+			}
+			r.log.Infof("update api permission %s -> %s", perm.Code, perm.Operation)
+			if err := db.Model(&model.SysPermission{}).Where("id = ?", old.ID).Updates(map[string]interface{}{
+				"name":     perm.Name,
+				"api_path": perm.Operation,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 注解中已不存在的权限，同时物理删除其授权，避免之后重新声明同一权限码时旧授权随之恢复
+		removed := make([]int64, 0, len(existing))
+		for code, p := range existing {
+			r.log.Infof("remove api permission %s -> %s", code, p.APIPath)
+			removed = append(removed, p.ID)
+		}
+		if len(removed) > 0 {
+			if err := db.Where("id IN ?", removed).Delete(&model.SysPermission{}).Error; err != nil {
+				return err
+			}
+			// 授权属于各个租户，不经过数据权限插件
+			skipCtx := auth.WithSkipDataScope(ctx)
+			for _, m := range []interface{}{&model.SysRolePermission{}, &model.SysPackagePermission{}} {
+				if err := r.data.DB(skipCtx).Unscoped().Where("permission_id IN ?", removed).Delete(m).Error; err != nil {
+					return err
+				}
+			}
+		}
+		r.perms.Delete(ctx, apiPermissionKey)
+		return nil
+	})
 }
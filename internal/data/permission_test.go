@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+func TestSyncApiPermissions(t *testing.T) {
+	d := newTestData(t)
+	repo := newPermissionRepo(d, d.logger)
+	ctx := auth.WithSkipDataScope(context.Background())
+
+	sync := func(perms ...*biz.ApiPermission) map[string]model.SysPermission {
+		t.Helper()
+		if err := repo.SyncApiPermissions(ctx, perms); err != nil {
+			t.Fatal(err)
+		}
+		var list []model.SysPermission
+		if err := d.DB(ctx).Where("type = ?", permissionTypeAPI).Find(&list).Error; err != nil {
+			t.Fatal(err)
+		}
+		result := make(map[string]model.SysPermission, len(list))
+		for _, p := range list {
+			result[p.Code] = p
+		}
+		return result
+	}
+
+	got := sync(
+		&biz.ApiPermission{Operation: "/api.user.v1.User/ListUsers", Code: "user:list", Name: "用户列表"},
+		&biz.ApiPermission{Operation: "/api.user.v1.User/DeleteUser", Code: "user:delete", Name: "删除用户"},
+	)
+	if len(got) != 2 || got["user:list"].APIPath != "/api.user.v1.User/ListUsers" {
+		t.Fatalf("initial sync = %v", got)
+	}
+	id := got["user:list"].ID
+
+	// 角色及套餐授权
+	grants := func(permID int64) (n int64) {
+		t.Helper()
+		for _, m := range []interface{}{&model.SysRolePermission{}, &model.SysPackagePermission{}} {
+			var c int64
+			if err := d.DB(ctx).Model(m).Where("permission_id = ?", permID).Count(&c).Error; err != nil {
+				t.Fatal(err)
+			}
+			n += c
+		}
+		return n
+	}
+	for _, p := range got {
+		rp := &model.SysRolePermission{RoleID: 1, PermissionID: p.ID, DataScope: auth.ScopeAll}
+		rp.TenantID = 2
+		if err := d.DB(ctx).Create(rp).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := d.DB(ctx).Create(&model.SysPackagePermission{ID: p.ID, PackageID: 1, PermissionID: p.ID}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	removedID := got["user:delete"].ID
+
+	// 更新名称、删除已不存在的权限及其授权，已有权限保持 ID 及授权不变
+	got = sync(&biz.ApiPermission{Operation: "/api.user.v1.User/ListUsers", Code: "user:list", Name: "查询用户"})
+	if len(got) != 1 || got["user:list"].Name != "查询用户" || got["user:list"].ID != id {
+		t.Fatalf("second sync = %v", got)
+	}
+	if n := grants(id); n != 2 {
+		t.Fatalf("grants of kept permission = %d, want 2", n)
+	}
+	if n := grants(removedID); n != 0 {
+		t.Fatalf("grants of removed permission = %d, want 0", n)
+	}
+
+	// 重新声明已删除的权限码，不带回旧授权
+	got = sync(
+		&biz.ApiPermission{Operation: "/api.user.v1.User/ListUsers", Code: "user:list", Name: "查询用户"},
+		&biz.ApiPermission{Operation: "/api.user.v1.User/DeleteUser", Code: "user:delete", Name: "删除用户"},
+	)
+	if len(got) != 2 || got["user:delete"].ID == removedID || grants(got["user:delete"].ID) != 0 {
+		t.Fatalf("redeclared = %v", got)
+	}
+}
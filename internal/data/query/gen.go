@@ -17,28 +17,50 @@ import (
 
 var (
 	Q                    = new(Query)
+	SysConfig            *sysConfig
+	SysConfigLog         *sysConfigLog
 	SysDept              *sysDept
+	SysDictItem          *sysDictItem
+	SysDictType          *sysDictType
+	SysNotice            *sysNotice
+	SysNoticeInbox       *sysNoticeInbox
+	SysOperLog           *sysOperLog
+	SysOperLogChain      *sysOperLogChain
 	SysPackage           *sysPackage
 	SysPackagePermission *sysPackagePermission
 	SysPermission        *sysPermission
 	SysRole              *sysRole
+	SysRoleDept          *sysRoleDept
+	SysRoleInherit       *sysRoleInherit
 	SysRolePermission    *sysRolePermission
 	SysTenant            *sysTenant
 	SysUser              *sysUser
+	SysUserJob           *sysUserJob
 	SysUserRole          *sysUserRole
 	User                 *user
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	SysConfig = &Q.SysConfig
+	SysConfigLog = &Q.SysConfigLog
 	SysDept = &Q.SysDept
+	SysDictItem = &Q.SysDictItem
+	SysDictType = &Q.SysDictType
+	SysNotice = &Q.SysNotice
+	SysNoticeInbox = &Q.SysNoticeInbox
+	SysOperLog = &Q.SysOperLog
+	SysOperLogChain = &Q.SysOperLogChain
 	SysPackage = &Q.SysPackage
 	SysPackagePermission = &Q.SysPackagePermission
 	SysPermission = &Q.SysPermission
 	SysRole = &Q.SysRole
+	SysRoleDept = &Q.SysRoleDept
+	SysRoleInherit = &Q.SysRoleInherit
 	SysRolePermission = &Q.SysRolePermission
 	SysTenant = &Q.SysTenant
 	SysUser = &Q.SysUser
+	SysUserJob = &Q.SysUserJob
 	SysUserRole = &Q.SysUserRole
 	User = &Q.User
 }
@@ -46,14 +68,25 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                   db,
+		SysConfig:            newSysConfig(db, opts...),
+		SysConfigLog:         newSysConfigLog(db, opts...),
 		SysDept:              newSysDept(db, opts...),
+		SysDictItem:          newSysDictItem(db, opts...),
+		SysDictType:          newSysDictType(db, opts...),
+		SysNotice:            newSysNotice(db, opts...),
+		SysNoticeInbox:       newSysNoticeInbox(db, opts...),
+		SysOperLog:           newSysOperLog(db, opts...),
+		SysOperLogChain:      newSysOperLogChain(db, opts...),
 		SysPackage:           newSysPackage(db, opts...),
 		SysPackagePermission: newSysPackagePermission(db, opts...),
 		SysPermission:        newSysPermission(db, opts...),
 		SysRole:              newSysRole(db, opts...),
+		SysRoleDept:          newSysRoleDept(db, opts...),
+		SysRoleInherit:       newSysRoleInherit(db, opts...),
 		SysRolePermission:    newSysRolePermission(db, opts...),
 		SysTenant:            newSysTenant(db, opts...),
 		SysUser:              newSysUser(db, opts...),
+		SysUserJob:           newSysUserJob(db, opts...),
 		SysUserRole:          newSysUserRole(db, opts...),
 		User:                 newUser(db, opts...),
 	}
@@ -62,14 +95,25 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	SysConfig            sysConfig
+	SysConfigLog         sysConfigLog
 	SysDept              sysDept
+	SysDictItem          sysDictItem
+	SysDictType          sysDictType
+	SysNotice            sysNotice
+	SysNoticeInbox       sysNoticeInbox
+	SysOperLog           sysOperLog
+	SysOperLogChain      sysOperLogChain
 	SysPackage           sysPackage
 	SysPackagePermission sysPackagePermission
 	SysPermission        sysPermission
 	SysRole              sysRole
+	SysRoleDept          sysRoleDept
+	SysRoleInherit       sysRoleInherit
 	SysRolePermission    sysRolePermission
 	SysTenant            sysTenant
 	SysUser              sysUser
+	SysUserJob           sysUserJob
 	SysUserRole          sysUserRole
 	User                 user
 }
@@ -79,14 +123,25 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                   db,
+		SysConfig:            q.SysConfig.clone(db),
+		SysConfigLog:         q.SysConfigLog.clone(db),
 		SysDept:              q.SysDept.clone(db),
+		SysDictItem:          q.SysDictItem.clone(db),
+		SysDictType:          q.SysDictType.clone(db),
+		SysNotice:            q.SysNotice.clone(db),
+		SysNoticeInbox:       q.SysNoticeInbox.clone(db),
+		SysOperLog:           q.SysOperLog.clone(db),
+		SysOperLogChain:      q.SysOperLogChain.clone(db),
 		SysPackage:           q.SysPackage.clone(db),
 		SysPackagePermission: q.SysPackagePermission.clone(db),
 		SysPermission:        q.SysPermission.clone(db),
 		SysRole:              q.SysRole.clone(db),
+		SysRoleDept:          q.SysRoleDept.clone(db),
+		SysRoleInherit:       q.SysRoleInherit.clone(db),
 		SysRolePermission:    q.SysRolePermission.clone(db),
 		SysTenant:            q.SysTenant.clone(db),
 		SysUser:              q.SysUser.clone(db),
+		SysUserJob:           q.SysUserJob.clone(db),
 		SysUserRole:          q.SysUserRole.clone(db),
 		User:                 q.User.clone(db),
 	}
@@ -103,42 +158,75 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                   db,
+		SysConfig:            q.SysConfig.replaceDB(db),
+		SysConfigLog:         q.SysConfigLog.replaceDB(db),
 		SysDept:              q.SysDept.replaceDB(db),
+		SysDictItem:          q.SysDictItem.replaceDB(db),
+		SysDictType:          q.SysDictType.replaceDB(db),
+		SysNotice:            q.SysNotice.replaceDB(db),
+		SysNoticeInbox:       q.SysNoticeInbox.replaceDB(db),
+		SysOperLog:           q.SysOperLog.replaceDB(db),
+		SysOperLogChain:      q.SysOperLogChain.replaceDB(db),
 		SysPackage:           q.SysPackage.replaceDB(db),
 		SysPackagePermission: q.SysPackagePermission.replaceDB(db),
 		SysPermission:        q.SysPermission.replaceDB(db),
 		SysRole:              q.SysRole.replaceDB(db),
+		SysRoleDept:          q.SysRoleDept.replaceDB(db),
+		SysRoleInherit:       q.SysRoleInherit.replaceDB(db),
 		SysRolePermission:    q.SysRolePermission.replaceDB(db),
 		SysTenant:            q.SysTenant.replaceDB(db),
 		SysUser:              q.SysUser.replaceDB(db),
+		SysUserJob:           q.SysUserJob.replaceDB(db),
 		SysUserRole:          q.SysUserRole.replaceDB(db),
 		User:                 q.User.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
+	SysConfig            ISysConfigDo
+	SysConfigLog         ISysConfigLogDo
 	SysDept              ISysDeptDo
+	SysDictItem          ISysDictItemDo
+	SysDictType          ISysDictTypeDo
+	SysNotice            ISysNoticeDo
+	SysNoticeInbox       ISysNoticeInboxDo
+	SysOperLog           ISysOperLogDo
+	SysOperLogChain      ISysOperLogChainDo
 	SysPackage           ISysPackageDo
 	SysPackagePermission ISysPackagePermissionDo
 	SysPermission        ISysPermissionDo
 	SysRole              ISysRoleDo
+	SysRoleDept          ISysRoleDeptDo
+	SysRoleInherit       ISysRoleInheritDo
 	SysRolePermission    ISysRolePermissionDo
 	SysTenant            ISysTenantDo
 	SysUser              ISysUserDo
+	SysUserJob           ISysUserJobDo
 	SysUserRole          ISysUserRoleDo
 	User                 IUserDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		SysConfig:            q.SysConfig.WithContext(ctx),
+		SysConfigLog:         q.SysConfigLog.WithContext(ctx),
 		SysDept:              q.SysDept.WithContext(ctx),
+		SysDictItem:          q.SysDictItem.WithContext(ctx),
+		SysDictType:          q.SysDictType.WithContext(ctx),
+		SysNotice:            q.SysNotice.WithContext(ctx),
+		SysNoticeInbox:       q.SysNoticeInbox.WithContext(ctx),
+		SysOperLog:           q.SysOperLog.WithContext(ctx),
+		SysOperLogChain:      q.SysOperLogChain.WithContext(ctx),
 		SysPackage:           q.SysPackage.WithContext(ctx),
 		SysPackagePermission: q.SysPackagePermission.WithContext(ctx),
 		SysPermission:        q.SysPermission.WithContext(ctx),
 		SysRole:              q.SysRole.WithContext(ctx),
+		SysRoleDept:          q.SysRoleDept.WithContext(ctx),
+		SysRoleInherit:       q.SysRoleInherit.WithContext(ctx),
 		SysRolePermission:    q.SysRolePermission.WithContext(ctx),
 		SysTenant:            q.SysTenant.WithContext(ctx),
 		SysUser:              q.SysUser.WithContext(ctx),
+		SysUserJob:           q.SysUserJob.WithContext(ctx),
 		SysUserRole:          q.SysUserRole.WithContext(ctx),
 		User:                 q.User.WithContext(ctx),
 	}
@@ -0,0 +1,424 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysConfigLog(db *gorm.DB, opts ...gen.DOOption) sysConfigLog {
+	_sysConfigLog := sysConfigLog{}
+
+	_sysConfigLog.sysConfigLogDo.UseDB(db, opts...)
+	_sysConfigLog.sysConfigLogDo.UseModel(&model.SysConfigLog{})
+
+	tableName := _sysConfigLog.sysConfigLogDo.TableName()
+	_sysConfigLog.ALL = field.NewAsterisk(tableName)
+	_sysConfigLog.ID = field.NewInt64(tableName, "id")
+	_sysConfigLog.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysConfigLog.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_sysConfigLog.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysConfigLog.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysConfigLog.Version = field.NewInt64(tableName, "version")
+	_sysConfigLog.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysConfigLog.ConfigKey = field.NewString(tableName, "config_key")
+	_sysConfigLog.Action = field.NewString(tableName, "action")
+	_sysConfigLog.OldValue = field.NewString(tableName, "old_value")
+	_sysConfigLog.NewValue = field.NewString(tableName, "new_value")
+	_sysConfigLog.CreatedBy = field.NewInt64(tableName, "created_by")
+
+	_sysConfigLog.fillFieldMap()
+
+	return _sysConfigLog
+}
+
+type sysConfigLog struct {
+	sysConfigLogDo
+
+	ALL       field.Asterisk
+	ID        field.Int64
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
+	TenantID  field.Int64
+	ConfigKey field.String
+	Action    field.String
+	OldValue  field.String
+	NewValue  field.String
+	CreatedBy field.Int64
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysConfigLog) Table(newTableName string) *sysConfigLog {
+	s.sysConfigLogDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysConfigLog) As(alias string) *sysConfigLog {
+	s.sysConfigLogDo.DO = *(s.sysConfigLogDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysConfigLog) updateTableName(table string) *sysConfigLog {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.ConfigKey = field.NewString(table, "config_key")
+	s.Action = field.NewString(table, "action")
+	s.OldValue = field.NewString(table, "old_value")
+	s.NewValue = field.NewString(table, "new_value")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysConfigLog) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysConfigLog) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["config_key"] = s.ConfigKey
+	s.fieldMap["action"] = s.Action
+	s.fieldMap["old_value"] = s.OldValue
+	s.fieldMap["new_value"] = s.NewValue
+	s.fieldMap["created_by"] = s.CreatedBy
+}
+
+func (s sysConfigLog) clone(db *gorm.DB) sysConfigLog {
+	s.sysConfigLogDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysConfigLog) replaceDB(db *gorm.DB) sysConfigLog {
+	s.sysConfigLogDo.ReplaceDB(db)
+	return s
+}
+
+type sysConfigLogDo struct{ gen.DO }
+
+type ISysConfigLogDo interface {
+	gen.SubQuery
+	Debug() ISysConfigLogDo
+	WithContext(ctx context.Context) ISysConfigLogDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysConfigLogDo
+	WriteDB() ISysConfigLogDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysConfigLogDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysConfigLogDo
+	Not(conds ...gen.Condition) ISysConfigLogDo
+	Or(conds ...gen.Condition) ISysConfigLogDo
+	Select(conds ...field.Expr) ISysConfigLogDo
+	Where(conds ...gen.Condition) ISysConfigLogDo
+	Order(conds ...field.Expr) ISysConfigLogDo
+	Distinct(cols ...field.Expr) ISysConfigLogDo
+	Omit(cols ...field.Expr) ISysConfigLogDo
+	Join(table schema.Tabler, on ...field.Expr) ISysConfigLogDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysConfigLogDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysConfigLogDo
+	Group(cols ...field.Expr) ISysConfigLogDo
+	Having(conds ...gen.Condition) ISysConfigLogDo
+	Limit(limit int) ISysConfigLogDo
+	Offset(offset int) ISysConfigLogDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysConfigLogDo
+	Unscoped() ISysConfigLogDo
+	Create(values ...*model.SysConfigLog) error
+	CreateInBatches(values []*model.SysConfigLog, batchSize int) error
+	Save(values ...*model.SysConfigLog) error
+	First() (*model.SysConfigLog, error)
+	Take() (*model.SysConfigLog, error)
+	Last() (*model.SysConfigLog, error)
+	Find() ([]*model.SysConfigLog, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysConfigLog, err error)
+	FindInBatches(result *[]*model.SysConfigLog, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysConfigLog) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysConfigLogDo
+	Assign(attrs ...field.AssignExpr) ISysConfigLogDo
+	Joins(fields ...field.RelationField) ISysConfigLogDo
+	Preload(fields ...field.RelationField) ISysConfigLogDo
+	FirstOrInit() (*model.SysConfigLog, error)
+	FirstOrCreate() (*model.SysConfigLog, error)
+	FindByPage(offset int, limit int) (result []*model.SysConfigLog, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysConfigLogDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysConfigLogDo) Debug() ISysConfigLogDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysConfigLogDo) WithContext(ctx context.Context) ISysConfigLogDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysConfigLogDo) ReadDB() ISysConfigLogDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysConfigLogDo) WriteDB() ISysConfigLogDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysConfigLogDo) Session(config *gorm.Session) ISysConfigLogDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysConfigLogDo) Clauses(conds ...clause.Expression) ISysConfigLogDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysConfigLogDo) Returning(value interface{}, columns ...string) ISysConfigLogDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysConfigLogDo) Not(conds ...gen.Condition) ISysConfigLogDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysConfigLogDo) Or(conds ...gen.Condition) ISysConfigLogDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysConfigLogDo) Select(conds ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysConfigLogDo) Where(conds ...gen.Condition) ISysConfigLogDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysConfigLogDo) Order(conds ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysConfigLogDo) Distinct(cols ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysConfigLogDo) Omit(cols ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysConfigLogDo) Join(table schema.Tabler, on ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysConfigLogDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysConfigLogDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysConfigLogDo) Group(cols ...field.Expr) ISysConfigLogDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysConfigLogDo) Having(conds ...gen.Condition) ISysConfigLogDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysConfigLogDo) Limit(limit int) ISysConfigLogDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysConfigLogDo) Offset(offset int) ISysConfigLogDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysConfigLogDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysConfigLogDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysConfigLogDo) Unscoped() ISysConfigLogDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysConfigLogDo) Create(values ...*model.SysConfigLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysConfigLogDo) CreateInBatches(values []*model.SysConfigLog, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysConfigLogDo) Save(values ...*model.SysConfigLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysConfigLogDo) First() (*model.SysConfigLog, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysConfigLog), nil
+	}
+}
+
+func (s sysConfigLogDo) Take() (*model.SysConfigLog, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysConfigLog), nil
+	}
+}
+
+func (s sysConfigLogDo) Last() (*model.SysConfigLog, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysConfigLog), nil
+	}
+}
+
+func (s sysConfigLogDo) Find() ([]*model.SysConfigLog, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysConfigLog), err
+}
+
+func (s sysConfigLogDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysConfigLog, err error) {
+	buf := make([]*model.SysConfigLog, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysConfigLogDo) FindInBatches(result *[]*model.SysConfigLog, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysConfigLogDo) Attrs(attrs ...field.AssignExpr) ISysConfigLogDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysConfigLogDo) Assign(attrs ...field.AssignExpr) ISysConfigLogDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysConfigLogDo) Joins(fields ...field.RelationField) ISysConfigLogDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysConfigLogDo) Preload(fields ...field.RelationField) ISysConfigLogDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysConfigLogDo) FirstOrInit() (*model.SysConfigLog, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysConfigLog), nil
+	}
+}
+
+func (s sysConfigLogDo) FirstOrCreate() (*model.SysConfigLog, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysConfigLog), nil
+	}
+}
+
+func (s sysConfigLogDo) FindByPage(offset int, limit int) (result []*model.SysConfigLog, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysConfigLogDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysConfigLogDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysConfigLogDo) Delete(models ...*model.SysConfigLog) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysConfigLogDo) withDO(do gen.Dao) *sysConfigLogDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -31,11 +31,16 @@ func newSysDept(db *gorm.DB, opts ...gen.DOOption) sysDept {
 	_sysDept.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysDept.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysDept.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysDept.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysDept.Version = field.NewInt64(tableName, "version")
 	_sysDept.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysDept.CreatedBy = field.NewInt64(tableName, "created_by")
+	_sysDept.DeptID = field.NewInt64(tableName, "dept_id")
 	_sysDept.ParentID = field.NewInt64(tableName, "parent_id")
 	_sysDept.Name = field.NewString(tableName, "name")
 	_sysDept.Ancestors = field.NewString(tableName, "ancestors")
 	_sysDept.Sort = field.NewInt32(tableName, "sort")
+	_sysDept.DeletedBy = field.NewInt64(tableName, "deleted_by")
 
 	_sysDept.fillFieldMap()
 
@@ -50,11 +55,16 @@ type sysDept struct {
 	CreatedAt field.Time
 	UpdatedAt field.Time
 	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
 	TenantID  field.Int64
+	CreatedBy field.Int64
+	DeptID    field.Int64
 	ParentID  field.Int64
 	Name      field.String
 	Ancestors field.String
 	Sort      field.Int32
+	DeletedBy field.Int64
 
 	fieldMap map[string]field.Expr
 }
@@ -75,11 +85,16 @@ func (s *sysDept) updateTableName(table string) *sysDept {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
 	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+	s.DeptID = field.NewInt64(table, "dept_id")
 	s.ParentID = field.NewInt64(table, "parent_id")
 	s.Name = field.NewString(table, "name")
 	s.Ancestors = field.NewString(table, "ancestors")
 	s.Sort = field.NewInt32(table, "sort")
+	s.DeletedBy = field.NewInt64(table, "deleted_by")
 
 	s.fillFieldMap()
 
@@ -96,16 +111,21 @@ func (s *sysDept) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysDept) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 9)
+	s.fieldMap = make(map[string]field.Expr, 14)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
 	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["created_by"] = s.CreatedBy
+	s.fieldMap["dept_id"] = s.DeptID
 	s.fieldMap["parent_id"] = s.ParentID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["ancestors"] = s.Ancestors
 	s.fieldMap["sort"] = s.Sort
+	s.fieldMap["deleted_by"] = s.DeletedBy
 }
 
 func (s sysDept) clone(db *gorm.DB) sysDept {
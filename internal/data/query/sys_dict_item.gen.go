@@ -0,0 +1,432 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysDictItem(db *gorm.DB, opts ...gen.DOOption) sysDictItem {
+	_sysDictItem := sysDictItem{}
+
+	_sysDictItem.sysDictItemDo.UseDB(db, opts...)
+	_sysDictItem.sysDictItemDo.UseModel(&model.SysDictItem{})
+
+	tableName := _sysDictItem.sysDictItemDo.TableName()
+	_sysDictItem.ALL = field.NewAsterisk(tableName)
+	_sysDictItem.ID = field.NewInt64(tableName, "id")
+	_sysDictItem.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysDictItem.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_sysDictItem.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysDictItem.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysDictItem.Version = field.NewInt64(tableName, "version")
+	_sysDictItem.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysDictItem.TypeCode = field.NewString(tableName, "type_code")
+	_sysDictItem.Label = field.NewString(tableName, "label")
+	_sysDictItem.Value = field.NewString(tableName, "value")
+	_sysDictItem.Sort = field.NewInt32(tableName, "sort")
+	_sysDictItem.Color = field.NewString(tableName, "color")
+	_sysDictItem.Enabled = field.NewBool(tableName, "enabled")
+	_sysDictItem.Remark = field.NewString(tableName, "remark")
+
+	_sysDictItem.fillFieldMap()
+
+	return _sysDictItem
+}
+
+type sysDictItem struct {
+	sysDictItemDo
+
+	ALL       field.Asterisk
+	ID        field.Int64
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
+	TenantID  field.Int64
+	TypeCode  field.String
+	Label     field.String
+	Value     field.String
+	Sort      field.Int32
+	Color     field.String
+	Enabled   field.Bool
+	Remark    field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysDictItem) Table(newTableName string) *sysDictItem {
+	s.sysDictItemDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysDictItem) As(alias string) *sysDictItem {
+	s.sysDictItemDo.DO = *(s.sysDictItemDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysDictItem) updateTableName(table string) *sysDictItem {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.TypeCode = field.NewString(table, "type_code")
+	s.Label = field.NewString(table, "label")
+	s.Value = field.NewString(table, "value")
+	s.Sort = field.NewInt32(table, "sort")
+	s.Color = field.NewString(table, "color")
+	s.Enabled = field.NewBool(table, "enabled")
+	s.Remark = field.NewString(table, "remark")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysDictItem) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysDictItem) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 14)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["type_code"] = s.TypeCode
+	s.fieldMap["label"] = s.Label
+	s.fieldMap["value"] = s.Value
+	s.fieldMap["sort"] = s.Sort
+	s.fieldMap["color"] = s.Color
+	s.fieldMap["enabled"] = s.Enabled
+	s.fieldMap["remark"] = s.Remark
+}
+
+func (s sysDictItem) clone(db *gorm.DB) sysDictItem {
+	s.sysDictItemDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysDictItem) replaceDB(db *gorm.DB) sysDictItem {
+	s.sysDictItemDo.ReplaceDB(db)
+	return s
+}
+
+type sysDictItemDo struct{ gen.DO }
+
+type ISysDictItemDo interface {
+	gen.SubQuery
+	Debug() ISysDictItemDo
+	WithContext(ctx context.Context) ISysDictItemDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysDictItemDo
+	WriteDB() ISysDictItemDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysDictItemDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysDictItemDo
+	Not(conds ...gen.Condition) ISysDictItemDo
+	Or(conds ...gen.Condition) ISysDictItemDo
+	Select(conds ...field.Expr) ISysDictItemDo
+	Where(conds ...gen.Condition) ISysDictItemDo
+	Order(conds ...field.Expr) ISysDictItemDo
+	Distinct(cols ...field.Expr) ISysDictItemDo
+	Omit(cols ...field.Expr) ISysDictItemDo
+	Join(table schema.Tabler, on ...field.Expr) ISysDictItemDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysDictItemDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysDictItemDo
+	Group(cols ...field.Expr) ISysDictItemDo
+	Having(conds ...gen.Condition) ISysDictItemDo
+	Limit(limit int) ISysDictItemDo
+	Offset(offset int) ISysDictItemDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysDictItemDo
+	Unscoped() ISysDictItemDo
+	Create(values ...*model.SysDictItem) error
+	CreateInBatches(values []*model.SysDictItem, batchSize int) error
+	Save(values ...*model.SysDictItem) error
+	First() (*model.SysDictItem, error)
+	Take() (*model.SysDictItem, error)
+	Last() (*model.SysDictItem, error)
+	Find() ([]*model.SysDictItem, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysDictItem, err error)
+	FindInBatches(result *[]*model.SysDictItem, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysDictItem) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysDictItemDo
+	Assign(attrs ...field.AssignExpr) ISysDictItemDo
+	Joins(fields ...field.RelationField) ISysDictItemDo
+	Preload(fields ...field.RelationField) ISysDictItemDo
+	FirstOrInit() (*model.SysDictItem, error)
+	FirstOrCreate() (*model.SysDictItem, error)
+	FindByPage(offset int, limit int) (result []*model.SysDictItem, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysDictItemDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysDictItemDo) Debug() ISysDictItemDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysDictItemDo) WithContext(ctx context.Context) ISysDictItemDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysDictItemDo) ReadDB() ISysDictItemDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysDictItemDo) WriteDB() ISysDictItemDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysDictItemDo) Session(config *gorm.Session) ISysDictItemDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysDictItemDo) Clauses(conds ...clause.Expression) ISysDictItemDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysDictItemDo) Returning(value interface{}, columns ...string) ISysDictItemDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysDictItemDo) Not(conds ...gen.Condition) ISysDictItemDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysDictItemDo) Or(conds ...gen.Condition) ISysDictItemDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysDictItemDo) Select(conds ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysDictItemDo) Where(conds ...gen.Condition) ISysDictItemDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysDictItemDo) Order(conds ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysDictItemDo) Distinct(cols ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysDictItemDo) Omit(cols ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysDictItemDo) Join(table schema.Tabler, on ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysDictItemDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysDictItemDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysDictItemDo) Group(cols ...field.Expr) ISysDictItemDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysDictItemDo) Having(conds ...gen.Condition) ISysDictItemDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysDictItemDo) Limit(limit int) ISysDictItemDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysDictItemDo) Offset(offset int) ISysDictItemDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysDictItemDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysDictItemDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysDictItemDo) Unscoped() ISysDictItemDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysDictItemDo) Create(values ...*model.SysDictItem) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysDictItemDo) CreateInBatches(values []*model.SysDictItem, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysDictItemDo) Save(values ...*model.SysDictItem) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysDictItemDo) First() (*model.SysDictItem, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysDictItem), nil
+	}
+}
+
+func (s sysDictItemDo) Take() (*model.SysDictItem, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysDictItem), nil
+	}
+}
+
+func (s sysDictItemDo) Last() (*model.SysDictItem, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysDictItem), nil
+	}
+}
+
+func (s sysDictItemDo) Find() ([]*model.SysDictItem, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysDictItem), err
+}
+
+func (s sysDictItemDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysDictItem, err error) {
+	buf := make([]*model.SysDictItem, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysDictItemDo) FindInBatches(result *[]*model.SysDictItem, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysDictItemDo) Attrs(attrs ...field.AssignExpr) ISysDictItemDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysDictItemDo) Assign(attrs ...field.AssignExpr) ISysDictItemDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysDictItemDo) Joins(fields ...field.RelationField) ISysDictItemDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysDictItemDo) Preload(fields ...field.RelationField) ISysDictItemDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysDictItemDo) FirstOrInit() (*model.SysDictItem, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysDictItem), nil
+	}
+}
+
+func (s sysDictItemDo) FirstOrCreate() (*model.SysDictItem, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysDictItem), nil
+	}
+}
+
+func (s sysDictItemDo) FindByPage(offset int, limit int) (result []*model.SysDictItem, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysDictItemDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysDictItemDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysDictItemDo) Delete(models ...*model.SysDictItem) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysDictItemDo) withDO(do gen.Dao) *sysDictItemDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,416 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysNoticeInbox(db *gorm.DB, opts ...gen.DOOption) sysNoticeInbox {
+	_sysNoticeInbox := sysNoticeInbox{}
+
+	_sysNoticeInbox.sysNoticeInboxDo.UseDB(db, opts...)
+	_sysNoticeInbox.sysNoticeInboxDo.UseModel(&model.SysNoticeInbox{})
+
+	tableName := _sysNoticeInbox.sysNoticeInboxDo.TableName()
+	_sysNoticeInbox.ALL = field.NewAsterisk(tableName)
+	_sysNoticeInbox.ID = field.NewInt64(tableName, "id")
+	_sysNoticeInbox.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysNoticeInbox.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_sysNoticeInbox.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysNoticeInbox.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysNoticeInbox.Version = field.NewInt64(tableName, "version")
+	_sysNoticeInbox.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysNoticeInbox.UserID = field.NewInt64(tableName, "user_id")
+	_sysNoticeInbox.NoticeID = field.NewInt64(tableName, "notice_id")
+	_sysNoticeInbox.ReadAt = field.NewTime(tableName, "read_at")
+
+	_sysNoticeInbox.fillFieldMap()
+
+	return _sysNoticeInbox
+}
+
+type sysNoticeInbox struct {
+	sysNoticeInboxDo
+
+	ALL       field.Asterisk
+	ID        field.Int64
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
+	TenantID  field.Int64
+	UserID    field.Int64
+	NoticeID  field.Int64
+	ReadAt    field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysNoticeInbox) Table(newTableName string) *sysNoticeInbox {
+	s.sysNoticeInboxDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysNoticeInbox) As(alias string) *sysNoticeInbox {
+	s.sysNoticeInboxDo.DO = *(s.sysNoticeInboxDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysNoticeInbox) updateTableName(table string) *sysNoticeInbox {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.UserID = field.NewInt64(table, "user_id")
+	s.NoticeID = field.NewInt64(table, "notice_id")
+	s.ReadAt = field.NewTime(table, "read_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysNoticeInbox) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysNoticeInbox) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["user_id"] = s.UserID
+	s.fieldMap["notice_id"] = s.NoticeID
+	s.fieldMap["read_at"] = s.ReadAt
+}
+
+func (s sysNoticeInbox) clone(db *gorm.DB) sysNoticeInbox {
+	s.sysNoticeInboxDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysNoticeInbox) replaceDB(db *gorm.DB) sysNoticeInbox {
+	s.sysNoticeInboxDo.ReplaceDB(db)
+	return s
+}
+
+type sysNoticeInboxDo struct{ gen.DO }
+
+type ISysNoticeInboxDo interface {
+	gen.SubQuery
+	Debug() ISysNoticeInboxDo
+	WithContext(ctx context.Context) ISysNoticeInboxDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysNoticeInboxDo
+	WriteDB() ISysNoticeInboxDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysNoticeInboxDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysNoticeInboxDo
+	Not(conds ...gen.Condition) ISysNoticeInboxDo
+	Or(conds ...gen.Condition) ISysNoticeInboxDo
+	Select(conds ...field.Expr) ISysNoticeInboxDo
+	Where(conds ...gen.Condition) ISysNoticeInboxDo
+	Order(conds ...field.Expr) ISysNoticeInboxDo
+	Distinct(cols ...field.Expr) ISysNoticeInboxDo
+	Omit(cols ...field.Expr) ISysNoticeInboxDo
+	Join(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo
+	Group(cols ...field.Expr) ISysNoticeInboxDo
+	Having(conds ...gen.Condition) ISysNoticeInboxDo
+	Limit(limit int) ISysNoticeInboxDo
+	Offset(offset int) ISysNoticeInboxDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysNoticeInboxDo
+	Unscoped() ISysNoticeInboxDo
+	Create(values ...*model.SysNoticeInbox) error
+	CreateInBatches(values []*model.SysNoticeInbox, batchSize int) error
+	Save(values ...*model.SysNoticeInbox) error
+	First() (*model.SysNoticeInbox, error)
+	Take() (*model.SysNoticeInbox, error)
+	Last() (*model.SysNoticeInbox, error)
+	Find() ([]*model.SysNoticeInbox, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysNoticeInbox, err error)
+	FindInBatches(result *[]*model.SysNoticeInbox, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysNoticeInbox) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysNoticeInboxDo
+	Assign(attrs ...field.AssignExpr) ISysNoticeInboxDo
+	Joins(fields ...field.RelationField) ISysNoticeInboxDo
+	Preload(fields ...field.RelationField) ISysNoticeInboxDo
+	FirstOrInit() (*model.SysNoticeInbox, error)
+	FirstOrCreate() (*model.SysNoticeInbox, error)
+	FindByPage(offset int, limit int) (result []*model.SysNoticeInbox, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysNoticeInboxDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysNoticeInboxDo) Debug() ISysNoticeInboxDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysNoticeInboxDo) WithContext(ctx context.Context) ISysNoticeInboxDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysNoticeInboxDo) ReadDB() ISysNoticeInboxDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysNoticeInboxDo) WriteDB() ISysNoticeInboxDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysNoticeInboxDo) Session(config *gorm.Session) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysNoticeInboxDo) Clauses(conds ...clause.Expression) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysNoticeInboxDo) Returning(value interface{}, columns ...string) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysNoticeInboxDo) Not(conds ...gen.Condition) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysNoticeInboxDo) Or(conds ...gen.Condition) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysNoticeInboxDo) Select(conds ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysNoticeInboxDo) Where(conds ...gen.Condition) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysNoticeInboxDo) Order(conds ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysNoticeInboxDo) Distinct(cols ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysNoticeInboxDo) Omit(cols ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysNoticeInboxDo) Join(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysNoticeInboxDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysNoticeInboxDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysNoticeInboxDo) Group(cols ...field.Expr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysNoticeInboxDo) Having(conds ...gen.Condition) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysNoticeInboxDo) Limit(limit int) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysNoticeInboxDo) Offset(offset int) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysNoticeInboxDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysNoticeInboxDo) Unscoped() ISysNoticeInboxDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysNoticeInboxDo) Create(values ...*model.SysNoticeInbox) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysNoticeInboxDo) CreateInBatches(values []*model.SysNoticeInbox, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysNoticeInboxDo) Save(values ...*model.SysNoticeInbox) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysNoticeInboxDo) First() (*model.SysNoticeInbox, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysNoticeInbox), nil
+	}
+}
+
+func (s sysNoticeInboxDo) Take() (*model.SysNoticeInbox, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysNoticeInbox), nil
+	}
+}
+
+func (s sysNoticeInboxDo) Last() (*model.SysNoticeInbox, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysNoticeInbox), nil
+	}
+}
+
+func (s sysNoticeInboxDo) Find() ([]*model.SysNoticeInbox, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysNoticeInbox), err
+}
+
+func (s sysNoticeInboxDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysNoticeInbox, err error) {
+	buf := make([]*model.SysNoticeInbox, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysNoticeInboxDo) FindInBatches(result *[]*model.SysNoticeInbox, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysNoticeInboxDo) Attrs(attrs ...field.AssignExpr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysNoticeInboxDo) Assign(attrs ...field.AssignExpr) ISysNoticeInboxDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysNoticeInboxDo) Joins(fields ...field.RelationField) ISysNoticeInboxDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysNoticeInboxDo) Preload(fields ...field.RelationField) ISysNoticeInboxDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysNoticeInboxDo) FirstOrInit() (*model.SysNoticeInbox, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysNoticeInbox), nil
+	}
+}
+
+func (s sysNoticeInboxDo) FirstOrCreate() (*model.SysNoticeInbox, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysNoticeInbox), nil
+	}
+}
+
+func (s sysNoticeInboxDo) FindByPage(offset int, limit int) (result []*model.SysNoticeInbox, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysNoticeInboxDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysNoticeInboxDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysNoticeInboxDo) Delete(models ...*model.SysNoticeInbox) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysNoticeInboxDo) withDO(do gen.Dao) *sysNoticeInboxDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,472 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysOperLog(db *gorm.DB, opts ...gen.DOOption) sysOperLog {
+	_sysOperLog := sysOperLog{}
+
+	_sysOperLog.sysOperLogDo.UseDB(db, opts...)
+	_sysOperLog.sysOperLogDo.UseModel(&model.SysOperLog{})
+
+	tableName := _sysOperLog.sysOperLogDo.TableName()
+	_sysOperLog.ALL = field.NewAsterisk(tableName)
+	_sysOperLog.ID = field.NewInt64(tableName, "id")
+	_sysOperLog.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysOperLog.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_sysOperLog.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysOperLog.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysOperLog.Version = field.NewInt64(tableName, "version")
+	_sysOperLog.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysOperLog.CreatedBy = field.NewInt64(tableName, "created_by")
+	_sysOperLog.DeptID = field.NewInt64(tableName, "dept_id")
+	_sysOperLog.IP = field.NewString(tableName, "ip")
+	_sysOperLog.Operation = field.NewString(tableName, "operation")
+	_sysOperLog.Name = field.NewString(tableName, "name")
+	_sysOperLog.PermCode = field.NewString(tableName, "perm_code")
+	_sysOperLog.Method = field.NewString(tableName, "method")
+	_sysOperLog.Path = field.NewString(tableName, "path")
+	_sysOperLog.Request = field.NewString(tableName, "request")
+	_sysOperLog.Code = field.NewInt32(tableName, "code")
+	_sysOperLog.Reason = field.NewString(tableName, "reason")
+	_sysOperLog.Latency = field.NewInt64(tableName, "latency")
+	_sysOperLog.Diff = field.NewString(tableName, "diff")
+	_sysOperLog.Node = field.NewString(tableName, "node")
+	_sysOperLog.Seq = field.NewInt64(tableName, "seq")
+	_sysOperLog.PrevHash = field.NewString(tableName, "prev_hash")
+	_sysOperLog.Hash = field.NewString(tableName, "hash")
+
+	_sysOperLog.fillFieldMap()
+
+	return _sysOperLog
+}
+
+type sysOperLog struct {
+	sysOperLogDo
+
+	ALL       field.Asterisk
+	ID        field.Int64
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
+	TenantID  field.Int64
+	CreatedBy field.Int64
+	DeptID    field.Int64
+	IP        field.String
+	Operation field.String
+	Name      field.String
+	PermCode  field.String
+	Method    field.String
+	Path      field.String
+	Request   field.String
+	Code      field.Int32
+	Reason    field.String
+	Latency   field.Int64
+	Diff      field.String
+	Node      field.String
+	Seq       field.Int64
+	PrevHash  field.String
+	Hash      field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysOperLog) Table(newTableName string) *sysOperLog {
+	s.sysOperLogDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysOperLog) As(alias string) *sysOperLog {
+	s.sysOperLogDo.DO = *(s.sysOperLogDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysOperLog) updateTableName(table string) *sysOperLog {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+	s.DeptID = field.NewInt64(table, "dept_id")
+	s.IP = field.NewString(table, "ip")
+	s.Operation = field.NewString(table, "operation")
+	s.Name = field.NewString(table, "name")
+	s.PermCode = field.NewString(table, "perm_code")
+	s.Method = field.NewString(table, "method")
+	s.Path = field.NewString(table, "path")
+	s.Request = field.NewString(table, "request")
+	s.Code = field.NewInt32(table, "code")
+	s.Reason = field.NewString(table, "reason")
+	s.Latency = field.NewInt64(table, "latency")
+	s.Diff = field.NewString(table, "diff")
+	s.Node = field.NewString(table, "node")
+	s.Seq = field.NewInt64(table, "seq")
+	s.PrevHash = field.NewString(table, "prev_hash")
+	s.Hash = field.NewString(table, "hash")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysOperLog) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysOperLog) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 24)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["created_by"] = s.CreatedBy
+	s.fieldMap["dept_id"] = s.DeptID
+	s.fieldMap["ip"] = s.IP
+	s.fieldMap["operation"] = s.Operation
+	s.fieldMap["name"] = s.Name
+	s.fieldMap["perm_code"] = s.PermCode
+	s.fieldMap["method"] = s.Method
+	s.fieldMap["path"] = s.Path
+	s.fieldMap["request"] = s.Request
+	s.fieldMap["code"] = s.Code
+	s.fieldMap["reason"] = s.Reason
+	s.fieldMap["latency"] = s.Latency
+	s.fieldMap["diff"] = s.Diff
+	s.fieldMap["node"] = s.Node
+	s.fieldMap["seq"] = s.Seq
+	s.fieldMap["prev_hash"] = s.PrevHash
+	s.fieldMap["hash"] = s.Hash
+}
+
+func (s sysOperLog) clone(db *gorm.DB) sysOperLog {
+	s.sysOperLogDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysOperLog) replaceDB(db *gorm.DB) sysOperLog {
+	s.sysOperLogDo.ReplaceDB(db)
+	return s
+}
+
+type sysOperLogDo struct{ gen.DO }
+
+type ISysOperLogDo interface {
+	gen.SubQuery
+	Debug() ISysOperLogDo
+	WithContext(ctx context.Context) ISysOperLogDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysOperLogDo
+	WriteDB() ISysOperLogDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysOperLogDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysOperLogDo
+	Not(conds ...gen.Condition) ISysOperLogDo
+	Or(conds ...gen.Condition) ISysOperLogDo
+	Select(conds ...field.Expr) ISysOperLogDo
+	Where(conds ...gen.Condition) ISysOperLogDo
+	Order(conds ...field.Expr) ISysOperLogDo
+	Distinct(cols ...field.Expr) ISysOperLogDo
+	Omit(cols ...field.Expr) ISysOperLogDo
+	Join(table schema.Tabler, on ...field.Expr) ISysOperLogDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysOperLogDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysOperLogDo
+	Group(cols ...field.Expr) ISysOperLogDo
+	Having(conds ...gen.Condition) ISysOperLogDo
+	Limit(limit int) ISysOperLogDo
+	Offset(offset int) ISysOperLogDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysOperLogDo
+	Unscoped() ISysOperLogDo
+	Create(values ...*model.SysOperLog) error
+	CreateInBatches(values []*model.SysOperLog, batchSize int) error
+	Save(values ...*model.SysOperLog) error
+	First() (*model.SysOperLog, error)
+	Take() (*model.SysOperLog, error)
+	Last() (*model.SysOperLog, error)
+	Find() ([]*model.SysOperLog, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysOperLog, err error)
+	FindInBatches(result *[]*model.SysOperLog, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysOperLog) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysOperLogDo
+	Assign(attrs ...field.AssignExpr) ISysOperLogDo
+	Joins(fields ...field.RelationField) ISysOperLogDo
+	Preload(fields ...field.RelationField) ISysOperLogDo
+	FirstOrInit() (*model.SysOperLog, error)
+	FirstOrCreate() (*model.SysOperLog, error)
+	FindByPage(offset int, limit int) (result []*model.SysOperLog, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysOperLogDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysOperLogDo) Debug() ISysOperLogDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysOperLogDo) WithContext(ctx context.Context) ISysOperLogDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysOperLogDo) ReadDB() ISysOperLogDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysOperLogDo) WriteDB() ISysOperLogDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysOperLogDo) Session(config *gorm.Session) ISysOperLogDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysOperLogDo) Clauses(conds ...clause.Expression) ISysOperLogDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysOperLogDo) Returning(value interface{}, columns ...string) ISysOperLogDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysOperLogDo) Not(conds ...gen.Condition) ISysOperLogDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysOperLogDo) Or(conds ...gen.Condition) ISysOperLogDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysOperLogDo) Select(conds ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysOperLogDo) Where(conds ...gen.Condition) ISysOperLogDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysOperLogDo) Order(conds ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysOperLogDo) Distinct(cols ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysOperLogDo) Omit(cols ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysOperLogDo) Join(table schema.Tabler, on ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysOperLogDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysOperLogDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysOperLogDo) Group(cols ...field.Expr) ISysOperLogDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysOperLogDo) Having(conds ...gen.Condition) ISysOperLogDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysOperLogDo) Limit(limit int) ISysOperLogDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysOperLogDo) Offset(offset int) ISysOperLogDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysOperLogDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysOperLogDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysOperLogDo) Unscoped() ISysOperLogDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysOperLogDo) Create(values ...*model.SysOperLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysOperLogDo) CreateInBatches(values []*model.SysOperLog, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysOperLogDo) Save(values ...*model.SysOperLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysOperLogDo) First() (*model.SysOperLog, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLog), nil
+	}
+}
+
+func (s sysOperLogDo) Take() (*model.SysOperLog, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLog), nil
+	}
+}
+
+func (s sysOperLogDo) Last() (*model.SysOperLog, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLog), nil
+	}
+}
+
+func (s sysOperLogDo) Find() ([]*model.SysOperLog, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysOperLog), err
+}
+
+func (s sysOperLogDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysOperLog, err error) {
+	buf := make([]*model.SysOperLog, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysOperLogDo) FindInBatches(result *[]*model.SysOperLog, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysOperLogDo) Attrs(attrs ...field.AssignExpr) ISysOperLogDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysOperLogDo) Assign(attrs ...field.AssignExpr) ISysOperLogDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysOperLogDo) Joins(fields ...field.RelationField) ISysOperLogDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysOperLogDo) Preload(fields ...field.RelationField) ISysOperLogDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysOperLogDo) FirstOrInit() (*model.SysOperLog, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLog), nil
+	}
+}
+
+func (s sysOperLogDo) FirstOrCreate() (*model.SysOperLog, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLog), nil
+	}
+}
+
+func (s sysOperLogDo) FindByPage(offset int, limit int) (result []*model.SysOperLog, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysOperLogDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysOperLogDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysOperLogDo) Delete(models ...*model.SysOperLog) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysOperLogDo) withDO(do gen.Dao) *sysOperLogDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,400 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysOperLogChain(db *gorm.DB, opts ...gen.DOOption) sysOperLogChain {
+	_sysOperLogChain := sysOperLogChain{}
+
+	_sysOperLogChain.sysOperLogChainDo.UseDB(db, opts...)
+	_sysOperLogChain.sysOperLogChainDo.UseModel(&model.SysOperLogChain{})
+
+	tableName := _sysOperLogChain.sysOperLogChainDo.TableName()
+	_sysOperLogChain.ALL = field.NewAsterisk(tableName)
+	_sysOperLogChain.Node = field.NewString(tableName, "node")
+	_sysOperLogChain.LastSeq = field.NewInt64(tableName, "last_seq")
+	_sysOperLogChain.PrunedSeq = field.NewInt64(tableName, "pruned_seq")
+	_sysOperLogChain.PrunedHash = field.NewString(tableName, "pruned_hash")
+	_sysOperLogChain.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysOperLogChain.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_sysOperLogChain.fillFieldMap()
+
+	return _sysOperLogChain
+}
+
+type sysOperLogChain struct {
+	sysOperLogChainDo
+
+	ALL        field.Asterisk
+	Node       field.String
+	LastSeq    field.Int64
+	PrunedSeq  field.Int64
+	PrunedHash field.String
+	CreatedAt  field.Time
+	UpdatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysOperLogChain) Table(newTableName string) *sysOperLogChain {
+	s.sysOperLogChainDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysOperLogChain) As(alias string) *sysOperLogChain {
+	s.sysOperLogChainDo.DO = *(s.sysOperLogChainDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysOperLogChain) updateTableName(table string) *sysOperLogChain {
+	s.ALL = field.NewAsterisk(table)
+	s.Node = field.NewString(table, "node")
+	s.LastSeq = field.NewInt64(table, "last_seq")
+	s.PrunedSeq = field.NewInt64(table, "pruned_seq")
+	s.PrunedHash = field.NewString(table, "pruned_hash")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysOperLogChain) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysOperLogChain) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 6)
+	s.fieldMap["node"] = s.Node
+	s.fieldMap["last_seq"] = s.LastSeq
+	s.fieldMap["pruned_seq"] = s.PrunedSeq
+	s.fieldMap["pruned_hash"] = s.PrunedHash
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+}
+
+func (s sysOperLogChain) clone(db *gorm.DB) sysOperLogChain {
+	s.sysOperLogChainDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysOperLogChain) replaceDB(db *gorm.DB) sysOperLogChain {
+	s.sysOperLogChainDo.ReplaceDB(db)
+	return s
+}
+
+type sysOperLogChainDo struct{ gen.DO }
+
+type ISysOperLogChainDo interface {
+	gen.SubQuery
+	Debug() ISysOperLogChainDo
+	WithContext(ctx context.Context) ISysOperLogChainDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysOperLogChainDo
+	WriteDB() ISysOperLogChainDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysOperLogChainDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysOperLogChainDo
+	Not(conds ...gen.Condition) ISysOperLogChainDo
+	Or(conds ...gen.Condition) ISysOperLogChainDo
+	Select(conds ...field.Expr) ISysOperLogChainDo
+	Where(conds ...gen.Condition) ISysOperLogChainDo
+	Order(conds ...field.Expr) ISysOperLogChainDo
+	Distinct(cols ...field.Expr) ISysOperLogChainDo
+	Omit(cols ...field.Expr) ISysOperLogChainDo
+	Join(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo
+	Group(cols ...field.Expr) ISysOperLogChainDo
+	Having(conds ...gen.Condition) ISysOperLogChainDo
+	Limit(limit int) ISysOperLogChainDo
+	Offset(offset int) ISysOperLogChainDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysOperLogChainDo
+	Unscoped() ISysOperLogChainDo
+	Create(values ...*model.SysOperLogChain) error
+	CreateInBatches(values []*model.SysOperLogChain, batchSize int) error
+	Save(values ...*model.SysOperLogChain) error
+	First() (*model.SysOperLogChain, error)
+	Take() (*model.SysOperLogChain, error)
+	Last() (*model.SysOperLogChain, error)
+	Find() ([]*model.SysOperLogChain, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysOperLogChain, err error)
+	FindInBatches(result *[]*model.SysOperLogChain, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysOperLogChain) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysOperLogChainDo
+	Assign(attrs ...field.AssignExpr) ISysOperLogChainDo
+	Joins(fields ...field.RelationField) ISysOperLogChainDo
+	Preload(fields ...field.RelationField) ISysOperLogChainDo
+	FirstOrInit() (*model.SysOperLogChain, error)
+	FirstOrCreate() (*model.SysOperLogChain, error)
+	FindByPage(offset int, limit int) (result []*model.SysOperLogChain, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysOperLogChainDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysOperLogChainDo) Debug() ISysOperLogChainDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysOperLogChainDo) WithContext(ctx context.Context) ISysOperLogChainDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysOperLogChainDo) ReadDB() ISysOperLogChainDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysOperLogChainDo) WriteDB() ISysOperLogChainDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysOperLogChainDo) Session(config *gorm.Session) ISysOperLogChainDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysOperLogChainDo) Clauses(conds ...clause.Expression) ISysOperLogChainDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysOperLogChainDo) Returning(value interface{}, columns ...string) ISysOperLogChainDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysOperLogChainDo) Not(conds ...gen.Condition) ISysOperLogChainDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysOperLogChainDo) Or(conds ...gen.Condition) ISysOperLogChainDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysOperLogChainDo) Select(conds ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysOperLogChainDo) Where(conds ...gen.Condition) ISysOperLogChainDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysOperLogChainDo) Order(conds ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysOperLogChainDo) Distinct(cols ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysOperLogChainDo) Omit(cols ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysOperLogChainDo) Join(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysOperLogChainDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysOperLogChainDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysOperLogChainDo) Group(cols ...field.Expr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysOperLogChainDo) Having(conds ...gen.Condition) ISysOperLogChainDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysOperLogChainDo) Limit(limit int) ISysOperLogChainDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysOperLogChainDo) Offset(offset int) ISysOperLogChainDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysOperLogChainDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysOperLogChainDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysOperLogChainDo) Unscoped() ISysOperLogChainDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysOperLogChainDo) Create(values ...*model.SysOperLogChain) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysOperLogChainDo) CreateInBatches(values []*model.SysOperLogChain, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysOperLogChainDo) Save(values ...*model.SysOperLogChain) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysOperLogChainDo) First() (*model.SysOperLogChain, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLogChain), nil
+	}
+}
+
+func (s sysOperLogChainDo) Take() (*model.SysOperLogChain, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLogChain), nil
+	}
+}
+
+func (s sysOperLogChainDo) Last() (*model.SysOperLogChain, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLogChain), nil
+	}
+}
+
+func (s sysOperLogChainDo) Find() ([]*model.SysOperLogChain, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysOperLogChain), err
+}
+
+func (s sysOperLogChainDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysOperLogChain, err error) {
+	buf := make([]*model.SysOperLogChain, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysOperLogChainDo) FindInBatches(result *[]*model.SysOperLogChain, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysOperLogChainDo) Attrs(attrs ...field.AssignExpr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysOperLogChainDo) Assign(attrs ...field.AssignExpr) ISysOperLogChainDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysOperLogChainDo) Joins(fields ...field.RelationField) ISysOperLogChainDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysOperLogChainDo) Preload(fields ...field.RelationField) ISysOperLogChainDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysOperLogChainDo) FirstOrInit() (*model.SysOperLogChain, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLogChain), nil
+	}
+}
+
+func (s sysOperLogChainDo) FirstOrCreate() (*model.SysOperLogChain, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysOperLogChain), nil
+	}
+}
+
+func (s sysOperLogChainDo) FindByPage(offset int, limit int) (result []*model.SysOperLogChain, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysOperLogChainDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysOperLogChainDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysOperLogChainDo) Delete(models ...*model.SysOperLogChain) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysOperLogChainDo) withDO(do gen.Dao) *sysOperLogChainDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
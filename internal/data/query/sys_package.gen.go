@@ -31,6 +31,8 @@ func newSysPackage(db *gorm.DB, opts ...gen.DOOption) sysPackage {
 	_sysPackage.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysPackage.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysPackage.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysPackage.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysPackage.Version = field.NewInt64(tableName, "version")
 	_sysPackage.Name = field.NewString(tableName, "name")
 	_sysPackage.Status = field.NewInt16(tableName, "status")
 	_sysPackage.Remark = field.NewString(tableName, "remark")
@@ -48,6 +50,8 @@ type sysPackage struct {
 	CreatedAt field.Time
 	UpdatedAt field.Time
 	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
 	Name      field.String
 	Status    field.Int16
 	Remark    field.String
@@ -71,6 +75,8 @@ func (s *sysPackage) updateTableName(table string) *sysPackage {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
 	s.Name = field.NewString(table, "name")
 	s.Status = field.NewInt16(table, "status")
 	s.Remark = field.NewString(table, "remark")
@@ -90,11 +96,13 @@ func (s *sysPackage) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysPackage) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 7)
+	s.fieldMap = make(map[string]field.Expr, 9)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["status"] = s.Status
 	s.fieldMap["remark"] = s.Remark
@@ -31,6 +31,8 @@ func newSysPermission(db *gorm.DB, opts ...gen.DOOption) sysPermission {
 	_sysPermission.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysPermission.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysPermission.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysPermission.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysPermission.Version = field.NewInt64(tableName, "version")
 	_sysPermission.ParentID = field.NewInt64(tableName, "parent_id")
 	_sysPermission.Name = field.NewString(tableName, "name")
 	_sysPermission.Code = field.NewString(tableName, "code")
@@ -38,6 +40,7 @@ func newSysPermission(db *gorm.DB, opts ...gen.DOOption) sysPermission {
 	_sysPermission.APIPath = field.NewString(tableName, "api_path")
 	_sysPermission.APIMethod = field.NewString(tableName, "api_method")
 	_sysPermission.Sort = field.NewInt32(tableName, "sort")
+	_sysPermission.DeletedBy = field.NewInt64(tableName, "deleted_by")
 
 	_sysPermission.fillFieldMap()
 
@@ -52,6 +55,8 @@ type sysPermission struct {
 	CreatedAt field.Time
 	UpdatedAt field.Time
 	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
 	ParentID  field.Int64
 	Name      field.String
 	Code      field.String
@@ -59,6 +64,7 @@ type sysPermission struct {
 	APIPath   field.String
 	APIMethod field.String
 	Sort      field.Int32
+	DeletedBy field.Int64
 
 	fieldMap map[string]field.Expr
 }
@@ -79,6 +85,8 @@ func (s *sysPermission) updateTableName(table string) *sysPermission {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
 	s.ParentID = field.NewInt64(table, "parent_id")
 	s.Name = field.NewString(table, "name")
 	s.Code = field.NewString(table, "code")
@@ -86,6 +94,7 @@ func (s *sysPermission) updateTableName(table string) *sysPermission {
 	s.APIPath = field.NewString(table, "api_path")
 	s.APIMethod = field.NewString(table, "api_method")
 	s.Sort = field.NewInt32(table, "sort")
+	s.DeletedBy = field.NewInt64(table, "deleted_by")
 
 	s.fillFieldMap()
 
@@ -102,11 +111,13 @@ func (s *sysPermission) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (s *sysPermission) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 11)
+	s.fieldMap = make(map[string]field.Expr, 14)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
 	s.fieldMap["parent_id"] = s.ParentID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["code"] = s.Code
@@ -114,6 +125,7 @@ func (s *sysPermission) fillFieldMap() {
 	s.fieldMap["api_path"] = s.APIPath
 	s.fieldMap["api_method"] = s.APIMethod
 	s.fieldMap["sort"] = s.Sort
+	s.fieldMap["deleted_by"] = s.DeletedBy
 }
 
 func (s sysPermission) clone(db *gorm.DB) sysPermission {
@@ -31,9 +31,14 @@ func newSysRole(db *gorm.DB, opts ...gen.DOOption) sysRole {
 	_sysRole.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysRole.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysRole.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysRole.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysRole.Version = field.NewInt64(tableName, "version")
 	_sysRole.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysRole.CreatedBy = field.NewInt64(tableName, "created_by")
+	_sysRole.DeptID = field.NewInt64(tableName, "dept_id")
 	_sysRole.Name = field.NewString(tableName, "name")
 	_sysRole.Code = field.NewString(tableName, "code")
+	_sysRole.DeletedBy = field.NewInt64(tableName, "deleted_by")
 
 	_sysRole.fillFieldMap()
 
@@ -48,9 +53,14 @@ type sysRole struct {
 	CreatedAt field.Time
 	UpdatedAt field.Time
 	DeletedAt field.Field
+	UpdatedBy field.Int64
+	Version   field.Int64
 	TenantID  field.Int64
+	CreatedBy field.Int64
+	DeptID    field.Int64
 	Name      field.String
 	Code      field.String
+	DeletedBy field.Int64
 
 	fieldMap map[string]field.Expr
 }
@@ -71,9 +81,14 @@ func (s *sysRole) updateTableName(table string) *sysRole {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
 	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+	s.DeptID = field.NewInt64(table, "dept_id")
 	s.Name = field.NewString(table, "name")
 	s.Code = field.NewString(table, "code")
+	s.DeletedBy = field.NewInt64(table, "deleted_by")
 
 	s.fillFieldMap()
 
@@ -90,14 +105,19 @@ func (s *sysRole) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysRole) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 7)
+	s.fieldMap = make(map[string]field.Expr, 12)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
 	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["created_by"] = s.CreatedBy
+	s.fieldMap["dept_id"] = s.DeptID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["code"] = s.Code
+	s.fieldMap["deleted_by"] = s.DeletedBy
 }
 
 func (s sysRole) clone(db *gorm.DB) sysRole {
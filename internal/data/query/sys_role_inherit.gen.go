@@ -0,0 +1,396 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysRoleInherit(db *gorm.DB, opts ...gen.DOOption) sysRoleInherit {
+	_sysRoleInherit := sysRoleInherit{}
+
+	_sysRoleInherit.sysRoleInheritDo.UseDB(db, opts...)
+	_sysRoleInherit.sysRoleInheritDo.UseModel(&model.SysRoleInherit{})
+
+	tableName := _sysRoleInherit.sysRoleInheritDo.TableName()
+	_sysRoleInherit.ALL = field.NewAsterisk(tableName)
+	_sysRoleInherit.ID = field.NewInt64(tableName, "id")
+	_sysRoleInherit.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysRoleInherit.RoleID = field.NewInt64(tableName, "role_id")
+	_sysRoleInherit.ParentID = field.NewInt64(tableName, "parent_id")
+	_sysRoleInherit.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_sysRoleInherit.fillFieldMap()
+
+	return _sysRoleInherit
+}
+
+type sysRoleInherit struct {
+	sysRoleInheritDo
+
+	ALL       field.Asterisk
+	ID        field.Int64
+	TenantID  field.Int64
+	RoleID    field.Int64
+	ParentID  field.Int64
+	CreatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysRoleInherit) Table(newTableName string) *sysRoleInherit {
+	s.sysRoleInheritDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysRoleInherit) As(alias string) *sysRoleInherit {
+	s.sysRoleInheritDo.DO = *(s.sysRoleInheritDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysRoleInherit) updateTableName(table string) *sysRoleInherit {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.RoleID = field.NewInt64(table, "role_id")
+	s.ParentID = field.NewInt64(table, "parent_id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysRoleInherit) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysRoleInherit) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 5)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["role_id"] = s.RoleID
+	s.fieldMap["parent_id"] = s.ParentID
+	s.fieldMap["created_at"] = s.CreatedAt
+}
+
+func (s sysRoleInherit) clone(db *gorm.DB) sysRoleInherit {
+	s.sysRoleInheritDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysRoleInherit) replaceDB(db *gorm.DB) sysRoleInherit {
+	s.sysRoleInheritDo.ReplaceDB(db)
+	return s
+}
+
+type sysRoleInheritDo struct{ gen.DO }
+
+type ISysRoleInheritDo interface {
+	gen.SubQuery
+	Debug() ISysRoleInheritDo
+	WithContext(ctx context.Context) ISysRoleInheritDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysRoleInheritDo
+	WriteDB() ISysRoleInheritDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysRoleInheritDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysRoleInheritDo
+	Not(conds ...gen.Condition) ISysRoleInheritDo
+	Or(conds ...gen.Condition) ISysRoleInheritDo
+	Select(conds ...field.Expr) ISysRoleInheritDo
+	Where(conds ...gen.Condition) ISysRoleInheritDo
+	Order(conds ...field.Expr) ISysRoleInheritDo
+	Distinct(cols ...field.Expr) ISysRoleInheritDo
+	Omit(cols ...field.Expr) ISysRoleInheritDo
+	Join(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo
+	Group(cols ...field.Expr) ISysRoleInheritDo
+	Having(conds ...gen.Condition) ISysRoleInheritDo
+	Limit(limit int) ISysRoleInheritDo
+	Offset(offset int) ISysRoleInheritDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysRoleInheritDo
+	Unscoped() ISysRoleInheritDo
+	Create(values ...*model.SysRoleInherit) error
+	CreateInBatches(values []*model.SysRoleInherit, batchSize int) error
+	Save(values ...*model.SysRoleInherit) error
+	First() (*model.SysRoleInherit, error)
+	Take() (*model.SysRoleInherit, error)
+	Last() (*model.SysRoleInherit, error)
+	Find() ([]*model.SysRoleInherit, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysRoleInherit, err error)
+	FindInBatches(result *[]*model.SysRoleInherit, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysRoleInherit) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysRoleInheritDo
+	Assign(attrs ...field.AssignExpr) ISysRoleInheritDo
+	Joins(fields ...field.RelationField) ISysRoleInheritDo
+	Preload(fields ...field.RelationField) ISysRoleInheritDo
+	FirstOrInit() (*model.SysRoleInherit, error)
+	FirstOrCreate() (*model.SysRoleInherit, error)
+	FindByPage(offset int, limit int) (result []*model.SysRoleInherit, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysRoleInheritDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysRoleInheritDo) Debug() ISysRoleInheritDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysRoleInheritDo) WithContext(ctx context.Context) ISysRoleInheritDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysRoleInheritDo) ReadDB() ISysRoleInheritDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysRoleInheritDo) WriteDB() ISysRoleInheritDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysRoleInheritDo) Session(config *gorm.Session) ISysRoleInheritDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysRoleInheritDo) Clauses(conds ...clause.Expression) ISysRoleInheritDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysRoleInheritDo) Returning(value interface{}, columns ...string) ISysRoleInheritDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysRoleInheritDo) Not(conds ...gen.Condition) ISysRoleInheritDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysRoleInheritDo) Or(conds ...gen.Condition) ISysRoleInheritDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysRoleInheritDo) Select(conds ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysRoleInheritDo) Where(conds ...gen.Condition) ISysRoleInheritDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysRoleInheritDo) Order(conds ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysRoleInheritDo) Distinct(cols ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysRoleInheritDo) Omit(cols ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysRoleInheritDo) Join(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysRoleInheritDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysRoleInheritDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysRoleInheritDo) Group(cols ...field.Expr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysRoleInheritDo) Having(conds ...gen.Condition) ISysRoleInheritDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysRoleInheritDo) Limit(limit int) ISysRoleInheritDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysRoleInheritDo) Offset(offset int) ISysRoleInheritDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysRoleInheritDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysRoleInheritDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysRoleInheritDo) Unscoped() ISysRoleInheritDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysRoleInheritDo) Create(values ...*model.SysRoleInherit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysRoleInheritDo) CreateInBatches(values []*model.SysRoleInherit, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysRoleInheritDo) Save(values ...*model.SysRoleInherit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysRoleInheritDo) First() (*model.SysRoleInherit, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysRoleInherit), nil
+	}
+}
+
+func (s sysRoleInheritDo) Take() (*model.SysRoleInherit, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysRoleInherit), nil
+	}
+}
+
+func (s sysRoleInheritDo) Last() (*model.SysRoleInherit, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysRoleInherit), nil
+	}
+}
+
+func (s sysRoleInheritDo) Find() ([]*model.SysRoleInherit, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysRoleInherit), err
+}
+
+func (s sysRoleInheritDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysRoleInherit, err error) {
+	buf := make([]*model.SysRoleInherit, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysRoleInheritDo) FindInBatches(result *[]*model.SysRoleInherit, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysRoleInheritDo) Attrs(attrs ...field.AssignExpr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysRoleInheritDo) Assign(attrs ...field.AssignExpr) ISysRoleInheritDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysRoleInheritDo) Joins(fields ...field.RelationField) ISysRoleInheritDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysRoleInheritDo) Preload(fields ...field.RelationField) ISysRoleInheritDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysRoleInheritDo) FirstOrInit() (*model.SysRoleInherit, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysRoleInherit), nil
+	}
+}
+
+func (s sysRoleInheritDo) FirstOrCreate() (*model.SysRoleInherit, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysRoleInherit), nil
+	}
+}
+
+func (s sysRoleInheritDo) FindByPage(offset int, limit int) (result []*model.SysRoleInherit, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysRoleInheritDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysRoleInheritDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysRoleInheritDo) Delete(models ...*model.SysRoleInherit) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysRoleInheritDo) withDO(do gen.Dao) *sysRoleInheritDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -31,6 +31,9 @@ func newSysTenant(db *gorm.DB, opts ...gen.DOOption) sysTenant {
 	_sysTenant.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysTenant.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysTenant.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysTenant.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysTenant.Version = field.NewInt64(tableName, "version")
+	_sysTenant.CreatedBy = field.NewInt64(tableName, "created_by")
 	_sysTenant.Code = field.NewString(tableName, "code")
 	_sysTenant.Name = field.NewString(tableName, "name")
 	_sysTenant.PackageID = field.NewInt64(tableName, "package_id")
@@ -50,6 +53,9 @@ type sysTenant struct {
 	CreatedAt  field.Time
 	UpdatedAt  field.Time
 	DeletedAt  field.Field
+	UpdatedBy  field.Int64
+	Version    field.Int64
+	CreatedBy  field.Int64
 	Code       field.String
 	Name       field.String
 	PackageID  field.Int64
@@ -75,6 +81,9 @@ func (s *sysTenant) updateTableName(table string) *sysTenant {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.CreatedBy = field.NewInt64(table, "created_by")
 	s.Code = field.NewString(table, "code")
 	s.Name = field.NewString(table, "name")
 	s.PackageID = field.NewInt64(table, "package_id")
@@ -96,11 +105,14 @@ func (s *sysTenant) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysTenant) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 9)
+	s.fieldMap = make(map[string]field.Expr, 12)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["created_by"] = s.CreatedBy
 	s.fieldMap["code"] = s.Code
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["package_id"] = s.PackageID
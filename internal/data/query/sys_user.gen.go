@@ -31,16 +31,22 @@ func newSysUser(db *gorm.DB, opts ...gen.DOOption) sysUser {
 	_sysUser.CreatedAt = field.NewTime(tableName, "created_at")
 	_sysUser.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysUser.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysUser.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysUser.Version = field.NewInt64(tableName, "version")
+	_sysUser.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysUser.CreatedBy = field.NewInt64(tableName, "created_by")
+	_sysUser.DeptID = field.NewInt64(tableName, "dept_id")
 	_sysUser.Username = field.NewString(tableName, "username")
 	_sysUser.PasswordHash = field.NewString(tableName, "password_hash")
 	_sysUser.Name = field.NewString(tableName, "name")
 	_sysUser.Mobile = field.NewString(tableName, "mobile")
-	_sysUser.DeptID = field.NewInt64(tableName, "dept_id")
+	_sysUser.Email = field.NewString(tableName, "email")
 	_sysUser.Avatar = field.NewString(tableName, "avatar")
 	_sysUser.Status = field.NewInt16(tableName, "status")
 	_sysUser.LoginFailedCount = field.NewInt(tableName, "login_failed_count")
 	_sysUser.LastLoginFailedAt = field.NewTime(tableName, "last_login_failed_at")
-	_sysUser.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysUser.NotifyChannels = field.NewString(tableName, "notify_channels")
+	_sysUser.DeletedBy = field.NewInt64(tableName, "deleted_by")
 
 	_sysUser.fillFieldMap()
 
@@ -55,16 +61,22 @@ type sysUser struct {
 	CreatedAt         field.Time
 	UpdatedAt         field.Time
 	DeletedAt         field.Field
+	UpdatedBy         field.Int64
+	Version           field.Int64
+	TenantID          field.Int64
+	CreatedBy         field.Int64
+	DeptID            field.Int64
 	Username          field.String
 	PasswordHash      field.String
 	Name              field.String
 	Mobile            field.String
-	DeptID            field.Int64
+	Email             field.String
 	Avatar            field.String
 	Status            field.Int16
 	LoginFailedCount  field.Int
 	LastLoginFailedAt field.Time
-	TenantID          field.Int64
+	NotifyChannels    field.String
+	DeletedBy         field.Int64
 
 	fieldMap map[string]field.Expr
 }
@@ -85,16 +97,22 @@ func (s *sysUser) updateTableName(table string) *sysUser {
 	s.CreatedAt = field.NewTime(table, "created_at")
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+	s.DeptID = field.NewInt64(table, "dept_id")
 	s.Username = field.NewString(table, "username")
 	s.PasswordHash = field.NewString(table, "password_hash")
 	s.Name = field.NewString(table, "name")
 	s.Mobile = field.NewString(table, "mobile")
-	s.DeptID = field.NewInt64(table, "dept_id")
+	s.Email = field.NewString(table, "email")
 	s.Avatar = field.NewString(table, "avatar")
 	s.Status = field.NewInt16(table, "status")
 	s.LoginFailedCount = field.NewInt(table, "login_failed_count")
 	s.LastLoginFailedAt = field.NewTime(table, "last_login_failed_at")
-	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.NotifyChannels = field.NewString(table, "notify_channels")
+	s.DeletedBy = field.NewInt64(table, "deleted_by")
 
 	s.fillFieldMap()
 
@@ -111,21 +129,27 @@ func (s *sysUser) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysUser) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 14)
+	s.fieldMap = make(map[string]field.Expr, 20)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["created_by"] = s.CreatedBy
+	s.fieldMap["dept_id"] = s.DeptID
 	s.fieldMap["username"] = s.Username
 	s.fieldMap["password_hash"] = s.PasswordHash
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["mobile"] = s.Mobile
-	s.fieldMap["dept_id"] = s.DeptID
+	s.fieldMap["email"] = s.Email
 	s.fieldMap["avatar"] = s.Avatar
 	s.fieldMap["status"] = s.Status
 	s.fieldMap["login_failed_count"] = s.LoginFailedCount
 	s.fieldMap["last_login_failed_at"] = s.LastLoginFailedAt
-	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["notify_channels"] = s.NotifyChannels
+	s.fieldMap["deleted_by"] = s.DeletedBy
 }
 
 func (s sysUser) clone(db *gorm.DB) sysUser {
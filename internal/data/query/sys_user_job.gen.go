@@ -0,0 +1,460 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+)
+
+func newSysUserJob(db *gorm.DB, opts ...gen.DOOption) sysUserJob {
+	_sysUserJob := sysUserJob{}
+
+	_sysUserJob.sysUserJobDo.UseDB(db, opts...)
+	_sysUserJob.sysUserJobDo.UseModel(&model.SysUserJob{})
+
+	tableName := _sysUserJob.sysUserJobDo.TableName()
+	_sysUserJob.ALL = field.NewAsterisk(tableName)
+	_sysUserJob.ID = field.NewInt64(tableName, "id")
+	_sysUserJob.CreatedAt = field.NewTime(tableName, "created_at")
+	_sysUserJob.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_sysUserJob.DeletedAt = field.NewField(tableName, "deleted_at")
+	_sysUserJob.UpdatedBy = field.NewInt64(tableName, "updated_by")
+	_sysUserJob.Version = field.NewInt64(tableName, "version")
+	_sysUserJob.TenantID = field.NewInt64(tableName, "tenant_id")
+	_sysUserJob.CreatedBy = field.NewInt64(tableName, "created_by")
+	_sysUserJob.DeptID = field.NewInt64(tableName, "dept_id")
+	_sysUserJob.Type = field.NewInt16(tableName, "type")
+	_sysUserJob.Status = field.NewInt16(tableName, "status")
+	_sysUserJob.DryRun = field.NewBool(tableName, "dry_run")
+	_sysUserJob.Format = field.NewString(tableName, "format")
+	_sysUserJob.FileKey = field.NewString(tableName, "file_key")
+	_sysUserJob.ResultKey = field.NewString(tableName, "result_key")
+	_sysUserJob.Params = field.NewString(tableName, "params")
+	_sysUserJob.Total = field.NewInt(tableName, "total")
+	_sysUserJob.Succeeded = field.NewInt(tableName, "succeeded")
+	_sysUserJob.Failed = field.NewInt(tableName, "failed")
+	_sysUserJob.Message = field.NewString(tableName, "message")
+	_sysUserJob.FinishedAt = field.NewTime(tableName, "finished_at")
+
+	_sysUserJob.fillFieldMap()
+
+	return _sysUserJob
+}
+
+type sysUserJob struct {
+	sysUserJobDo
+
+	ALL        field.Asterisk
+	ID         field.Int64
+	CreatedAt  field.Time
+	UpdatedAt  field.Time
+	DeletedAt  field.Field
+	UpdatedBy  field.Int64
+	Version    field.Int64
+	TenantID   field.Int64
+	CreatedBy  field.Int64
+	DeptID     field.Int64
+	Type       field.Int16
+	Status     field.Int16
+	DryRun     field.Bool
+	Format     field.String
+	FileKey    field.String
+	ResultKey  field.String
+	Params     field.String
+	Total      field.Int
+	Succeeded  field.Int
+	Failed     field.Int
+	Message    field.String
+	FinishedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sysUserJob) Table(newTableName string) *sysUserJob {
+	s.sysUserJobDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sysUserJob) As(alias string) *sysUserJob {
+	s.sysUserJobDo.DO = *(s.sysUserJobDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sysUserJob) updateTableName(table string) *sysUserJob {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt64(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.DeletedAt = field.NewField(table, "deleted_at")
+	s.UpdatedBy = field.NewInt64(table, "updated_by")
+	s.Version = field.NewInt64(table, "version")
+	s.TenantID = field.NewInt64(table, "tenant_id")
+	s.CreatedBy = field.NewInt64(table, "created_by")
+	s.DeptID = field.NewInt64(table, "dept_id")
+	s.Type = field.NewInt16(table, "type")
+	s.Status = field.NewInt16(table, "status")
+	s.DryRun = field.NewBool(table, "dry_run")
+	s.Format = field.NewString(table, "format")
+	s.FileKey = field.NewString(table, "file_key")
+	s.ResultKey = field.NewString(table, "result_key")
+	s.Params = field.NewString(table, "params")
+	s.Total = field.NewInt(table, "total")
+	s.Succeeded = field.NewInt(table, "succeeded")
+	s.Failed = field.NewInt(table, "failed")
+	s.Message = field.NewString(table, "message")
+	s.FinishedAt = field.NewTime(table, "finished_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sysUserJob) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sysUserJob) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 21)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["deleted_at"] = s.DeletedAt
+	s.fieldMap["updated_by"] = s.UpdatedBy
+	s.fieldMap["version"] = s.Version
+	s.fieldMap["tenant_id"] = s.TenantID
+	s.fieldMap["created_by"] = s.CreatedBy
+	s.fieldMap["dept_id"] = s.DeptID
+	s.fieldMap["type"] = s.Type
+	s.fieldMap["status"] = s.Status
+	s.fieldMap["dry_run"] = s.DryRun
+	s.fieldMap["format"] = s.Format
+	s.fieldMap["file_key"] = s.FileKey
+	s.fieldMap["result_key"] = s.ResultKey
+	s.fieldMap["params"] = s.Params
+	s.fieldMap["total"] = s.Total
+	s.fieldMap["succeeded"] = s.Succeeded
+	s.fieldMap["failed"] = s.Failed
+	s.fieldMap["message"] = s.Message
+	s.fieldMap["finished_at"] = s.FinishedAt
+}
+
+func (s sysUserJob) clone(db *gorm.DB) sysUserJob {
+	s.sysUserJobDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sysUserJob) replaceDB(db *gorm.DB) sysUserJob {
+	s.sysUserJobDo.ReplaceDB(db)
+	return s
+}
+
+type sysUserJobDo struct{ gen.DO }
+
+type ISysUserJobDo interface {
+	gen.SubQuery
+	Debug() ISysUserJobDo
+	WithContext(ctx context.Context) ISysUserJobDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISysUserJobDo
+	WriteDB() ISysUserJobDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISysUserJobDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISysUserJobDo
+	Not(conds ...gen.Condition) ISysUserJobDo
+	Or(conds ...gen.Condition) ISysUserJobDo
+	Select(conds ...field.Expr) ISysUserJobDo
+	Where(conds ...gen.Condition) ISysUserJobDo
+	Order(conds ...field.Expr) ISysUserJobDo
+	Distinct(cols ...field.Expr) ISysUserJobDo
+	Omit(cols ...field.Expr) ISysUserJobDo
+	Join(table schema.Tabler, on ...field.Expr) ISysUserJobDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISysUserJobDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISysUserJobDo
+	Group(cols ...field.Expr) ISysUserJobDo
+	Having(conds ...gen.Condition) ISysUserJobDo
+	Limit(limit int) ISysUserJobDo
+	Offset(offset int) ISysUserJobDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISysUserJobDo
+	Unscoped() ISysUserJobDo
+	Create(values ...*model.SysUserJob) error
+	CreateInBatches(values []*model.SysUserJob, batchSize int) error
+	Save(values ...*model.SysUserJob) error
+	First() (*model.SysUserJob, error)
+	Take() (*model.SysUserJob, error)
+	Last() (*model.SysUserJob, error)
+	Find() ([]*model.SysUserJob, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysUserJob, err error)
+	FindInBatches(result *[]*model.SysUserJob, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.SysUserJob) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISysUserJobDo
+	Assign(attrs ...field.AssignExpr) ISysUserJobDo
+	Joins(fields ...field.RelationField) ISysUserJobDo
+	Preload(fields ...field.RelationField) ISysUserJobDo
+	FirstOrInit() (*model.SysUserJob, error)
+	FirstOrCreate() (*model.SysUserJob, error)
+	FindByPage(offset int, limit int) (result []*model.SysUserJob, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISysUserJobDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sysUserJobDo) Debug() ISysUserJobDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sysUserJobDo) WithContext(ctx context.Context) ISysUserJobDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sysUserJobDo) ReadDB() ISysUserJobDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sysUserJobDo) WriteDB() ISysUserJobDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sysUserJobDo) Session(config *gorm.Session) ISysUserJobDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sysUserJobDo) Clauses(conds ...clause.Expression) ISysUserJobDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sysUserJobDo) Returning(value interface{}, columns ...string) ISysUserJobDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sysUserJobDo) Not(conds ...gen.Condition) ISysUserJobDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sysUserJobDo) Or(conds ...gen.Condition) ISysUserJobDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sysUserJobDo) Select(conds ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sysUserJobDo) Where(conds ...gen.Condition) ISysUserJobDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sysUserJobDo) Order(conds ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sysUserJobDo) Distinct(cols ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sysUserJobDo) Omit(cols ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sysUserJobDo) Join(table schema.Tabler, on ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sysUserJobDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sysUserJobDo) RightJoin(table schema.Tabler, on ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sysUserJobDo) Group(cols ...field.Expr) ISysUserJobDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sysUserJobDo) Having(conds ...gen.Condition) ISysUserJobDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sysUserJobDo) Limit(limit int) ISysUserJobDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sysUserJobDo) Offset(offset int) ISysUserJobDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sysUserJobDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISysUserJobDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sysUserJobDo) Unscoped() ISysUserJobDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sysUserJobDo) Create(values ...*model.SysUserJob) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sysUserJobDo) CreateInBatches(values []*model.SysUserJob, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sysUserJobDo) Save(values ...*model.SysUserJob) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sysUserJobDo) First() (*model.SysUserJob, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysUserJob), nil
+	}
+}
+
+func (s sysUserJobDo) Take() (*model.SysUserJob, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysUserJob), nil
+	}
+}
+
+func (s sysUserJobDo) Last() (*model.SysUserJob, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysUserJob), nil
+	}
+}
+
+func (s sysUserJobDo) Find() ([]*model.SysUserJob, error) {
+	result, err := s.DO.Find()
+	return result.([]*model.SysUserJob), err
+}
+
+func (s sysUserJobDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.SysUserJob, err error) {
+	buf := make([]*model.SysUserJob, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sysUserJobDo) FindInBatches(result *[]*model.SysUserJob, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sysUserJobDo) Attrs(attrs ...field.AssignExpr) ISysUserJobDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sysUserJobDo) Assign(attrs ...field.AssignExpr) ISysUserJobDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sysUserJobDo) Joins(fields ...field.RelationField) ISysUserJobDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sysUserJobDo) Preload(fields ...field.RelationField) ISysUserJobDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sysUserJobDo) FirstOrInit() (*model.SysUserJob, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysUserJob), nil
+	}
+}
+
+func (s sysUserJobDo) FirstOrCreate() (*model.SysUserJob, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.SysUserJob), nil
+	}
+}
+
+func (s sysUserJobDo) FindByPage(offset int, limit int) (result []*model.SysUserJob, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sysUserJobDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sysUserJobDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sysUserJobDo) Delete(models ...*model.SysUserJob) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sysUserJobDo) withDO(do gen.Dao) *sysUserJobDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
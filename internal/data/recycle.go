@@ -0,0 +1,379 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var _ biz.RecycleRepo = (*recycleRepo)(nil)
+
+// recycleCascade 彻底删除时一并物理删除的关联数据
+type recycleCascade struct {
+	model  interface{}
+	column string
+}
+
+// recycleSpec 回收站数据类型对应的表结构
+type recycleSpec struct {
+	model    func() interface{}
+	tenant   bool   // 是否按租户隔离
+	nameCol  string // 显示名称
+	codeCol  string // 唯一标识，与 tenant_id 组成部分唯一索引 (WHERE deleted_at IS NULL)，为空表示无唯一约束
+	parent   bool   // 是否检查上级是否已删除
+	cascades []recycleCascade
+}
+
+var recycleSpecs = map[string]*recycleSpec{
+	biz.RecycleUser: {
+		model:    func() interface{} { return &model.SysUser{} },
+		tenant:   true,
+		nameCol:  "name",
+		codeCol:  "username",
+		cascades: []recycleCascade{{&model.SysUserRole{}, "user_id"}},
+	},
+	biz.RecycleRole: {
+		model:   func() interface{} { return &model.SysRole{} },
+		tenant:  true,
+		nameCol: "name",
+		codeCol: "code",
+		cascades: []recycleCascade{
+			{&model.SysUserRole{}, "role_id"},
+			{&model.SysRolePermission{}, "role_id"},
+			{&model.SysRoleDept{}, "role_id"},
+			{&model.SysRoleInherit{}, "role_id"},
+			{&model.SysRoleInherit{}, "parent_id"},
+		},
+	},
+	biz.RecycleDept: {
+		model:    func() interface{} { return &model.SysDept{} },
+		tenant:   true,
+		nameCol:  "name",
+		parent:   true,
+		cascades: []recycleCascade{{&model.SysRoleDept{}, "dept_id"}},
+	},
+	biz.RecyclePermission: {
+		model:   func() interface{} { return &model.SysPermission{} },
+		nameCol: "name",
+		codeCol: "code",
+		cascades: []recycleCascade{
+			{&model.SysRolePermission{}, "permission_id"},
+			{&model.SysPackagePermission{}, "permission_id"},
+		},
+	},
+}
+
+// columns 查询回收站记录的字段，缺失的字段以常量补齐
+func (s *recycleSpec) columns() string {
+	cols := []string{"id", "deleted_at", "deleted_by", s.nameCol + " AS name"}
+	if s.tenant {
+		cols = append(cols, "tenant_id")
+	} else {
+		cols = append(cols, "0 AS tenant_id")
+	}
+	if s.codeCol != "" {
+		cols = append(cols, s.codeCol+" AS code")
+	} else {
+		cols = append(cols, "'' AS code")
+	}
+	if s.parent {
+		cols = append(cols, "parent_id")
+	} else {
+		cols = append(cols, "0 AS parent_id")
+	}
+	return strings.Join(cols, ", ")
+}
+
+type trashedRow struct {
+	ID        int64
+	TenantID  int64
+	ParentID  int64
+	Name      string
+	Code      string
+	DeletedAt time.Time
+	DeletedBy int64
+}
+
+type recycleRepo struct {
+	BaseRepo
+}
+
+func NewRecycleRepo(data *Data, logger log.Logger) biz.RecycleRepo {
+	return &recycleRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+func (r *recycleRepo) spec(kind string) (*recycleSpec, error) {
+	s, ok := recycleSpecs[kind]
+	if !ok {
+		return nil, biz.ErrRecycleKindInvalid
+	}
+	return s, nil
+}
+
+// trashed 回收站中的记录，租户及数据范围由 DataScopePlugin 过滤
+func (r *recycleRepo) trashed(ctx context.Context, s *recycleSpec) *gorm.DB {
+	return r.data.DB(ctx).Model(s.model()).Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+func (r *recycleRepo) ListTrashed(ctx context.Context, kind string, page, pageSize int) ([]*biz.TrashedRecord, int64, error) {
+	s, err := r.spec(kind)
+	if err != nil {
+		return nil, 0, err
+	}
+	var total int64
+	if err := r.trashed(ctx, s).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+	var rows []trashedRow
+	if err := r.trashed(ctx, s).Select(s.columns()).
+		Scopes(r.SortBy("deleted_at", false), r.Paginate(page, pageSize)).
+		Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	userIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		if row.DeletedBy > 0 {
+			userIDs = append(userIDs, row.DeletedBy)
+		}
+	}
+	names, err := r.userNames(ctx, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*biz.TrashedRecord, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, &biz.TrashedRecord{
+			ID:            row.ID,
+			TenantID:      row.TenantID,
+			Name:          row.Name,
+			Code:          row.Code,
+			DeletedAt:     row.DeletedAt,
+			DeletedBy:     row.DeletedBy,
+			DeletedByName: names[row.DeletedBy],
+		})
+	}
+	return list, total, nil
+}
+
+// userNames 查询删除人姓名，删除人可能已被删除或不在当前数据范围内
+func (r *recycleRepo) userNames(ctx context.Context, ids []int64) (map[int64]string, error) {
+	names := make(map[int64]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	var users []model.SysUser
+	if err := r.data.DB(auth.WithSkipDataScope(ctx)).Unscoped().
+		Select("id, username, name").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		name := u.Name
+		if name == "" {
+			name = u.Username
+		}
+		names[u.ID] = name
+	}
+	return names, nil
+}
+
+func (r *recycleRepo) FindRestoreConflicts(ctx context.Context, kind string, ids []int64) ([]*biz.RecycleConflict, error) {
+	s, err := r.spec(kind)
+	if err != nil {
+		return nil, err
+	}
+	var rows []trashedRow
+	if err := r.trashed(ctx, s).Select(s.columns()).Where("id IN ?", ids).Order("id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	found := make(map[int64]*trashedRow, len(rows))
+	for i := range rows {
+		found[rows[i].ID] = &rows[i]
+	}
+
+	conflicts := make(map[int64]string)
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			conflicts[id] = biz.RecycleConflictNotFound
+		}
+	}
+	if s.codeCol != "" {
+		if err := r.uniqueConflicts(ctx, s, rows, conflicts); err != nil {
+			return nil, err
+		}
+	}
+	if s.parent {
+		if err := r.parentConflicts(ctx, s, rows, conflicts); err != nil {
+			return nil, err
+		}
+	}
+
+	list := make([]*biz.RecycleConflict, 0, len(conflicts))
+	for _, id := range ids {
+		if reason, ok := conflicts[id]; ok {
+			list = append(list, &biz.RecycleConflict{ID: id, Reason: reason})
+			delete(conflicts, id)
+		}
+	}
+	return list, nil
+}
+
+// uniqueConflicts 与未删除数据或同批次记录的唯一标识重复
+// 唯一索引跨越数据范围，因此查询未删除数据时不按数据范围过滤
+func (r *recycleRepo) uniqueConflicts(ctx context.Context, s *recycleSpec, rows []trashedRow, conflicts map[int64]string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	key := func(tenantID int64, code string) string {
+		return fmt.Sprintf("%d:%s", tenantID, code)
+	}
+	db := r.data.DB(auth.WithSkipDataScope(ctx)).Model(s.model())
+	var live []trashedRow
+	if s.tenant {
+		pairs := make([][]interface{}, 0, len(rows))
+		for _, row := range rows {
+			pairs = append(pairs, []interface{}{row.TenantID, row.Code})
+		}
+		db = db.Select("tenant_id, "+s.codeCol+" AS code").Where("(tenant_id, "+s.codeCol+") IN ?", pairs)
+	} else {
+		codes := make([]string, 0, len(rows))
+		for _, row := range rows {
+			codes = append(codes, row.Code)
+		}
+		db = db.Select(s.codeCol+" AS code").Where(s.codeCol+" IN ?", codes)
+	}
+	if err := db.Scan(&live).Error; err != nil {
+		return err
+	}
+	taken := make(map[string]bool, len(live))
+	for _, row := range live {
+		taken[key(row.TenantID, row.Code)] = true
+	}
+	batch := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		k := key(row.TenantID, row.Code)
+		switch {
+		case taken[k]:
+			conflicts[row.ID] = biz.RecycleConflictDuplicate
+		case batch[k]:
+			conflicts[row.ID] = biz.RecycleConflictBatch
+		default:
+			batch[k] = true
+		}
+	}
+	return nil
+}
+
+// parentConflicts 上级既未恢复也不在本次恢复范围内，上级无法恢复时下级同样无法恢复
+func (r *recycleRepo) parentConflicts(ctx context.Context, s *recycleSpec, rows []trashedRow, conflicts map[int64]string) error {
+	parentIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		if row.ParentID > 0 {
+			parentIDs = append(parentIDs, row.ParentID)
+		}
+	}
+	if len(parentIDs) == 0 {
+		return nil
+	}
+	var liveIDs []int64
+	if err := r.data.DB(auth.WithSkipDataScope(ctx)).Model(s.model()).
+		Where("id IN ?", parentIDs).Pluck("id", &liveIDs).Error; err != nil {
+		return err
+	}
+	live := make(map[int64]bool, len(liveIDs))
+	for _, id := range liveIDs {
+		live[id] = true
+	}
+	batch := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		batch[row.ID] = true
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, row := range rows {
+			if row.ParentID == 0 || live[row.ParentID] {
+				continue
+			}
+			if _, ok := conflicts[row.ID]; ok {
+				continue
+			}
+			if _, ok := conflicts[row.ParentID]; !ok && batch[row.ParentID] {
+				continue
+			}
+			conflicts[row.ID] = biz.RecycleConflictParent
+			changed = true
+		}
+	}
+	return nil
+}
+
+func (r *recycleRepo) RestoreTrashed(ctx context.Context, kind string, ids []int64) (int64, error) {
+	s, err := r.spec(kind)
+	if err != nil {
+		return 0, err
+	}
+	res := r.trashed(ctx, s).Where("id IN ?", ids).
+		Updates(map[string]interface{}{"deleted_at": nil, deletedByColumn: 0})
+	if res.Error == nil {
+		r.invalidate(ctx, kind, ids)
+	}
+	return res.RowsAffected, res.Error
+}
+
+func (r *recycleRepo) PurgeTrashed(ctx context.Context, kind string, ids []int64) (int64, error) {
+	s, err := r.spec(kind)
+	if err != nil {
+		return 0, err
+	}
+	var purged []int64
+	if err := r.trashed(ctx, s).Where("id IN ?", ids).Pluck("id", &purged).Error; err != nil {
+		return 0, err
+	}
+	if len(purged) == 0 {
+		return 0, nil
+	}
+	res := r.data.DB(ctx).Unscoped().Where("id IN ?", purged).Delete(s.model())
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	// 记录已确认在当前数据范围内，关联数据按 ID 直接物理删除
+	skipCtx := auth.WithSkipDataScope(ctx)
+	for _, c := range s.cascades {
+		if err := r.data.DB(skipCtx).Unscoped().Where(c.column+" IN ?", purged).Delete(c.model).Error; err != nil {
+			return 0, err
+		}
+	}
+	r.invalidate(ctx, kind, purged)
+	return res.RowsAffected, nil
+}
+
+// invalidate 恢复或彻底删除后使缓存失效
+func (r *recycleRepo) invalidate(ctx context.Context, kind string, ids []int64) {
+	switch kind {
+	case biz.RecycleUser:
+		userCache(r.data).Delete(ctx, userCacheKeys(ids...)...)
+	case biz.RecyclePermission:
+		apiPermissionCache(r.data).Delete(ctx, apiPermissionKey)
+	}
+}
+
+func (r *recycleRepo) ListExpiredTrashIDs(ctx context.Context, kind string, before time.Time, limit int) ([]int64, error) {
+	s, err := r.spec(kind)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int64
+	err = r.trashed(auth.WithSkipDataScope(ctx), s).Where("deleted_at < ?", before).
+		Order("id").Limit(limit).Pluck("id", &ids).Error
+	return ids, err
+}
@@ -3,10 +3,12 @@ package data
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // BaseRepo 定义基础仓库结构
@@ -23,61 +25,29 @@ func NewBaseRepo(data *Data, logger log.Logger) BaseRepo {
 	}
 }
 
-// DataScope 是 BaseRepo 的一个方法，所有继承它的 Repo 都能直接使用
+// DataScope 手动追加租户隔离与数据范围条件
+// 带模型的语句已由 DataScopePlugin 自动过滤，这里用于 Table/Raw 拼接的查询
 func (r *BaseRepo) DataScope(ctx context.Context) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		// 这里是从 Context 中提取中间件注入的权限标识（前面讨论的逻辑）
-		scope, _ := ctx.Value("DataScope").(string)
-		deptID, _ := ctx.Value("UserDeptID").(int64)
-		userID, _ := ctx.Value("UserID").(int64)
-		tenantID, _ := ctx.Value("TenantID").(int64)
-
-		// 1. 租户物理隔离（每个 SQL 必加）
-		db = db.Where("tenant_id = ?", tenantID)
-
-		// 2. 根据数据范围动态拼接 SQL
-		switch scope {
-		case "ALL":
+		if auth.IsSkipDataScope(ctx) {
 			return db
-		case "DEPT_SUB":
-			return db.Where("dept_id IN (SELECT id FROM sys_dept WHERE id = ? OR ancestors LIKE ?)",
-				deptID, fmt.Sprintf("%%,%d,%%", deptID))
-		case "DEPT":
-			return db.Where("dept_id = ?", deptID)
-		case "SELF":
-			return db.Where("created_by = ?", userID)
-		default:
-			return db.Where("1 = 0") // 默认无权，防止逻辑漏洞
 		}
+		exprs := dataScopeExprs(auth.GetContextInfo(ctx), "", "dept_id", "created_by")
+		return db.Clauses(clause.Where{Exprs: exprs})
 	}
 }
 
 /* 使用示例
-package data
-
-import (
-    "context"
-    "your_project/internal/biz"
-)
-
-type orderRepo struct {
-    BaseRepo // 匿名嵌入，这样 orderRepo 就拥有了 DataScope 方法
-}
-
-func NewOrderRepo(data *Data, logger log.Logger) biz.OrderRepo {
-    return &orderRepo{
-        BaseRepo: NewBaseRepo(data, logger),
-    }
-}
-
-func (r *orderRepo) ListOrders(ctx context.Context) ([]*biz.Order, error) {
-    var orders []*Order
-    // 直接调用 r.DataScope(ctx)，代码非常简洁
-    err := r.data.db.WithContext(ctx).
+func (r *orderRepo) ListOrderStats(ctx context.Context) ([]*biz.OrderStat, error) {
+    var stats []*biz.OrderStat
+    // Table 查询无法解析模型，需手动调用 r.DataScope(ctx)
+    err := r.data.DB(ctx).Table("biz_order").
+        Select("status, count(*) as total").
         Scopes(r.DataScope(ctx)).
-        Find(&orders).Error
+        Group("status").
+        Scan(&stats).Error
 
-    return orders, err
+    return stats, err
 }
 */
 
@@ -119,6 +89,22 @@ func (r *BaseRepo) OnlyTrashed(db *gorm.DB) *gorm.DB {
 // 使用：
 // r.data.db.Scopes(OnlyTrashed).Find(&deletedUsers)
 
+// nullTime 零值时间写入为 NULL，MySQL 严格模式不接受零值日期
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// timeValue NULL 读取为零值时间
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
 // SortBy 排序
 func (r *BaseRepo) SortBy(field string, ascending bool) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -0,0 +1,423 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// 仓库测试经 forEachDatabase 在 SQLite 及已配置的 MySQL、PostgreSQL 上运行，用于校验各数据库的行为一致
+
+// createDeptTree 创建租户 1 的部门树，ID 有意构造为前缀相同，校验 ancestors 匹配不会误包含：
+//
+//	1 ─ 12 ─ 13
+//	11 ─ 111
+func createDeptTree(t *testing.T, d *Data) {
+	t.Helper()
+	db := d.DB(auth.WithSkipDataScope(context.Background()))
+	for _, dept := range []struct {
+		id, parentID int64
+		ancestors    string
+	}{
+		{1, 0, "0"},
+		{11, 0, "0"},
+		{12, 1, "0,1"},
+		{13, 12, "0,1,12"},
+		{111, 11, "0,11"},
+	} {
+		m := &model.SysDept{
+			BaseAuthModel: model.BaseAuthModel{BaseModel: model.BaseModel{ID: dept.id}, AuthField: model.AuthField{TenantID: 1}},
+			ParentID:      dept.parentID,
+			Name:          fmt.Sprintf("dept-%d", dept.id),
+			Ancestors:     dept.ancestors,
+			Sort:          int32(dept.id),
+		}
+		if err := db.Create(m).Error; err != nil {
+			t.Fatalf("create dept %d: %v", dept.id, err)
+		}
+	}
+}
+
+func sortedIDs(ids []int64) string {
+	slices.Sort(ids)
+	return fmt.Sprint(ids)
+}
+
+func TestSubDeptQuery(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		createDeptTree(t, d)
+		repo := newRoleRepo(d, d.logger)
+
+		for deptID, want := range map[int64]string{
+			1:   "[1 12 13]",
+			11:  "[11 111]",
+			12:  "[12 13]",
+			111: "[111]",
+		} {
+			ids, err := repo.LoadSubDeptIDs(context.Background(), 1, deptID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := sortedIDs(ids); got != want {
+				t.Errorf("sub depts of %d = %s, want %s", deptID, got, want)
+			}
+		}
+		if ids, err := repo.LoadSubDeptIDs(context.Background(), 2, 1); err != nil || len(ids) != 0 {
+			t.Errorf("sub depts in other tenant = %v, %v", ids, err)
+		}
+	})
+}
+
+func TestDeptRepo(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		createDeptTree(t, d)
+		repo := NewDeptRepo(d, d.logger)
+
+		// 部门树不受数据范围限制
+		depts, err := repo.ListDepts(tenantContext(1, 0, 12, auth.ScopeSelf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int64
+		for _, dept := range depts {
+			ids = append(ids, dept.ID)
+		}
+		if fmt.Sprint(ids) != "[1 11 12 13 111]" {
+			t.Fatalf("depts = %v", ids)
+		}
+
+		scoped, err := repo.ListScopedDeptIDs(tenantContext(1, 0, 1, auth.ScopeDeptSub))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := sortedIDs(scoped); got != "[1 12 13]" {
+			t.Fatalf("scoped depts = %s", got)
+		}
+	})
+}
+
+func TestSysUserRepo(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		createDeptTree(t, d)
+		repo := NewSysUserRepo(d, d.logger)
+		ctx := tenantContext(1, 0, 1, auth.ScopeAll)
+
+		create := func(username, phone string, deptID int64, available bool) *biz.SysUser {
+			t.Helper()
+			u, err := repo.CreateUser(ctx, &biz.SysUser{
+				Username: username, Phone: phone, Nickname: username, DeptID: deptID, TenantID: 1, IsAvailable: available,
+			})
+			if err != nil {
+				t.Fatalf("create %s: %v", username, err)
+			}
+			return u
+		}
+		alice := create("alice", "13800000001", 1, true)
+		create("bob", "13800000002", 13, true)
+		create("carol", "13800000003", 111, false)
+
+		t.Run("get", func(t *testing.T) {
+			u, err := repo.GetUserByUsername(ctx, "alice")
+			if err != nil || u.ID != alice.ID {
+				t.Fatalf("by username = %v, %v", u, err)
+			}
+			if u, err = repo.GetUserByPhone(ctx, "13800000001"); err != nil || u.ID != alice.ID {
+				t.Fatalf("by phone = %v, %v", u, err)
+			}
+			if u, err = repo.GetUserByID(ctx, alice.ID); err != nil || u.Username != "alice" {
+				t.Fatalf("by id = %v, %v", u, err)
+			}
+			if _, err = repo.GetUserByUsername(ctx, "nobody"); !errors.Is(err, biz.ErrUserNotFound) {
+				t.Fatalf("missing user: %v", err)
+			}
+		})
+
+		t.Run("update", func(t *testing.T) {
+			if err := repo.UpdatePassword(ctx, alice.ID, "hash"); err != nil {
+				t.Fatal(err)
+			}
+			if err := repo.UpdatePhone(ctx, alice.ID, "13900000001"); err != nil {
+				t.Fatal(err)
+			}
+			// 缓存中不含密码哈希，按用户名读取
+			u, err := repo.GetUserByUsername(ctx, "alice")
+			if err != nil || u.PasswordHash != "hash" || u.Phone != "13900000001" {
+				t.Fatalf("updated user = %+v, %v", u, err)
+			}
+			if u, err = repo.GetUserByID(ctx, alice.ID); err != nil || u.Phone != "13900000001" {
+				t.Fatalf("cached user = %+v, %v", u, err)
+			}
+		})
+
+		t.Run("existing", func(t *testing.T) {
+			taken, err := repo.ExistingUsernames(ctx, []string{"alice", "dave"})
+			if err != nil || fmt.Sprint(taken) != "[alice]" {
+				t.Fatalf("usernames = %v, %v", taken, err)
+			}
+			// 其他租户的同名用户不占用
+			if taken, err = repo.ExistingUsernames(tenantContext(2, 0, 0, auth.ScopeAll), []string{"alice"}); err != nil || len(taken) != 0 {
+				t.Fatalf("usernames in other tenant = %v, %v", taken, err)
+			}
+		})
+
+		t.Run("filter", func(t *testing.T) {
+			tests := []struct {
+				filter biz.UserFilter
+				want   string
+			}{
+				{biz.UserFilter{}, "[alice bob carol]"},
+				{biz.UserFilter{DeptID: 1}, "[alice bob]"},
+				{biz.UserFilter{DeptID: 11}, "[carol]"},
+				{biz.UserFilter{Keyword: "ali"}, "[alice]"},
+				{biz.UserFilter{Status: biz.UserStatusDisabled}, "[carol]"},
+			}
+			for _, tt := range tests {
+				users, err := repo.FindUsers(ctx, &tt.filter, 10)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var names []string
+				for _, u := range users {
+					names = append(names, u.Username)
+				}
+				slices.Sort(names)
+				if got := fmt.Sprint(names); got != tt.want {
+					t.Errorf("find %+v = %s, want %s", tt.filter, got, tt.want)
+				}
+				total, err := repo.CountUsers(ctx, &tt.filter)
+				if err != nil || int(total) != len(names) {
+					t.Errorf("count %+v = %d, %v", tt.filter, total, err)
+				}
+			}
+		})
+
+		t.Run("role codes", func(t *testing.T) {
+			db := d.DB(auth.WithSkipDataScope(ctx))
+			roleID := func(code string) int64 {
+				role := &model.SysRole{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Code: code, Name: code}
+				if err := db.Create(role).Error; err != nil {
+					t.Fatal(err)
+				}
+				return role.ID
+			}
+			expired := time.Now().Add(-time.Hour)
+			for _, ur := range []*model.SysUserRole{
+				{UserID: alice.ID, RoleID: roleID("editor")},
+				{UserID: alice.ID, RoleID: roleID("auditor")},
+				{UserID: alice.ID, RoleID: roleID("expired"), ExpireAt: &expired},
+			} {
+				ur.TenantID = 1
+				if err := db.Create(ur).Error; err != nil {
+					t.Fatal(err)
+				}
+			}
+			codes, err := repo.ListUserRoleCodes(ctx, []int64{alice.ID})
+			if err != nil || fmt.Sprint(codes[alice.ID]) != "[auditor editor]" {
+				t.Fatalf("role codes = %v, %v", codes, err)
+			}
+		})
+	})
+}
+
+func TestRoleRepo(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		createDeptTree(t, d)
+		repo := newRoleRepo(d, d.logger)
+		ctx := tenantContext(1, 0, 1, auth.ScopeAll)
+		db := d.DB(auth.WithSkipDataScope(ctx))
+
+		var ids []int64
+		for _, role := range []*model.SysRole{
+			{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Code: "editor", Name: "编辑"},
+			{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Code: "viewer", Name: "访客"},
+			{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 2}}, Code: "editor", Name: "编辑"},
+		} {
+			if err := db.Create(role).Error; err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, role.ID)
+		}
+		editor, viewer, foreign := ids[0], ids[1], ids[2]
+
+		t.Run("get", func(t *testing.T) {
+			role, err := repo.GetRoleByID(ctx, editor)
+			if err != nil || role.Code != "editor" {
+				t.Fatalf("role = %v, %v", role, err)
+			}
+			if _, err := repo.GetRoleByID(ctx, foreign); !errors.Is(err, biz.ErrRoleNotFound) {
+				t.Fatalf("role of other tenant: %v", err)
+			}
+			roles, err := repo.ListRoles(ctx)
+			if err != nil || len(roles) != 2 {
+				t.Fatalf("roles = %v, %v", roles, err)
+			}
+			if roles, err = repo.ListRolesByIDs(ctx, []int64{viewer, foreign}); err != nil || len(roles) != 1 {
+				t.Fatalf("roles by ids = %v, %v", roles, err)
+			}
+		})
+
+		t.Run("depts", func(t *testing.T) {
+			if n, err := repo.CountDepts(ctx, []int64{1, 12, 999}); err != nil || n != 2 {
+				t.Fatalf("count depts = %d, %v", n, err)
+			}
+			if err := repo.ReplaceRoleDepts(ctx, editor, []int64{1, 12}); err != nil {
+				t.Fatal(err)
+			}
+			if err := repo.ReplaceRoleDepts(ctx, editor, []int64{11}); err != nil {
+				t.Fatal(err)
+			}
+			deptIDs, err := repo.LoadRoleDeptIDs(ctx, 1, "editor")
+			if err != nil || sortedIDs(deptIDs) != "[11]" {
+				t.Fatalf("role depts = %v, %v", deptIDs, err)
+			}
+		})
+
+		t.Run("parents", func(t *testing.T) {
+			if err := db.Create(&model.SysRoleInherit{TenantID: 1, RoleID: editor, ParentID: viewer}).Error; err != nil {
+				t.Fatal(err)
+			}
+			err := d.InTx(ctx, func(ctx context.Context) error {
+				edges, err := repo.LockRoleParents(ctx)
+				if err != nil || fmt.Sprint(edges[editor]) != fmt.Sprint([]int64{viewer}) {
+					t.Fatalf("parents = %v, %v", edges, err)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	})
+}
+
+func TestTenantRepo(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		repo := newTenantRepo(d, d.logger)
+		ctx := auth.WithSkipDataScope(context.Background())
+		db := d.DB(ctx)
+
+		t.Run("admin emails", func(t *testing.T) {
+			admin := &model.SysRole{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Code: "admin", Name: "管理员"}
+			if err := db.Create(admin).Error; err != nil {
+				t.Fatal(err)
+			}
+			expired := time.Now().Add(-time.Hour)
+			future := time.Now().Add(time.Hour)
+			var revoked *model.SysUserRole
+			for _, u := range []struct {
+				name     string
+				expireAt *time.Time
+			}{{"alice", nil}, {"bob", &future}, {"carol", &expired}, {"dave", nil}} {
+				user := &model.SysUser{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: 1}}, Username: u.name, Name: u.name, Email: u.name + "@example.com"}
+				if err := db.Create(user).Error; err != nil {
+					t.Fatal(err)
+				}
+				ur := &model.SysUserRole{UserID: user.ID, RoleID: admin.ID, ExpireAt: u.expireAt}
+				ur.TenantID = 1
+				if err := db.Create(ur).Error; err != nil {
+					t.Fatal(err)
+				}
+				revoked = ur
+			}
+			// 已撤销（逻辑删除）及已到期的授权不再视为管理员
+			if err := db.Delete(revoked).Error; err != nil {
+				t.Fatal(err)
+			}
+			emails, err := repo.ListTenantAdminEmails(ctx, 1)
+			slices.Sort(emails)
+			if err != nil || fmt.Sprint(emails) != "[alice@example.com bob@example.com]" {
+				t.Fatalf("admin emails = %v, %v", emails, err)
+			}
+		})
+
+		t.Run("expire notice", func(t *testing.T) {
+			unlock, ok, err := repo.LockExpireNotice(ctx, time.Minute)
+			if err != nil || !ok {
+				t.Fatalf("lock = %v, %v", ok, err)
+			}
+			if _, ok, err := repo.LockExpireNotice(ctx, time.Minute); err != nil || ok {
+				t.Fatalf("lock held by other node = %v, %v", ok, err)
+			}
+			unlock()
+			unlock, ok, err = repo.LockExpireNotice(ctx, time.Minute)
+			if err != nil || !ok {
+				t.Fatalf("lock after release = %v, %v", ok, err)
+			}
+			unlock()
+
+			expireTime := time.Now().Add(72 * time.Hour)
+			if err := repo.MarkExpireNotified(ctx, 1, expireTime, time.Hour); err != nil {
+				t.Fatal(err)
+			}
+			if ok, err := repo.IsExpireNotified(ctx, 1, expireTime); err != nil || !ok {
+				t.Fatalf("notified = %v, %v", ok, err)
+			}
+			// 续期后过期时间变化，进入新的提醒周期
+			if ok, err := repo.IsExpireNotified(ctx, 1, expireTime.AddDate(1, 0, 0)); err != nil || ok {
+				t.Fatalf("notified after renew = %v, %v", ok, err)
+			}
+		})
+	})
+}
+
+// TestSoftUniqueIndexes 唯一索引只约束未删除的数据：PostgreSQL、SQLite 为部分索引，MySQL 为函数索引
+func TestSoftUniqueIndexes(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, d *Data) {
+		ctx := auth.WithSkipDataScope(context.Background())
+		db := d.DB(ctx)
+
+		for _, idx := range softUniqueIndexes {
+			if !db.Migrator().HasIndex(idx.table, idx.name) {
+				t.Errorf("index %s missing", idx.name)
+			}
+		}
+
+		user := func(tenantID int64, username string) *model.SysUser {
+			return &model.SysUser{BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}}, Username: username, Name: username}
+		}
+		first := user(1, "alice")
+		if err := db.Create(first).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(user(1, "alice")).Error; err == nil {
+			t.Fatal("duplicate username in the same tenant accepted")
+		}
+		if err := db.Create(user(2, "alice")).Error; err != nil {
+			t.Fatalf("same username in other tenant: %v", err)
+		}
+
+		// 删除后可重新创建，且允许多条同名的已删除记录
+		for i := 0; i < 2; i++ {
+			if err := db.Delete(first).Error; err != nil {
+				t.Fatal(err)
+			}
+			first = user(1, "alice")
+			if err := db.Create(first).Error; err != nil {
+				t.Fatalf("recreate deleted username (round %d): %v", i+1, err)
+			}
+		}
+
+		// 不含租户的索引
+		dictType := func() *model.SysDictType { return &model.SysDictType{Code: "gender", Name: "性别", Enabled: true} }
+		dt := dictType()
+		if err := db.Create(dt).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(dictType()).Error; err == nil {
+			t.Fatal("duplicate dict type accepted")
+		}
+		if err := db.Delete(dt).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(dictType()).Error; err != nil {
+			t.Fatalf("recreate deleted dict type: %v", err)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// 数据源名称及路由目标
+const (
+	DefaultSource = "default" // conf.Data.database 对应的主数据源
+
+	routePrimary = "primary"
+	routeReplica = "replica"
+)
+
+// dbRoute 语句实际使用的连接，写入 Context 供 SQL 日志输出
+type dbRoute struct {
+	source string
+	target string
+	index  int // 副本序号，从 0 开始
+}
+
+func (r dbRoute) String() string {
+	if r.target == routeReplica {
+		return fmt.Sprintf("%s/%s-%d", r.source, r.target, r.index)
+	}
+	return r.source + "/" + r.target
+}
+
+type dbRouteKey struct{}
+
+// routeFromContext 取出语句的路由，未配置副本的数据源没有路由信息
+func routeFromContext(ctx context.Context) (dbRoute, bool) {
+	r, ok := ctx.Value(dbRouteKey{}).(dbRoute)
+	return r, ok
+}
+
+// routeCounter 按数据源及路由目标统计语句数，使用 OpenTelemetry 全局 MeterProvider
+var routeCounter, _ = otel.Meter("github.com/sober-studio/bubble-admin-go-kratos/internal/data").Int64Counter(
+	"db.client.routed_statements",
+	metric.WithDescription("按数据源及主库/副本统计的 SQL 语句数"),
+	metric.WithUnit("{statement}"),
+)
+
+// replicaDialector 记录副本连接池的序号，dbresolver 不对外暴露连接池
+type replicaDialector struct {
+	gorm.Dialector
+	index int
+	pools map[gorm.ConnPool]int
+}
+
+func (d replicaDialector) Initialize(db *gorm.DB) error {
+	if err := d.Dialector.Initialize(db); err != nil {
+		return err
+	}
+	d.pools[db.ConnPool] = d.index
+	return nil
+}
+
+// useReplicas 注册读写分离：读取随机分配到副本，写入、事务内语句及 WithPrimary 的读取使用主库
+func useReplicas(db *gorm.DB, name string, c *conf.Data_Database) (map[gorm.ConnPool]int, error) {
+	pools := make(map[gorm.ConnPool]int, len(c.Replicas))
+	replicas := make([]gorm.Dialector, 0, len(c.Replicas))
+	for i, source := range c.Replicas {
+		replicas = append(replicas, replicaDialector{Dialector: openDialector(c.Driver, source), index: i, pools: pools})
+	}
+	if err := db.Use(dbresolver.Register(dbresolver.Config{Replicas: replicas})); err != nil {
+		return nil, err
+	}
+
+	observe := func(db *gorm.DB) {
+		route := dbRoute{source: name, target: routePrimary}
+		// 事务内的语句已绑定主库连接，dbresolver 不再切换
+		if index, ok := pools[db.Statement.ConnPool]; ok {
+			route.target, route.index = routeReplica, index
+		}
+		ctx := db.Statement.Context
+		db.Statement.Context = context.WithValue(ctx, dbRouteKey{}, route)
+		routeCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("db.source", name),
+			attribute.String("db.route", route.target),
+		))
+	}
+	// 在 dbresolver 选定连接之后、执行 SQL 之前记录路由
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().After("gorm:db_resolver").Before("gorm:create").Register("data:db_route", observe),
+		cb.Query().After("gorm:db_resolver").Before("gorm:query").Register("data:db_route", observe),
+		cb.Update().After("gorm:db_resolver").Before("gorm:update").Register("data:db_route", observe),
+		cb.Delete().After("gorm:db_resolver").Before("gorm:delete").Register("data:db_route", observe),
+		cb.Row().After("gorm:db_resolver").Before("gorm:row").Register("data:db_route", observe),
+		cb.Raw().After("gorm:db_resolver").Before("gorm:raw").Register("data:db_route", observe),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pools, nil
+}
+
+// DataSources 额外的命名数据源（conf.Data.sources），需要访问其他数据库的仓库注入后按名称获取
+type DataSources struct {
+	dbs map[string]*gorm.DB
+}
+
+// NewDataSources 连接全部命名数据源，与主数据源相同注册插件及读写分离，不维护表结构
+func NewDataSources(c *conf.Data, l log.Logger) *DataSources {
+	s := &DataSources{dbs: make(map[string]*gorm.DB, len(c.Sources))}
+	names := make([]string, 0, len(c.Sources))
+	for name := range c.Sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == DefaultSource {
+			log.NewHelper(l).Fatalf("data source name %q is reserved", name)
+		}
+		s.dbs[name] = openDatabase(name, c.Sources[name], l)
+	}
+	return s
+}
+
+// DB 返回命名数据源，未配置时返回 false
+// 主数据源的事务（Data.InTx）不包含命名数据源；ctx 中的 WithPrimary 标记同样生效
+func (s *DataSources) DB(ctx context.Context, name string) (*gorm.DB, bool) {
+	db, ok := s.dbs[name]
+	if !ok {
+		return nil, false
+	}
+	return withRoute(ctx, db.WithContext(ctx)), true
+}
+
+/* 使用示例
+func NewReportRepo(data *Data, sources *DataSources, logger log.Logger) (biz.ReportRepo, error) {
+	if _, ok := sources.DB(context.Background(), "report"); !ok {
+		return nil, errors.New("data source report is not configured")
+	}
+	return &reportRepo{BaseRepo: NewBaseRepo(data, logger), sources: sources}, nil
+}
+
+func (r *reportRepo) OrderStats(ctx context.Context) ([]*biz.OrderStat, error) {
+	db, _ := r.sources.DB(ctx, "report")
+	var stats []*biz.OrderStat
+	err := db.Table("biz_order").Select("status, count(*) as total").Scopes(r.DataScope(ctx)).Group("status").Scan(&stats).Error
+	return stats, err
+}
+*/
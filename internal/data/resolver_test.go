@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"gorm.io/gorm"
+)
+
+// newReplicaTestData 使用两个 SQLite 文件作为主库及副本，两边的 route_marker 表分别写入 primary / replica
+// 读到的标记即语句实际使用的连接
+func newReplicaTestData(t *testing.T) *Data {
+	t.Helper()
+	dir := t.TempDir()
+	replica := filepath.Join(dir, "replica.db")
+	rdb, err := gorm.Open(openDialector("sqlite", replica), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := createRouteMarker(rdb, routeReplica); err != nil {
+		t.Fatal(err)
+	}
+	if sqlDB, err := rdb.DB(); err == nil {
+		_ = sqlDB.Close()
+	}
+
+	d := openTestData(t, &conf.Data{
+		Database: &conf.Data_Database{
+			Driver:   "sqlite",
+			Source:   filepath.Join(dir, "primary.db"),
+			Replicas: []string{replica},
+		},
+		Redis: &conf.Data_Redis{Embedded: true},
+	})
+	if err := createRouteMarker(d.DB(context.Background()), routePrimary); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func createRouteMarker(db *gorm.DB, name string) error {
+	if err := db.Exec("CREATE TABLE route_marker (name TEXT)").Error; err != nil {
+		return err
+	}
+	return db.Exec("INSERT INTO route_marker (name) VALUES (?)", name).Error
+}
+
+// readMarker 分别以原生 SQL 及查询构造读取标记，两者须路由到同一连接
+func readMarker(t *testing.T, db *gorm.DB) string {
+	t.Helper()
+	var raw, query []string
+	tx := db.Raw("SELECT name FROM route_marker").Scan(&raw)
+	if tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+	if err := db.Table("route_marker").Pluck("name", &query).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(raw) != 1 || len(query) != 1 || raw[0] != query[0] {
+		t.Fatalf("raw read %v, query read %v", raw, query)
+	}
+	return raw[0]
+}
+
+func TestReplicaRouting(t *testing.T) {
+	d := newReplicaTestData(t)
+	ctx := context.Background()
+
+	if got := readMarker(t, d.DB(ctx)); got != routeReplica {
+		t.Fatalf("read: got %s, want replica", got)
+	}
+	if got := readMarker(t, d.DB(biz.WithPrimary(ctx))); got != routePrimary {
+		t.Fatalf("read with WithPrimary: got %s, want primary", got)
+	}
+
+	// 写入使用主库，副本不受影响
+	if err := d.DB(ctx).Exec("UPDATE route_marker SET name = ?", "written").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DB(ctx).Table("route_marker").Where("1 = 1").Update("name", "updated").Error; err != nil {
+		t.Fatal(err)
+	}
+	if got := readMarker(t, d.DB(biz.WithPrimary(ctx))); got != "updated" {
+		t.Fatalf("primary after write: got %s, want updated", got)
+	}
+	if got := readMarker(t, d.DB(ctx)); got != routeReplica {
+		t.Fatalf("replica after write: got %s, want replica", got)
+	}
+
+	// 事务内的读取使用主库，包括写入之前的读取
+	errRollback := errors.New("rollback")
+	err := d.InTx(ctx, func(ctx context.Context) error {
+		if got := readMarker(t, d.DB(ctx)); got != "updated" {
+			t.Fatalf("read in tx: got %s, want updated", got)
+		}
+		if err := d.DB(ctx).Exec("UPDATE route_marker SET name = ?", "in-tx").Error; err != nil {
+			return err
+		}
+		if got := readMarker(t, d.DB(ctx)); got != "in-tx" {
+			t.Fatalf("read own write in tx: got %s, want in-tx", got)
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("InTx: %v", err)
+	}
+	if got := readMarker(t, d.DB(biz.WithPrimary(ctx))); got != "updated" {
+		t.Fatalf("primary after rollback: got %s, want updated", got)
+	}
+}
+
+// TestReplicaRouteContext 语句路由记录在 Statement.Context 中，供 SQL 日志输出
+func TestReplicaRouteContext(t *testing.T) {
+	d := newReplicaTestData(t)
+	ctx := context.Background()
+
+	routeOf := func(db *gorm.DB) string {
+		t.Helper()
+		var names []string
+		tx := db.Table("route_marker").Pluck("name", &names)
+		if tx.Error != nil {
+			t.Fatal(tx.Error)
+		}
+		r, ok := routeFromContext(tx.Statement.Context)
+		if !ok {
+			t.Fatal("statement has no route")
+		}
+		return r.String()
+	}
+
+	if got := routeOf(d.DB(ctx)); got != "default/replica-0" {
+		t.Fatalf("read: route %s", got)
+	}
+	if got := routeOf(d.DB(biz.WithPrimary(ctx))); got != "default/primary" {
+		t.Fatalf("read with WithPrimary: route %s", got)
+	}
+	err := d.InTx(ctx, func(ctx context.Context) error {
+		if got := routeOf(d.DB(ctx)); got != "default/primary" {
+			t.Fatalf("read in tx: route %s", got)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,166 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	_ biz.RoleRepo             = (*roleRepo)(nil)
+	_ provider.DataScopeLoader = (*roleRepo)(nil)
+)
+
+type roleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func newRoleRepo(data *Data, logger log.Logger) *roleRepo {
+	return &roleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func NewRoleRepo(data *Data, logger log.Logger) biz.RoleRepo {
+	return newRoleRepo(data, logger)
+}
+
+func NewDataScopeLoader(data *Data, logger log.Logger) provider.DataScopeLoader {
+	return newRoleRepo(data, logger)
+}
+
+func (r *roleRepo) GetRoleByID(ctx context.Context, id int64) (*biz.SysRole, error) {
+	var role model.SysRole
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&role).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	return &biz.SysRole{
+		ID:       role.ID,
+		TenantID: role.TenantID,
+		Name:     role.Name,
+		Code:     role.Code,
+	}, nil
+}
+
+func (r *roleRepo) ListRolesByIDs(ctx context.Context, ids []int64) ([]*biz.SysRole, error) {
+	var list []model.SysRole
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	roles := make([]*biz.SysRole, 0, len(list))
+	for _, role := range list {
+		roles = append(roles, &biz.SysRole{
+			ID:       role.ID,
+			TenantID: role.TenantID,
+			Name:     role.Name,
+			Code:     role.Code,
+		})
+	}
+	return roles, nil
+}
+
+func (r *roleRepo) ListRoles(ctx context.Context) ([]*biz.SysRole, error) {
+	var list []model.SysRole
+	if err := r.data.DB(ctx).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	roles := make([]*biz.SysRole, 0, len(list))
+	for _, role := range list {
+		roles = append(roles, &biz.SysRole{
+			ID:       role.ID,
+			TenantID: role.TenantID,
+			Name:     role.Name,
+			Code:     role.Code,
+		})
+	}
+	return roles, nil
+}
+
+func (r *roleRepo) ExistsUser(ctx context.Context, id int64) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysUser{}).Where("id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *roleRepo) CountPermissions(ctx context.Context, codes []string) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysPermission{}).Where("code IN ?", codes).Count(&count).Error
+	return count, err
+}
+
+func (r *roleRepo) CountDepts(ctx context.Context, ids []int64) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.SysDept{}).Where("id IN ?", ids).Count(&count).Error
+	return count, err
+}
+
+func (r *roleRepo) ReplaceRoleDepts(ctx context.Context, roleID int64, deptIDs []int64) error {
+	tenantID := auth.GetTenantID(ctx)
+	db := r.data.DB(ctx)
+	if err := db.Where("tenant_id = ? AND role_id = ?", tenantID, roleID).Delete(&model.SysRoleDept{}).Error; err != nil {
+		return err
+	}
+	if len(deptIDs) == 0 {
+		return nil
+	}
+	list := make([]*model.SysRoleDept, 0, len(deptIDs))
+	for _, id := range deptIDs {
+		list = append(list, &model.SysRoleDept{TenantID: tenantID, RoleID: roleID, DeptID: id})
+	}
+	return db.Create(&list).Error
+}
+
+// LockRoleParents 先锁定租户记录串行化同一租户的继承关系修改（继承关系为空或新增时行锁无法阻止插入），
+// 再锁定读取现有继承关系；SQLite 忽略锁子句，写事务本身已串行
+func (r *roleRepo) LockRoleParents(ctx context.Context) (map[int64][]int64, error) {
+	tenantID := auth.GetTenantID(ctx)
+	db := r.data.DB(ctx)
+	var ids []int64
+	if err := db.Model(&model.SysTenant{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", tenantID).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	var list []model.SysRoleInherit
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("tenant_id = ?", tenantID).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	edges := make(map[int64][]int64)
+	for _, ri := range list {
+		edges[ri.RoleID] = append(edges[ri.RoleID], ri.ParentID)
+	}
+	return edges, nil
+}
+
+func (r *roleRepo) LoadRoleDeptIDs(ctx context.Context, tenantID int64, roleCode string) ([]int64, error) {
+	// 缓存在变更后立即重新加载，读取主库
+	ctx = biz.WithPrimary(ctx)
+	var ids []int64
+	err := r.data.DB(ctx).Table("sys_role_dept rd").
+		Joins("JOIN sys_role r ON r.id = rd.role_id").
+		Where("rd.tenant_id = ? AND r.code = ? AND r.deleted_at IS NULL", tenantID, roleCode).
+		Pluck("rd.dept_id", &ids).Error
+	return ids, err
+}
+
+func (r *roleRepo) LoadSubDeptIDs(ctx context.Context, tenantID, deptID int64) ([]int64, error) {
+	// 缓存在变更后立即重新加载，读取主库
+	ctx = biz.WithPrimary(ctx)
+	var ids []int64
+	err := r.data.DB(ctx).Table("sys_dept").
+		Where("tenant_id = ? AND deleted_at IS NULL", tenantID).
+		Where(subDeptSQL, subDeptArgs(deptID)...).
+		Pluck("id", &ids).Error
+	return ids, err
+}
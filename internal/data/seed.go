@@ -0,0 +1,240 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var _ biz.SeedRepo = (*seedRepo)(nil)
+
+type seedRepo struct {
+	BaseRepo
+}
+
+func NewSeedRepo(data *Data, logger log.Logger) biz.SeedRepo {
+	return &seedRepo{BaseRepo: NewBaseRepo(data, logger)}
+}
+
+// db 初始化数据不受租户及数据范围限制
+func (r *seedRepo) db(ctx context.Context) *gorm.DB {
+	return r.data.DB(auth.WithSkipDataScope(ctx))
+}
+
+// ensure 按条件查找记录，不存在时创建 m，id 指向 m 的主键，返回后为已有或新建记录的 ID
+func (r *seedRepo) ensure(ctx context.Context, m interface{}, id *int64, query string, args ...interface{}) (bool, error) {
+	var found int64
+	err := r.db(ctx).Model(m).Select("id").Where(query, args...).Limit(1).Scan(&found).Error
+	if err != nil {
+		return false, err
+	}
+	if found != 0 {
+		*id = found
+		return false, nil
+	}
+	if err := r.db(ctx).Create(m).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *seedRepo) EnsurePackage(ctx context.Context, id int64, name string) (bool, error) {
+	m := &model.SysPackage{BaseModel: model.BaseModel{ID: id}, Name: name, Status: 1}
+	return r.ensure(ctx, m, &m.ID, "id = ?", id)
+}
+
+func (r *seedRepo) GrantPackagePermissions(ctx context.Context, packageID int64) (int, error) {
+	var ids []int64
+	err := r.db(ctx).Model(&model.SysPermission{}).
+		Where("id NOT IN (?)", r.db(ctx).Model(&model.SysPackagePermission{}).Select("permission_id").Where("package_id = ?", packageID)).
+		Order("id").
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	now := time.Now()
+	list := make([]*model.SysPackagePermission, 0, len(ids))
+	for _, permID := range ids {
+		id, err := model.NextID()
+		if err != nil {
+			return 0, err
+		}
+		list = append(list, &model.SysPackagePermission{ID: id, PackageID: packageID, PermissionID: permID, CreatedAt: now})
+	}
+	if err := r.db(ctx).Create(&list).Error; err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+func (r *seedRepo) EnsureTenant(ctx context.Context, t *biz.SeedTenant, packageID int64) (int64, bool, error) {
+	m := &model.SysTenant{
+		BaseModel: model.BaseModel{ID: t.ID},
+		Code:      t.Code,
+		Name:      t.Name,
+		PackageID: packageID,
+		Status:    1,
+	}
+	// 预置 ID 的租户（系统租户）按 ID 查找，避免编码被修改后重复创建
+	query, arg := "code = ?", interface{}(t.Code)
+	if t.ID != 0 {
+		query, arg = "id = ?", t.ID
+	}
+	created, err := r.ensure(ctx, m, &m.ID, query, arg)
+	return m.ID, created, err
+}
+
+func (r *seedRepo) EnsureDept(ctx context.Context, tenantID, parentID int64, name string, sort int32) (int64, bool, error) {
+	ancestors := "0"
+	if parentID != 0 {
+		var parent model.SysDept
+		if err := r.db(ctx).Select("id", "ancestors").First(&parent, parentID).Error; err != nil {
+			return 0, false, err
+		}
+		ancestors = fmt.Sprintf("%s,%d", parent.Ancestors, parent.ID)
+	}
+	m := &model.SysDept{
+		BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}},
+		ParentID:      parentID,
+		Name:          name,
+		Ancestors:     ancestors,
+		Sort:          sort,
+	}
+	created, err := r.ensure(ctx, m, &m.ID, "tenant_id = ? AND parent_id = ? AND name = ?", tenantID, parentID, name)
+	return m.ID, created, err
+}
+
+func (r *seedRepo) EnsureRole(ctx context.Context, tenantID int64, code, name string) (int64, bool, error) {
+	m := &model.SysRole{
+		BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}},
+		Code:          code,
+		Name:          name,
+	}
+	created, err := r.ensure(ctx, m, &m.ID, "tenant_id = ? AND code = ?", tenantID, code)
+	return m.ID, created, err
+}
+
+func (r *seedRepo) GrantRolePermissions(ctx context.Context, tenantID, roleID int64, codes []string, dataScope string) (int, error) {
+	var perms []*model.SysPermission
+	if err := r.db(ctx).Select("id", "code").Where("code IN ?", codes).Find(&perms).Error; err != nil {
+		return 0, err
+	}
+	if len(perms) != len(codes) {
+		found := make(map[string]struct{}, len(perms))
+		for _, p := range perms {
+			found[p.Code] = struct{}{}
+		}
+		for _, code := range codes {
+			if _, ok := found[code]; !ok {
+				return 0, fmt.Errorf("permission %s not found", code)
+			}
+		}
+	}
+
+	var granted []int64
+	err := r.db(ctx).Model(&model.SysRolePermission{}).
+		Where("role_id = ?", roleID).
+		Pluck("permission_id", &granted).Error
+	if err != nil {
+		return 0, err
+	}
+	exists := make(map[int64]struct{}, len(granted))
+	for _, id := range granted {
+		exists[id] = struct{}{}
+	}
+
+	var list []*model.SysRolePermission
+	for _, p := range perms {
+		if _, ok := exists[p.ID]; ok {
+			continue
+		}
+		list = append(list, &model.SysRolePermission{
+			BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}},
+			RoleID:        roleID,
+			PermissionID:  p.ID,
+			DataScope:     dataScope,
+			Effect:        "allow",
+		})
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+	if err := r.db(ctx).Create(&list).Error; err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+func (r *seedRepo) FindUserID(ctx context.Context, tenantID int64, username string) (int64, error) {
+	var user model.SysUser
+	err := r.db(ctx).Select("id").Where("tenant_id = ? AND username = ?", tenantID, username).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	return user.ID, err
+}
+
+func (r *seedRepo) CreateUser(ctx context.Context, u *biz.SysUser) error {
+	m := &model.SysUser{
+		BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: u.TenantID, DeptID: u.DeptID}},
+		Username:      u.Username,
+		PasswordHash:  u.PasswordHash,
+		Name:          u.Nickname,
+		Mobile:        u.Phone,
+		Email:         u.Email,
+		Status:        biz.UserStatusEnabled,
+	}
+	if err := r.db(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	u.ID = m.ID
+	// 清除该 ID 可能存在的“不存在”缓存
+	userCache(r.data).Delete(ctx, userCacheKeys(m.ID)...)
+	return nil
+}
+
+func (r *seedRepo) EnsureUserRole(ctx context.Context, tenantID, userID, roleID int64) (bool, error) {
+	m := &model.SysUserRole{
+		BaseAuthModel: model.BaseAuthModel{AuthField: model.AuthField{TenantID: tenantID}},
+		UserID:        userID,
+		RoleID:        roleID,
+	}
+	return r.ensure(ctx, m, &m.ID, "user_id = ? AND role_id = ?", userID, roleID)
+}
+
+func (r *seedRepo) EnsureDictType(ctx context.Context, code, name string) (bool, error) {
+	m := &model.SysDictType{Code: code, Name: name, Enabled: true}
+	return r.ensure(ctx, m, &m.ID, "code = ?", code)
+}
+
+func (r *seedRepo) EnsureDictItem(ctx context.Context, typeCode string, item *biz.SeedDictItem) (bool, error) {
+	m := &model.SysDictItem{
+		TenantID: 0,
+		TypeCode: typeCode,
+		Label:    item.Label,
+		Value:    item.Value,
+		Sort:     item.Sort,
+		Color:    item.Color,
+		Enabled:  true,
+	}
+	return r.ensure(ctx, m, &m.ID, "tenant_id = 0 AND type_code = ? AND value = ?", typeCode, item.Value)
+}
+
+func (r *seedRepo) EnsureConfig(ctx context.Context, c *biz.SeedConfig) (bool, error) {
+	m := &model.SysConfig{
+		TenantID:  0,
+		Key:       c.Key,
+		Name:      c.Name,
+		ValueType: c.ValueType,
+		Value:     c.Value,
+		Remark:    c.Remark,
+	}
+	return r.ensure(ctx, m, &m.ID, "tenant_id = 0 AND config_key = ?", c.Key)
+}
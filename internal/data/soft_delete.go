@@ -0,0 +1,52 @@
+package data
+
+import (
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const deletedByColumn = "deleted_by"
+
+// SoftDeletePlugin GORM 插件：逻辑删除包含 deleted_by 列的模型时，同时记录删除人
+//
+// 逻辑删除的 SET 子句由 gorm.DeletedAt 在 gorm:delete 中生成，合并子句时只替换表达式，
+// 因此这里预先为 SET 子句设置 Builder，在生成 SQL 时追加 deleted_by
+type SoftDeletePlugin struct{}
+
+func (SoftDeletePlugin) Name() string {
+	return "soft_delete"
+}
+
+func (p SoftDeletePlugin) Initialize(db *gorm.DB) error {
+	return db.Callback().Delete().Before("gorm:delete").Register("soft_delete:deleted_by", p.deletedBy)
+}
+
+func (SoftDeletePlugin) deletedBy(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Unscoped || stmt.Schema == nil || stmt.SQL.Len() > 0 {
+		return
+	}
+	if stmt.Schema.LookUpField(deletedByColumn) == nil {
+		return
+	}
+	uid := auth.GetUserID(stmt.Context)
+	if uid == 0 {
+		return
+	}
+
+	c := stmt.Clauses["SET"]
+	c.Name = "SET"
+	c.Builder = func(c clause.Clause, builder clause.Builder) {
+		if c.Expression == nil {
+			return
+		}
+		builder.WriteString("SET ")
+		c.Expression.Build(builder)
+		builder.WriteByte(',')
+		builder.WriteQuoted(deletedByColumn)
+		builder.WriteByte('=')
+		builder.AddVar(builder, uid)
+	}
+	stmt.Clauses["SET"] = c
+}
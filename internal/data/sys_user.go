@@ -2,11 +2,15 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"gorm.io/gorm"
 )
 
@@ -14,18 +18,45 @@ var _ biz.SysUserRepo = (*sysUserRepo)(nil)
 
 type sysUserRepo struct {
 	BaseRepo
-	data *Data
-	log  *log.Helper
+	data  *Data
+	users *Cache[*biz.SysUser]
+	log   *log.Helper
 }
 
 func NewSysUserRepo(data *Data, logger log.Logger) biz.SysUserRepo {
 	return &sysUserRepo{
 		BaseRepo: NewBaseRepo(data, logger),
 		data:     data,
+		users:    userCache(data),
 		log:      log.NewHelper(logger),
 	}
 }
 
+// userCache 按 ID 缓存用户，不缓存密码哈希（需要时在事务内或以 biz.WithPrimary 读取）
+func userCache(data *Data) *Cache[*biz.SysUser] {
+	return sharedCache(data, "user", CacheConfig[*biz.SysUser]{
+		TTL:         10 * time.Minute,
+		NotFound:    biz.ErrUserNotFound,
+		NegativeTTL: time.Minute,
+		LocalSize:   1024,
+		LocalTTL:    5 * time.Second,
+		Encode: func(u *biz.SysUser) ([]byte, error) {
+			v := *u
+			v.PasswordHash = ""
+			return json.Marshal(&v)
+		},
+	})
+}
+
+// userCacheKeys 用户缓存的 key
+func userCacheKeys(ids ...int64) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, strconv.FormatInt(id, 10))
+	}
+	return keys
+}
+
 func (r *sysUserRepo) CreateUser(ctx context.Context, u *biz.SysUser) (*biz.SysUser, error) {
 	status := int16(2)
 	if u.IsAvailable {
@@ -35,6 +66,7 @@ func (r *sysUserRepo) CreateUser(ctx context.Context, u *biz.SysUser) (*biz.SysU
 		Username:     u.Username,
 		PasswordHash: u.PasswordHash,
 		Mobile:       u.Phone,
+		Email:        u.Email,
 		Name:         u.Nickname,
 		Status:       status,
 		BaseAuthModel: model.BaseAuthModel{
@@ -48,6 +80,8 @@ func (r *sysUserRepo) CreateUser(ctx context.Context, u *biz.SysUser) (*biz.SysU
 	if err := r.data.DB(ctx).Create(user).Error; err != nil {
 		return nil, err
 	}
+	// 清除该 ID 可能存在的“不存在”缓存
+	r.users.Delete(ctx, userCacheKeys(user.ID)...)
 
 	return r.toBiz(user), nil
 }
@@ -74,29 +108,145 @@ func (r *sysUserRepo) GetUserByPhone(ctx context.Context, phone string) (*biz.Sy
 	return r.toBiz(&user), nil
 }
 
+// GetUserByID 按 ID 查询用户（经缓存），校验租户及数据范围
 func (r *sysUserRepo) GetUserByID(ctx context.Context, id int64) (*biz.SysUser, error) {
-	var user model.SysUser
-	if err := r.data.DB(ctx).Where("id = ?", id).First(&user).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, biz.ErrUserNotFound
+	user, err := r.users.Get(ctx, strconv.FormatInt(id, 10), func(ctx context.Context) (*biz.SysUser, error) {
+		// 缓存的结果与调用方无关，租户在读取后校验
+		var user model.SysUser
+		if err := r.data.DB(auth.WithSkipDataScope(ctx)).Where("id = ?", id).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, biz.ErrUserNotFound
+			}
+			return nil, err
 		}
+		return r.toBiz(&user), nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return r.toBiz(&user), nil
+	if auth.IsSkipDataScope(ctx) {
+		return user, nil
+	}
+	info := auth.GetContextInfo(ctx)
+	if user.TenantID != info.TenantID {
+		return nil, biz.ErrUserNotFound
+	}
+	// 数据范围受限时按主键查询一次，由数据权限插件判断是否可见
+	if info.DataScope != "" && info.DataScope != auth.ScopeAll {
+		var count int64
+		if err := r.data.DB(ctx).Model(&model.SysUser{}).Where("id = ?", id).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, biz.ErrUserNotFound
+		}
+	}
+	return user, nil
 }
 
 func (r *sysUserRepo) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
-	return r.data.DB(ctx).
+	err := r.data.DB(ctx).
 		Model(&model.SysUser{}).
 		Where("id = ?", id).
 		Update("password_hash", passwordHash).Error
+	if err == nil {
+		r.users.Delete(ctx, userCacheKeys(id)...)
+	}
+	return err
 }
 
 func (r *sysUserRepo) UpdatePhone(ctx context.Context, id int64, phone string) error {
-	return r.data.DB(ctx).
+	err := r.data.DB(ctx).
 		Model(&model.SysUser{}).
 		Where("id = ?", id).
 		Update("mobile", phone).Error
+	if err == nil {
+		r.users.Delete(ctx, userCacheKeys(id)...)
+	}
+	return err
+}
+
+func (r *sysUserRepo) ExistingUsernames(ctx context.Context, usernames []string) ([]string, error) {
+	return r.existing(ctx, "username", usernames)
+}
+
+func (r *sysUserRepo) ExistingPhones(ctx context.Context, phones []string) ([]string, error) {
+	return r.existing(ctx, "mobile", phones)
+}
+
+// existing 查询租户内已被占用的唯一字段值，唯一性不受数据范围限制
+func (r *sysUserRepo) existing(ctx context.Context, column string, values []string) ([]string, error) {
+	var taken []string
+	if len(values) == 0 {
+		return taken, nil
+	}
+	err := r.data.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysUser{}).
+		Scopes(r.TenantScope(ctx)).
+		Where(column+" IN ?", values).
+		Pluck(column, &taken).Error
+	return taken, err
+}
+
+func (r *sysUserRepo) CountUsers(ctx context.Context, filter *biz.UserFilter) (int64, error) {
+	var total int64
+	err := r.data.DB(ctx).Model(&model.SysUser{}).Scopes(r.filter(filter)).Count(&total).Error
+	return total, err
+}
+
+func (r *sysUserRepo) FindUsers(ctx context.Context, filter *biz.UserFilter, limit int) ([]*biz.SysUser, error) {
+	var list []*model.SysUser
+	if err := r.data.DB(ctx).Scopes(r.filter(filter), r.SortBy("id", true)).Limit(limit).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	users := make([]*biz.SysUser, 0, len(list))
+	for _, u := range list {
+		users = append(users, r.toBiz(u))
+	}
+	return users, nil
+}
+
+func (r *sysUserRepo) ListUserRoleCodes(ctx context.Context, userIDs []int64) (map[int64][]string, error) {
+	codes := make(map[int64][]string)
+	if len(userIDs) == 0 {
+		return codes, nil
+	}
+	var rows []struct {
+		UserID int64
+		Code   string
+	}
+	err := r.data.DB(auth.WithSkipDataScope(ctx)).Table("sys_user_role ur").
+		Select("ur.user_id, r.code").
+		Joins("JOIN sys_role r ON r.id = ur.role_id AND r.deleted_at IS NULL").
+		Where("ur.tenant_id = ? AND ur.user_id IN ? AND ur.deleted_at IS NULL", auth.GetTenantID(ctx), userIDs).
+		Where("ur.expire_at IS NULL OR ur.expire_at > ?", time.Now()).
+		Order("ur.user_id, r.code").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		codes[row.UserID] = append(codes[row.UserID], row.Code)
+	}
+	return codes, nil
+}
+
+func (r *sysUserRepo) filter(f *biz.UserFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.Keyword != "" {
+			like := "%" + f.Keyword + "%"
+			db = db.Where("username LIKE ? OR name LIKE ? OR mobile LIKE ?", like, like, like)
+		}
+		if f.DeptID > 0 {
+			db = db.Where("dept_id IN (SELECT id FROM sys_dept WHERE "+subDeptSQL+")", subDeptArgs(f.DeptID)...)
+		}
+		switch f.Status {
+		case biz.UserStatusEnabled:
+			db = db.Where("status = ?", 1)
+		case biz.UserStatusDisabled:
+			db = db.Where("status <> ?", 1)
+		}
+		return db
+	}
 }
 
 func (r *sysUserRepo) toBiz(u *model.SysUser) *biz.SysUser {
@@ -105,6 +255,7 @@ func (r *sysUserRepo) toBiz(u *model.SysUser) *biz.SysUser {
 		Username:     u.Username,
 		PasswordHash: u.PasswordHash,
 		Phone:        u.Mobile,
+		Email:        u.Email,
 		Nickname:     u.Name,
 		DeptID:       u.DeptID,
 		TenantID:     u.TenantID,
@@ -2,24 +2,70 @@ package data
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+)
+
+const (
+	tenantExpireNoticeLockKey    = "tenant:expire_notice:lock"
+	tenantExpireNoticeKeyPattern = "tenant:expire_notice:%d:%d"
+)
+
+// unlockScript 仅当锁仍由自己持有时释放
+var unlockScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`)
+
+var (
+	_ biz.TenantRepo         = (*tenantRepo)(nil)
+	_ provider.PackageLoader = (*tenantRepo)(nil)
+	_ provider.TenantLoader  = (*tenantRepo)(nil)
 )
 
 type tenantRepo struct {
-	data *Data
-	log  *log.Helper
+	data    *Data
+	tenants *Cache[*biz.SysTenant]
+	log     *log.Helper
 }
 
-func NewTenantRepo(data *Data, logger log.Logger) provider.PackageLoader {
+func newTenantRepo(data *Data, logger log.Logger) *tenantRepo {
 	return &tenantRepo{
 		data: data,
-		log:  log.NewHelper(logger),
+		// 按 ID 缓存租户，租户状态的权威来源仍是 provider.TenantProvider
+		tenants: sharedCache(data, "tenant", CacheConfig[*biz.SysTenant]{
+			TTL:         10 * time.Minute,
+			NotFound:    biz.ErrTenantNotFound,
+			NegativeTTL: time.Minute,
+			LocalSize:   256,
+			LocalTTL:    5 * time.Second,
+		}),
+		log: log.NewHelper(logger),
 	}
 }
 
+func NewTenantRepo(data *Data, logger log.Logger) biz.TenantRepo {
+	return newTenantRepo(data, logger)
+}
+
+func NewPackageLoader(data *Data, logger log.Logger) provider.PackageLoader {
+	return newTenantRepo(data, logger)
+}
+
+func NewTenantLoader(data *Data, logger log.Logger) provider.TenantLoader {
+	return newTenantRepo(data, logger)
+}
+
 func (r *tenantRepo) LoadAllTenantPackagePerms(ctx context.Context) (map[int64][]string, error) {
+	// 缓存在变更后立即重新加载，读取主库
+	ctx = biz.WithPrimary(ctx)
 	// 定义内部临时结构体，用于接收联表查询结果
 	type Row struct {
 		TenantID int64  `gorm:"column:tenant_id"`
@@ -37,6 +83,8 @@ func (r *tenantRepo) LoadAllTenantPackagePerms(ctx context.Context) (map[int64][
 		Joins("JOIN sys_permission p ON pp.permission_id = p.id").
 		// 过滤已软删除的记录（假设 sys_tenant 和 sys_permission 使用了 BaseModel）
 		Where("t.deleted_at IS NULL AND p.deleted_at IS NULL").
+		// 已禁用的租户不再拥有任何套餐权限
+		Where("t.status <> ?", provider.TenantStatusDisabled).
 		Scan(&rows).Error
 
 	if err != nil {
@@ -74,3 +122,132 @@ func (r *tenantRepo) LoadAllTenantPackagePerms(ctx context.Context) (map[int64][
 
 	return result, nil
 }
+
+func (r *tenantRepo) LoadAllTenants(ctx context.Context) ([]provider.TenantState, error) {
+	// 缓存在变更后立即重新加载，读取主库
+	ctx = biz.WithPrimary(ctx)
+	var list []model.SysTenant
+	if err := r.data.DB(ctx).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	result := make([]provider.TenantState, 0, len(list))
+	for _, t := range list {
+		result = append(result, provider.TenantState{
+			ID:         t.ID,
+			Code:       t.Code,
+			Status:     t.Status,
+			ExpireTime: timeValue(t.ExpireTime),
+		})
+	}
+	return result, nil
+}
+
+// GetTenantByID 按 ID 查询租户（经缓存）
+func (r *tenantRepo) GetTenantByID(ctx context.Context, id int64) (*biz.SysTenant, error) {
+	return r.tenants.Get(ctx, strconv.FormatInt(id, 10), func(ctx context.Context) (*biz.SysTenant, error) {
+		var t model.SysTenant
+		if err := r.data.DB(ctx).Where("id = ?", id).First(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, biz.ErrTenantNotFound
+			}
+			return nil, err
+		}
+		return r.toBiz(&t), nil
+	})
+}
+
+func (r *tenantRepo) UpdateStatus(ctx context.Context, id int64, status int16, version int64) (int64, error) {
+	return r.update(ctx, id, version, "status", status)
+}
+
+func (r *tenantRepo) UpdateExpireTime(ctx context.Context, id int64, expireTime time.Time, version int64) (int64, error) {
+	return r.update(ctx, id, version, "expire_time", nullTime(expireTime))
+}
+
+// update 以带版本号的模型更新，由 OptimisticLockPlugin 校验版本
+func (r *tenantRepo) update(ctx context.Context, id, version int64, column string, value interface{}) (int64, error) {
+	m := &model.SysTenant{BaseModel: model.BaseModel{ID: id, Version: version}}
+	if err := r.data.DB(ctx).Model(m).Update(column, value).Error; err != nil {
+		return 0, err
+	}
+	r.tenants.Delete(ctx, strconv.FormatInt(id, 10))
+	if version != 0 {
+		return m.Version, nil
+	}
+	// 未校验版本时重新读取
+	var current int64
+	err := r.data.DB(ctx).Model(&model.SysTenant{}).Where("id = ?", id).Pluck("version", &current).Error
+	return current, err
+}
+
+func (r *tenantRepo) ListExpiringTenants(ctx context.Context, from, to time.Time) ([]*biz.SysTenant, error) {
+	var list []model.SysTenant
+	err := r.data.DB(ctx).
+		Where("status = ?", provider.TenantStatusNormal).
+		Where("expire_time >= ? AND expire_time < ?", from, to).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*biz.SysTenant, 0, len(list))
+	for i := range list {
+		result = append(result, r.toBiz(&list[i]))
+	}
+	return result, nil
+}
+
+func (r *tenantRepo) ListTenantAdminEmails(ctx context.Context, tenantID int64) ([]string, error) {
+	var emails []string
+	err := r.data.DB(ctx).Table("sys_user u").
+		Distinct("u.email").
+		Joins("JOIN sys_user_role ur ON ur.user_id = u.id").
+		Joins("JOIN sys_role r ON r.id = ur.role_id").
+		Where("u.tenant_id = ? AND r.code = ?", tenantID, "admin").
+		Where("u.deleted_at IS NULL AND r.deleted_at IS NULL AND ur.deleted_at IS NULL").
+		// 已到期的角色授权不再视为管理员
+		Where("ur.expire_at IS NULL OR ur.expire_at > ?", time.Now()).
+		Where("u.email IS NOT NULL AND u.email <> ''").
+		Pluck("u.email", &emails).Error
+	return emails, err
+}
+
+func (r *tenantRepo) toBiz(t *model.SysTenant) *biz.SysTenant {
+	return &biz.SysTenant{
+		ID:         t.ID,
+		Code:       t.Code,
+		Name:       t.Name,
+		PackageID:  t.PackageID,
+		ExpireTime: timeValue(t.ExpireTime),
+		Status:     t.Status,
+		UpdatedBy:  t.UpdatedBy,
+		UpdatedAt:  t.UpdatedAt,
+		Version:    t.Version,
+	}
+}
+
+func (r *tenantRepo) LockExpireNotice(ctx context.Context, ttl time.Duration) (func(), bool, error) {
+	token := uuid.NewString()
+	ok, err := r.data.RDB().SetNX(ctx, tenantExpireNoticeLockKey, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return func() {
+		if err := unlockScript.Run(context.WithoutCancel(ctx), r.data.RDB(), []string{tenantExpireNoticeLockKey}, token).Err(); err != nil {
+			r.log.Errorf("release tenant expire notice lock failed: %v", err)
+		}
+	}, true, nil
+}
+
+func (r *tenantRepo) IsExpireNotified(ctx context.Context, tenantID int64, expireTime time.Time) (bool, error) {
+	n, err := r.data.RDB().Exists(ctx, expireNoticeKey(tenantID, expireTime)).Result()
+	return n > 0, err
+}
+
+func (r *tenantRepo) MarkExpireNotified(ctx context.Context, tenantID int64, expireTime time.Time, ttl time.Duration) error {
+	return r.data.RDB().Set(ctx, expireNoticeKey(tenantID, expireTime), 1, ttl).Err()
+}
+
+// expireNoticeKey 标记按过期时间区分，续期后进入新的提醒周期
+func expireNoticeKey(tenantID int64, expireTime time.Time) string {
+	return fmt.Sprintf(tenantExpireNoticeKeyPattern, tenantID, expireTime.Unix())
+}
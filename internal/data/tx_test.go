@@ -0,0 +1,249 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+var errTxTest = errors.New("tx test")
+
+// txProbe 事务测试使用的表及回调记录
+type txProbe struct {
+	t      *testing.T
+	d      *Data
+	events []string
+}
+
+func newTxProbe(t *testing.T) *txProbe {
+	t.Helper()
+	d := newTestData(t)
+	if err := d.DB(context.Background()).Exec("CREATE TABLE tx_probe (name TEXT)").Error; err != nil {
+		t.Fatal(err)
+	}
+	return &txProbe{t: t, d: d}
+}
+
+// insert 写入一行并注册提交、回滚回调
+func (p *txProbe) insert(ctx context.Context, name string) {
+	p.t.Helper()
+	if err := p.d.DB(ctx).Exec("INSERT INTO tx_probe (name) VALUES (?)", name).Error; err != nil {
+		p.t.Fatal(err)
+	}
+	biz.AfterCommit(ctx, func(context.Context) { p.events = append(p.events, "commit "+name) })
+	biz.AfterRollback(ctx, func(context.Context) { p.events = append(p.events, "rollback "+name) })
+}
+
+// rows 在事务外读取全部行
+func (p *txProbe) rows() []string {
+	p.t.Helper()
+	var names []string
+	if err := p.d.DB(context.Background()).Raw("SELECT name FROM tx_probe ORDER BY name").Scan(&names).Error; err != nil {
+		p.t.Fatal(err)
+	}
+	return names
+}
+
+func (p *txProbe) check(wantRows, wantEvents []string) {
+	p.t.Helper()
+	if got := p.rows(); !slices.Equal(got, wantRows) {
+		p.t.Fatalf("rows = %v, want %v", got, wantRows)
+	}
+	if !slices.Equal(p.events, wantEvents) {
+		p.t.Fatalf("events = %v, want %v", p.events, wantEvents)
+	}
+}
+
+func TestInTxJoinsOuter(t *testing.T) {
+	p := newTxProbe(t)
+	ctx := context.Background()
+
+	err := p.d.InTx(ctx, func(ctx context.Context) error {
+		p.insert(ctx, "a")
+		// 内层 InTx 加入外层事务，返回的错误由外层决定是否回滚
+		err := p.d.InTx(ctx, func(ctx context.Context) error {
+			p.insert(ctx, "b")
+			return errTxTest
+		})
+		if !errors.Is(err, errTxTest) {
+			t.Fatalf("inner InTx: %v", err)
+		}
+		if len(p.events) != 0 {
+			t.Fatalf("hooks ran before the outer tx ended: %v", p.events)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.check([]string{"a", "b"}, []string{"commit a", "commit b"})
+
+	p.events = nil
+	err = p.d.InTx(ctx, func(ctx context.Context) error {
+		p.insert(ctx, "c")
+		return p.d.InTx(ctx, func(ctx context.Context) error {
+			p.insert(ctx, "d")
+			return errTxTest
+		})
+	})
+	if !errors.Is(err, errTxTest) {
+		t.Fatalf("InTx: %v", err)
+	}
+	p.check([]string{"a", "b"}, []string{"rollback c", "rollback d"})
+}
+
+func TestInNestedTxSavepoint(t *testing.T) {
+	t.Run("rollback savepoint, commit outer", func(t *testing.T) {
+		p := newTxProbe(t)
+		err := p.d.InTx(context.Background(), func(ctx context.Context) error {
+			p.insert(ctx, "a")
+			err := p.d.InNestedTx(ctx, func(ctx context.Context) error {
+				p.insert(ctx, "b")
+				return errTxTest
+			})
+			if !errors.Is(err, errTxTest) {
+				t.Fatalf("InNestedTx: %v", err)
+			}
+			// 保存点回滚时立即执行其回滚回调，丢弃提交回调
+			if !slices.Equal(p.events, []string{"rollback b"}) {
+				t.Fatalf("events after savepoint rollback = %v", p.events)
+			}
+			p.insert(ctx, "c")
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.check([]string{"a", "c"}, []string{"rollback b", "commit a", "commit c"})
+	})
+
+	t.Run("release savepoint, rollback outer", func(t *testing.T) {
+		p := newTxProbe(t)
+		err := p.d.InTx(context.Background(), func(ctx context.Context) error {
+			p.insert(ctx, "a")
+			if err := p.d.InNestedTx(ctx, func(ctx context.Context) error {
+				p.insert(ctx, "b")
+				return nil
+			}); err != nil {
+				t.Fatalf("InNestedTx: %v", err)
+			}
+			// 保存点释放后回调移交外层，尚未执行
+			if len(p.events) != 0 {
+				t.Fatalf("hooks ran after savepoint release: %v", p.events)
+			}
+			return errTxTest
+		})
+		if !errors.Is(err, errTxTest) {
+			t.Fatalf("InTx: %v", err)
+		}
+		p.check(nil, []string{"rollback a", "rollback b"})
+	})
+
+	t.Run("without outer tx", func(t *testing.T) {
+		p := newTxProbe(t)
+		err := p.d.InNestedTx(context.Background(), func(ctx context.Context) error {
+			p.insert(ctx, "a")
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.check([]string{"a"}, []string{"commit a"})
+	})
+}
+
+func TestAfterCommit(t *testing.T) {
+	p := newTxProbe(t)
+	ctx := context.Background()
+
+	// 不在事务中时立即执行
+	p.insert(ctx, "a")
+	p.check([]string{"a"}, []string{"commit a"})
+
+	p.events = nil
+	err := p.d.InTx(ctx, func(ctx context.Context) error {
+		p.insert(ctx, "b")
+		err := p.d.InNestedTx(ctx, func(ctx context.Context) error {
+			p.insert(ctx, "c")
+			return p.d.InNestedTx(ctx, func(ctx context.Context) error {
+				p.insert(ctx, "d")
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if len(p.events) != 0 {
+			t.Fatalf("hooks ran before the outermost commit: %v", p.events)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.check([]string{"a", "b", "c", "d"}, []string{"commit b", "commit c", "commit d"})
+
+	// 回调收到的 ctx 不再包含事务，可以直接读写
+	p.events = nil
+	var seen []string
+	err = p.d.InTx(ctx, func(ctx context.Context) error {
+		p.insert(ctx, "e")
+		biz.AfterCommit(ctx, func(ctx context.Context) {
+			if err := p.d.DB(ctx).Raw("SELECT name FROM tx_probe ORDER BY name").Scan(&seen).Error; err != nil {
+				t.Error(err)
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(seen, []string{"a", "b", "c", "d", "e"}) {
+		t.Fatalf("rows seen by hook = %v", seen)
+	}
+}
+
+func TestInNestedTxPanic(t *testing.T) {
+	t.Run("propagates to outer", func(t *testing.T) {
+		p := newTxProbe(t)
+		func() {
+			defer func() {
+				if r := recover(); r != errTxTest {
+					t.Fatalf("recovered %v, want panic to propagate", r)
+				}
+			}()
+			_ = p.d.InTx(context.Background(), func(ctx context.Context) error {
+				p.insert(ctx, "a")
+				return p.d.InNestedTx(ctx, func(ctx context.Context) error {
+					p.insert(ctx, "b")
+					panic(errTxTest)
+				})
+			})
+		}()
+		p.check(nil, []string{"rollback b", "rollback a"})
+	})
+
+	t.Run("recovered by outer", func(t *testing.T) {
+		p := newTxProbe(t)
+		err := p.d.InTx(context.Background(), func(ctx context.Context) error {
+			p.insert(ctx, "a")
+			func() {
+				defer func() { _ = recover() }()
+				_ = p.d.InNestedTx(ctx, func(ctx context.Context) error {
+					p.insert(ctx, "b")
+					panic(errTxTest)
+				})
+			}()
+			// 保存点已回滚，外层事务仍可继续使用
+			p.insert(ctx, "c")
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.check([]string{"a", "c"}, []string{"rollback b", "commit a", "commit c"})
+	})
+}
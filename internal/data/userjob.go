@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"gorm.io/gorm"
+)
+
+var _ biz.UserJobRepo = (*userJobRepo)(nil)
+
+type userJobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserJobRepo(data *Data, logger log.Logger) biz.UserJobRepo {
+	return &userJobRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *userJobRepo) CreateUserJob(ctx context.Context, job *biz.UserJob) error {
+	m := &model.SysUserJob{
+		Type:    job.Type,
+		Status:  job.Status,
+		DryRun:  job.DryRun,
+		Format:  job.Format,
+		FileKey: job.FileKey,
+		Params:  job.Params,
+	}
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	job.ID = m.ID
+	job.CreatedBy = m.CreatedBy
+	job.CreatedAt = m.CreatedAt
+	return nil
+}
+
+func (r *userJobRepo) GetUserJob(ctx context.Context, id int64) (*biz.UserJob, error) {
+	var m model.SysUserJob
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrUserJobNotFound
+		}
+		return nil, err
+	}
+	job := &biz.UserJob{
+		ID:        m.ID,
+		Type:      m.Type,
+		Status:    m.Status,
+		DryRun:    m.DryRun,
+		Format:    m.Format,
+		FileKey:   m.FileKey,
+		ResultKey: m.ResultKey,
+		Params:    m.Params,
+		Total:     m.Total,
+		Succeeded: m.Succeeded,
+		Failed:    m.Failed,
+		Message:   m.Message,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.FinishedAt != nil {
+		job.FinishedAt = *m.FinishedAt
+	}
+	return job, nil
+}
+
+func (r *userJobRepo) StartUserJob(ctx context.Context, id int64) (bool, error) {
+	res := r.data.DB(ctx).Model(&model.SysUserJob{}).
+		Where("id = ? AND status = ?", id, biz.UserJobPending).
+		Update("status", biz.UserJobRunning)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *userJobRepo) UpdateUserJob(ctx context.Context, job *biz.UserJob) error {
+	var finishedAt *time.Time
+	if !job.FinishedAt.IsZero() {
+		finishedAt = &job.FinishedAt
+	}
+	return r.data.DB(ctx).Model(&model.SysUserJob{}).
+		Where("id = ?", job.ID).
+		Updates(map[string]interface{}{
+			"status":      job.Status,
+			"result_key":  job.ResultKey,
+			"total":       job.Total,
+			"succeeded":   job.Succeeded,
+			"failed":      job.Failed,
+			"message":     job.Message,
+			"finished_at": finishedAt,
+		}).Error
+}
+
+func (r *userJobRepo) FailStaleUserJobs(ctx context.Context, before time.Time, message string) (int64, error) {
+	res := r.data.DB(auth.WithSkipDataScope(ctx)).Model(&model.SysUserJob{}).
+		Where("status IN ? AND updated_at < ?", []int16{biz.UserJobPending, biz.UserJobRunning}, before).
+		Updates(map[string]interface{}{
+			"status":      biz.UserJobFailed,
+			"message":     message,
+			"finished_at": time.Now(),
+		})
+	return res.RowsAffected, res.Error
+}
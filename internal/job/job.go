@@ -4,4 +4,10 @@ import "github.com/google/wire"
 
 var ProviderSet = wire.NewSet(
 	NewHelloJob,
+	NewTenantRefreshJob,
+	NewTenantExpireNoticeJob,
+	NewUserRoleExpireJob,
+	NewOperLogCleanupJob,
+	NewUserJobTimeoutJob,
+	NewRecycleCleanupJob,
 )
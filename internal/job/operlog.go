@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
+)
+
+var _ cron.Job = (*OperLogCleanupJob)(nil)
+
+// OperLogCleanupJob 清理超出保留期的操作日志
+type OperLogCleanupJob struct {
+	cron.BaseJob
+	uc  *biz.OperLogUseCase
+	log *log.Helper
+}
+
+func NewOperLogCleanupJob(uc *biz.OperLogUseCase, logger log.Logger) *OperLogCleanupJob {
+	return &OperLogCleanupJob{
+		BaseJob: cron.BaseJob{
+			JobName: "OperLogCleanupJob",
+			JobSpec: cron.DailyAt(3, 0, 0),
+			JobDesc: "清理过期操作日志",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j OperLogCleanupJob) Run() {
+	n, err := j.uc.Cleanup(auth.WithSkipDataScope(context.Background()))
+	if err != nil {
+		j.log.Errorf("cleanup oper logs failed: %v", err)
+		return
+	}
+	if n > 0 {
+		j.log.Infof("cleaned up %d oper logs", n)
+	}
+}
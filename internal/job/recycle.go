@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
+)
+
+var _ cron.Job = (*RecycleCleanupJob)(nil)
+
+// RecycleCleanupJob 彻底删除超出保留期的回收站数据
+type RecycleCleanupJob struct {
+	cron.BaseJob
+	uc  *biz.RecycleUseCase
+	log *log.Helper
+}
+
+func NewRecycleCleanupJob(uc *biz.RecycleUseCase, logger log.Logger) *RecycleCleanupJob {
+	return &RecycleCleanupJob{
+		BaseJob: cron.BaseJob{
+			JobName: "RecycleCleanupJob",
+			JobSpec: cron.DailyAt(3, 30, 0),
+			JobDesc: "清理过期回收站数据",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j RecycleCleanupJob) Run() {
+	n, err := j.uc.Cleanup(auth.WithSkipDataScope(context.Background()))
+	if err != nil {
+		j.log.Errorf("cleanup recycle bin failed: %v", err)
+		return
+	}
+	if n > 0 {
+		j.log.Infof("purged %d expired records from recycle bin", n)
+	}
+}
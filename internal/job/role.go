@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
+)
+
+var _ cron.Job = (*UserRoleExpireJob)(nil)
+
+// UserRoleExpireJob 定时回收已到期的用户角色
+type UserRoleExpireJob struct {
+	cron.BaseJob
+	uc  *biz.RoleUseCase
+	log *log.Helper
+}
+
+func NewUserRoleExpireJob(uc *biz.RoleUseCase, logger log.Logger) *UserRoleExpireJob {
+	return &UserRoleExpireJob{
+		BaseJob: cron.BaseJob{
+			JobName: "UserRoleExpireJob",
+			JobSpec: cron.EveryMinuteSpec,
+			JobDesc: "回收到期的用户角色",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j UserRoleExpireJob) Run() {
+	n, err := j.uc.PurgeExpiredUserRoles(auth.WithSkipDataScope(context.Background()))
+	if err != nil {
+		j.log.Errorf("purge expired user roles failed: %v", err)
+		return
+	}
+	if n > 0 {
+		j.log.Infof("purged %d expired user roles", n)
+	}
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
+)
+
+var (
+	_ cron.Job = (*TenantRefreshJob)(nil)
+	_ cron.Job = (*TenantExpireNoticeJob)(nil)
+)
+
+// TenantRefreshJob 定时刷新租户状态缓存，保证多实例间最终一致
+type TenantRefreshJob struct {
+	cron.BaseJob
+	uc  *biz.TenantUseCase
+	log *log.Helper
+}
+
+func NewTenantRefreshJob(uc *biz.TenantUseCase, logger log.Logger) *TenantRefreshJob {
+	return &TenantRefreshJob{
+		BaseJob: cron.BaseJob{
+			JobName: "TenantRefreshJob",
+			JobSpec: cron.EveryMinuteSpec,
+			JobDesc: "刷新租户状态缓存",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j TenantRefreshJob) Run() {
+	if err := j.uc.Refresh(auth.WithSkipDataScope(context.Background())); err != nil {
+		j.log.Errorf("refresh tenants failed: %v", err)
+	}
+}
+
+// TenantExpireNoticeJob 租户到期前邮件提醒
+type TenantExpireNoticeJob struct {
+	cron.BaseJob
+	uc  *biz.TenantUseCase
+	log *log.Helper
+}
+
+func NewTenantExpireNoticeJob(uc *biz.TenantUseCase, logger log.Logger) *TenantExpireNoticeJob {
+	return &TenantExpireNoticeJob{
+		BaseJob: cron.BaseJob{
+			JobName: "TenantExpireNoticeJob",
+			JobSpec: cron.DailyAt(9, 0, 0),
+			JobDesc: "租户到期提醒",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j TenantExpireNoticeJob) Run() {
+	if err := j.uc.NotifyExpiring(auth.WithSkipDataScope(context.Background())); err != nil {
+		j.log.Errorf("notify expiring tenants failed: %v", err)
+	}
+}
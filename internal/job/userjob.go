@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/cron"
+)
+
+var _ cron.Job = (*UserJobTimeoutJob)(nil)
+
+// UserJobTimeoutJob 将因节点重启等原因中断的导入导出任务置为失败
+type UserJobTimeoutJob struct {
+	cron.BaseJob
+	uc  *biz.UserJobUseCase
+	log *log.Helper
+}
+
+func NewUserJobTimeoutJob(uc *biz.UserJobUseCase, logger log.Logger) *UserJobTimeoutJob {
+	return &UserJobTimeoutJob{
+		BaseJob: cron.BaseJob{
+			JobName: "UserJobTimeoutJob",
+			JobSpec: cron.EveryFiveMinutesSpec,
+			JobDesc: "标记中断的用户导入导出任务",
+		},
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (j UserJobTimeoutJob) Run() {
+	n, err := j.uc.FailStaleJobs(auth.WithSkipDataScope(context.Background()))
+	if err != nil {
+		j.log.Errorf("fail stale user jobs failed: %v", err)
+		return
+	}
+	if n > 0 {
+		j.log.Warnf("marked %d stale user jobs as failed", n)
+	}
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Record 一次操作的审计记录
+type Record struct {
+	TenantID  int64
+	UserID    int64
+	DeptID    int64
+	IP        string
+	Operation string   // 接口 Operation，如 /api.role.v1.Role/GrantRolePermissions
+	Name      string   // 操作名称，取自 proto 注解 (bubble.auth) 的 name
+	PermCodes []string // 接口关联的权限码
+	Method    string   // HTTP 方法
+	Path      string   // 请求路径
+	Request   string   // 已脱敏的请求参数 (JSON)
+	Code      int32    // 结果码，成功为 200
+	Reason    string   // 错误原因
+	Latency   time.Duration
+	Diffs     []Diff // 本次请求产生的数据变更
+	CreatedAt time.Time
+}
+
+// Diff 单行数据的变更
+type Diff struct {
+	Table   string   `json:"table"`
+	ID      int64    `json:"id"`
+	Changes []Change `json:"changes"`
+}
+
+// Change 单个字段的变更前后值
+type Change struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Writer 审计记录写入器，实现方需保证 Write 不阻塞请求
+type Writer interface {
+	Write(r *Record)
+}
+
+// ChainHash 哈希链的一环：sha256(prev + content)
+func ChainHash(prev string, content []byte) string {
+	h := sha256.New()
+	h.Write([]byte(prev))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type collectorKey struct{}
+
+// collector 收集一次请求内的数据变更
+type collector struct {
+	mu    sync.Mutex
+	diffs []Diff
+}
+
+// withCollector 开启变更收集，仅审计中间件调用
+func withCollector(ctx context.Context) (context.Context, *collector) {
+	c := &collector{}
+	return context.WithValue(ctx, collectorKey{}, c), c
+}
+
+// Collecting 当前请求是否需要记录数据变更
+func Collecting(ctx context.Context) bool {
+	_, ok := ctx.Value(collectorKey{}).(*collector)
+	return ok
+}
+
+// AddDiff 记录数据变更，未开启收集时忽略
+func AddDiff(ctx context.Context, diffs ...Diff) {
+	c, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	c.diffs = append(c.diffs, diffs...)
+	c.mu.Unlock()
+}
+
+func (c *collector) all() []Diff {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.diffs
+}
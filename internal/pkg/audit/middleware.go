@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+// Middleware 记录非 GET 请求的操作日志，需放在认证、租户中间件之后
+// names 为 Operation 到操作名称的映射，未声明时以 Operation 代替
+func Middleware(w Writer, names map[string]string, permissions *provider.PermissionProvider) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			var method, path string
+			if ht, ok := tr.(khttp.Transporter); ok {
+				method = ht.Request().Method
+				path = ht.Request().URL.Path
+				if method == http.MethodGet || method == http.MethodHead {
+					return handler(ctx, req)
+				}
+			}
+
+			start := time.Now()
+			ctx, c := withCollector(ctx)
+			reply, err := handler(ctx, req)
+
+			operation := tr.Operation()
+			name := names[operation]
+			if name == "" {
+				name = operation
+			}
+			info := auth.GetContextInfo(ctx)
+			r := &Record{
+				TenantID:  info.TenantID,
+				UserID:    info.UserID,
+				DeptID:    info.DeptID,
+				IP:        pkgCasbin.ClientIP(ctx),
+				Operation: operation,
+				Name:      name,
+				PermCodes: permissions.GetCodes(operation),
+				Method:    method,
+				Path:      path,
+				Request:   Redact(req),
+				Code:      http.StatusOK,
+				Latency:   time.Since(start),
+				Diffs:     c.all(),
+				CreatedAt: start,
+			}
+			if err != nil {
+				se := errors.FromError(err)
+				r.Code = se.Code
+				r.Reason = se.Reason
+			}
+			w.Write(r)
+			return reply, err
+		}
+	}
+}
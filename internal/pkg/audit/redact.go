@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	Redacted       = "******" // 脱敏后的值
+	maxRequestSize = 4096
+)
+
+// 包含以下片段的字段一律脱敏
+var sensitiveParts = []string{"password", "secret", "token", "captcha"}
+
+// 以下字段为验证码，脱敏
+var sensitiveKeys = map[string]struct{}{
+	"code":        {},
+	"sms_code":    {},
+	"email_code":  {},
+	"otp":         {},
+	"otp_code":    {},
+	"verify_code": {},
+}
+
+// Redact 将请求序列化为 JSON，并对密码、验证码、令牌等字段脱敏，超长时截断
+func Redact(req interface{}) string {
+	var raw []byte
+	var err error
+	if m, ok := req.(proto.Message); ok {
+		raw, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	} else {
+		raw, err = json.Marshal(req)
+	}
+	if err != nil {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return ""
+	}
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return ""
+	}
+	if len(b) > maxRequestSize {
+		return string(b[:maxRequestSize]) + "...(truncated)"
+	}
+	return string(b)
+}
+
+func redact(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if IsSensitive(k) {
+				val[k] = Redacted
+				continue
+			}
+			val[k] = redact(item)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redact(item)
+		}
+	}
+	return v
+}
+
+// IsSensitive 字段是否需要脱敏
+func IsSensitive(key string) bool {
+	key = strings.ToLower(key)
+	if _, ok := sensitiveKeys[key]; ok {
+		return true
+	}
+	for _, part := range sensitiveParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -103,7 +103,7 @@ func (s *JWTTokenService) GenerateToken(ctx context.Context, userID string, dept
 	}
 
 	if err := s.store.SaveToken(ctx, token); err != nil {
-		log.Error("Failed to save token: %v", err)
+		log.Errorf("Failed to save token: %v", err)
 		return "", ErrJWTGenerateError
 	}
 
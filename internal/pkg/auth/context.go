@@ -13,15 +13,20 @@ const (
 	deptIDKey      contextKey = "x-dept-id"      // 部门ID
 	dataScopeKey   contextKey = "x-data-scope"   // 数据权限范围 (SELF, DEPT, DEPT_SUB, ALL等)
 	authVersionKey contextKey = "x-auth-version" // 安全版本号
+	skipScopeKey   contextKey = "x-skip-scope"   // 跳过租户与数据权限过滤
+	scopeDeptsKey  contextKey = "x-scope-depts"  // 自定义数据范围的部门集合
+	scopeSelfKey   contextKey = "x-scope-self"   // 自定义数据范围是否包含个人数据
 )
 
 // ContextInfo 结构体用于一次性返回所有常用信息
 type ContextInfo struct {
-	UserID      int64
-	TenantID    int64
-	DeptID      int64
-	DataScope   string
-	AuthVersion int64
+	UserID       int64
+	TenantID     int64
+	DeptID       int64
+	DataScope    string
+	ScopeDeptIDs []int64 // DataScope 为 CUSTOM 时可访问的部门
+	ScopeSelf    bool    // DataScope 为 CUSTOM 时是否额外包含个人数据
+	AuthVersion  int64
 }
 
 // --- Context 注入函数 (通常在 Middleware 中调用) ---
@@ -32,6 +37,8 @@ func NewContext(ctx context.Context, info ContextInfo) context.Context {
 	ctx = context.WithValue(ctx, tenantIDKey, info.TenantID)
 	ctx = context.WithValue(ctx, deptIDKey, info.DeptID)
 	ctx = context.WithValue(ctx, dataScopeKey, info.DataScope)
+	ctx = context.WithValue(ctx, scopeDeptsKey, info.ScopeDeptIDs)
+	ctx = context.WithValue(ctx, scopeSelfKey, info.ScopeSelf)
 	ctx = context.WithValue(ctx, authVersionKey, info.AuthVersion)
 	return ctx
 }
@@ -70,6 +77,20 @@ func GetDataScope(ctx context.Context) string {
 	return ""
 }
 
+// GetScopeDeptIDs 获取自定义数据范围的部门集合
+func GetScopeDeptIDs(ctx context.Context) []int64 {
+	if v, ok := ctx.Value(scopeDeptsKey).([]int64); ok {
+		return v
+	}
+	return nil
+}
+
+// IsScopeSelf 自定义数据范围是否包含个人数据
+func IsScopeSelf(ctx context.Context) bool {
+	v, _ := ctx.Value(scopeSelfKey).(bool)
+	return v
+}
+
 // GetAuthVersion 获取安全版本号
 func GetAuthVersion(ctx context.Context) int64 {
 	if v, ok := ctx.Value(authVersionKey).(int64); ok {
@@ -81,11 +102,13 @@ func GetAuthVersion(ctx context.Context) int64 {
 // GetContextInfo 一次性获取所有权限信息
 func GetContextInfo(ctx context.Context) ContextInfo {
 	return ContextInfo{
-		UserID:      GetUserID(ctx),
-		TenantID:    GetTenantID(ctx),
-		DeptID:      GetDeptID(ctx),
-		DataScope:   GetDataScope(ctx),
-		AuthVersion: GetAuthVersion(ctx),
+		UserID:       GetUserID(ctx),
+		TenantID:     GetTenantID(ctx),
+		DeptID:       GetDeptID(ctx),
+		DataScope:    GetDataScope(ctx),
+		ScopeDeptIDs: GetScopeDeptIDs(ctx),
+		ScopeSelf:    IsScopeSelf(ctx),
+		AuthVersion:  GetAuthVersion(ctx),
 	}
 }
 
@@ -111,7 +134,25 @@ func WithDataScope(ctx context.Context, dataScope string) context.Context {
 	return context.WithValue(ctx, dataScopeKey, dataScope)
 }
 
+// WithCustomScope 注入自定义数据范围（部门集合，可选包含个人数据）
+func WithCustomScope(ctx context.Context, deptIDs []int64, withSelf bool) context.Context {
+	ctx = context.WithValue(ctx, dataScopeKey, ScopeCustom)
+	ctx = context.WithValue(ctx, scopeDeptsKey, deptIDs)
+	return context.WithValue(ctx, scopeSelfKey, withSelf)
+}
+
 // WithAuthVersion 手动注入安全版本号
 func WithAuthVersion(ctx context.Context, authVersion int64) context.Context {
 	return context.WithValue(ctx, authVersionKey, authVersion)
 }
+
+// WithSkipDataScope 跳过租户隔离与数据权限过滤（仅用于系统任务、全量加载等场景）
+func WithSkipDataScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipScopeKey, true)
+}
+
+// IsSkipDataScope 是否跳过租户隔离与数据权限过滤
+func IsSkipDataScope(ctx context.Context) bool {
+	v, _ := ctx.Value(skipScopeKey).(bool)
+	return v
+}
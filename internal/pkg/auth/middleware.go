@@ -25,7 +25,7 @@ func NewDefaultPathAccessConfig() *PathAccessConfig {
 			"": {},
 		},
 		AuthPaths: map[string]struct{}{
-			// 目前暂不判断，除公开接口列表中的路径外，均需要认证
+			// 仅需登录的路径，由 proto 注解 (bubble.auth).login 声明
 		},
 	}
 }
@@ -46,6 +46,11 @@ func IsPublicPath(ctx context.Context, operation string, config *PathAccessConfi
 	return Match(operation, config.PublicPaths)
 }
 
+// IsAuthPath 判断是否为仅需登录的路径（不校验权限码）
+func IsAuthPath(ctx context.Context, operation string, config *PathAccessConfig) bool {
+	return Match(operation, config.AuthPaths)
+}
+
 // Match 判断路径是否匹配
 func Match(operation string, paths map[string]struct{}) bool {
 	_, ok := paths[operation]
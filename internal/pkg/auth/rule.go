@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/sober-studio/bubble-admin-go-kratos/api/bubble"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ApiPermission 接口上声明的权限码
+type ApiPermission struct {
+	Operation string // Kratos Operation，如 /api.tenant.v1.Tenant/RenewTenant
+	Code      string // 权限码
+	Name      string // 权限名称
+}
+
+// AccessRules 由 proto 注解 (bubble.auth) 解析出的接口访问规则
+type AccessRules struct {
+	Paths       *PathAccessConfig
+	Permissions []*ApiPermission
+}
+
+// ScanRules 扫描文件中所有服务方法的 (bubble.auth) 注解：
+//   - public 的接口加入 PublicPaths，login 的接口加入 AuthPaths
+//   - 声明权限码的接口加入 Permissions，同一权限码只能对应一个接口
+//   - 未声明或声明不唯一的接口返回错误，避免遗漏鉴权
+func ScanRules(files ...protoreflect.FileDescriptor) (*AccessRules, error) {
+	rules := &AccessRules{
+		Paths: &PathAccessConfig{
+			PublicPaths: make(map[string]struct{}),
+			AuthPaths:   make(map[string]struct{}),
+		},
+	}
+	codes := make(map[string]string)
+
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				operation := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+
+				var rule *bubble.AuthRule
+				if opts := method.Options(); opts != nil && proto.HasExtension(opts, bubble.E_Auth) {
+					rule, _ = proto.GetExtension(opts, bubble.E_Auth).(*bubble.AuthRule)
+				}
+				if rule == nil {
+					return nil, fmt.Errorf("operation %s: missing (bubble.auth) option", operation)
+				}
+
+				declared := 0
+				if rule.Public {
+					declared++
+					rules.Paths.PublicPaths[operation] = struct{}{}
+				}
+				if rule.Login {
+					declared++
+					rules.Paths.AuthPaths[operation] = struct{}{}
+				}
+				if rule.Permission != "" {
+					declared++
+					if other, ok := codes[rule.Permission]; ok {
+						return nil, fmt.Errorf("operation %s: permission %q already used by %s", operation, rule.Permission, other)
+					}
+					codes[rule.Permission] = operation
+					name := rule.Name
+					if name == "" {
+						name = string(method.Name())
+					}
+					rules.Permissions = append(rules.Permissions, &ApiPermission{
+						Operation: operation,
+						Code:      rule.Permission,
+						Name:      name,
+					})
+				}
+				if declared != 1 {
+					return nil, fmt.Errorf("operation %s: exactly one of public, login or permission must be set", operation)
+				}
+			}
+		}
+	}
+	return rules, nil
+}
@@ -1,13 +1,29 @@
 package auth
 
+// 数据权限范围
+const (
+	ScopeAll     = "ALL"      // 全租户
+	ScopeDeptSub = "DEPT_SUB" // 本部门及下级
+	ScopeDept    = "DEPT"     // 本部门
+	ScopeSelf    = "SELF"     // 个人
+	ScopeCustom  = "CUSTOM"   // 自定义部门
+)
+
 var scopePriority = map[string]int{
-	"ALL":      4,
-	"DEPT_SUB": 3,
-	"DEPT":     2,
-	"SELF":     1,
+	ScopeAll:     4,
+	ScopeDeptSub: 3,
+	ScopeDept:    2,
+	ScopeSelf:    1,
+}
+
+// IsValidScope 是否为合法的数据范围
+func IsValidScope(scope string) bool {
+	_, ok := scopePriority[scope]
+	return ok || scope == ScopeCustom
 }
 
 // GetGreaterScope 比较两个范围，返回较大的那个
+// 注意：CUSTOM 无法与其他范围比较大小，多角色合并请使用并集（见 provider.DataScopeProvider）
 func GetGreaterScope(oldScope, newScope string) string {
 	if scopePriority[newScope] > scopePriority[oldScope] {
 		return newScope
@@ -0,0 +1,64 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/casbin/casbin/v3/model"
+	"github.com/casbin/casbin/v3/util"
+	"github.com/casbin/govaluate"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// NoCondition 无条件策略，对应 sys_role_permission.condition 为空
+const NoCondition = "true"
+
+// 策略效果：拒绝优先于允许
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// Attributes 请求属性，策略条件 (p.cond) 中以 r.attr.X 引用，例如：
+//   - 仅限办公网段：ipMatch(r.attr.IP, '10.0.0.0/8')
+//   - 仅限工作时间：r.attr.Weekday >= 1 && r.attr.Weekday <= 5 && r.attr.Clock >= 900 && r.attr.Clock < 1800
+//
+// 属性均由服务端确定，不取自请求参数；仅限本人数据使用数据范围 SELF
+type Attributes struct {
+	IP      string // 客户端 IP，见 ClientIP
+	Clock   int    // 当前时间 HHMM，如 930 表示 09:30
+	Weekday int    // 星期，0 表示周日
+	UserID  int64  // 当前用户 ID
+}
+
+// NewAttributes 从请求上下文中提取属性
+func NewAttributes(ctx context.Context) *Attributes {
+	now := time.Now()
+	return &Attributes{
+		IP:      ClientIP(ctx),
+		Clock:   now.Hour()*100 + now.Minute(),
+		Weekday: int(now.Weekday()),
+		UserID:  auth.GetUserID(ctx),
+	}
+}
+
+// attrRef 条件表达式中引用的请求属性
+var attrRef = regexp.MustCompile(`r\.attr\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// ValidateCondition 校验策略条件表达式能否被 Casbin 解析，且只引用 Attributes 中存在的属性
+func ValidateCondition(cond string) error {
+	if cond == "" {
+		return nil
+	}
+	for _, m := range attrRef.FindAllStringSubmatch(cond, -1) {
+		if _, ok := reflect.TypeOf(Attributes{}).FieldByName(m[1]); !ok {
+			return fmt.Errorf("unknown attribute r.attr.%s", m[1])
+		}
+	}
+	fm := model.LoadFunctionMap()
+	_, err := govaluate.NewEvaluableExpressionWithFunctions(util.EscapeAssertion(cond), fm.GetFunctions())
+	return err
+}
@@ -0,0 +1,112 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type clientIPKey struct{}
+
+// TrustedProxies 可信代理网段，仅来自这些地址的请求才读取 X-Forwarded-For / X-Real-IP
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies 解析 CIDR 或单个 IP
+func ParseTrustedProxies(list []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(list))
+	for _, s := range list {
+		s = strings.TrimSpace(s)
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", s)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", s, err)
+		}
+		proxies = append(proxies, n)
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) contains(ip net.IP) bool {
+	for _, n := range p {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve 默认使用连接地址；连接来自可信代理时，从 X-Forwarded-For 右侧起跳过可信代理，
+// 取第一个不可信的地址（左侧的地址可由客户端伪造），没有 X-Forwarded-For 时取 X-Real-IP
+func (p TrustedProxies) Resolve(remoteAddr string, header transport.Header) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !p.contains(ip) {
+		return host
+	}
+
+	if xff := header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := net.ParseIP(strings.TrimSpace(hops[i]))
+			if hop == nil {
+				// 无法解析的地址之后的内容均不可信
+				break
+			}
+			ip = hop
+			if !p.contains(hop) {
+				break
+			}
+		}
+		return ip.String()
+	}
+	if realIP := net.ParseIP(strings.TrimSpace(header.Get("X-Real-IP"))); realIP != nil {
+		return realIP.String()
+	}
+	return host
+}
+
+// ClientIPMiddleware 解析客户端 IP 存入 Context，供策略条件及操作日志使用，须位于最外层
+func ClientIPMiddleware(proxies TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if ht, ok := transport.FromServerContext(ctx); ok {
+				if tr, ok := ht.(http.Transporter); ok {
+					ctx = context.WithValue(ctx, clientIPKey{}, proxies.Resolve(tr.Request().RemoteAddr, tr.RequestHeader()))
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// ClientIP 由 ClientIPMiddleware 解析的客户端 IP，未经过该中间件时取连接地址
+func ClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(http.Transporter); ok {
+			return TrustedProxies(nil).Resolve(ht.Request().RemoteAddr, ht.RequestHeader())
+		}
+	}
+	return ""
+}
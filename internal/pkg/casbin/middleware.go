@@ -2,6 +2,9 @@ package casbin
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/go-kratos/kratos/v2/errors"
@@ -11,7 +14,13 @@ import (
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 )
 
-func Middleware(enforcer *casbin.SyncedEnforcer, provider *provider.PermissionProvider) middleware.Middleware {
+// 超级管理员：'1' 租户下的 'admin' 角色，与 Casbin matcher 中的短路分支保持一致
+const (
+	AdminRole   = "admin"
+	adminDomain = "1"
+)
+
+func Middleware(enforcer *casbin.SyncedEnforcer, provider *provider.PermissionProvider, scopes *provider.DataScopeProvider) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			tr, _ := transport.FromServerContext(ctx)
@@ -20,27 +29,80 @@ func Middleware(enforcer *casbin.SyncedEnforcer, provider *provider.PermissionPr
 			// 结果可能是: ["user:manage", "order:assign", "audit:view"]
 			permCodes := provider.GetCodes(tr.Operation())
 
-			userID := auth.GetUserID(ctx)
-			tenantID := auth.GetTenantID(ctx)
+			info := auth.GetContextInfo(ctx)
+			// Casbin 的内置函数要求参数均为字符串
+			sub := strconv.FormatInt(info.UserID, 10)
+			dom := strconv.FormatInt(info.TenantID, 10)
+			attr := NewAttributes(ctx)
 
 			// 2. 遍历校验：用户只要拥有其中【任何一个】权限码，即可访问该 API
-			isAllowed := false
-			finalScope := ""
+			// 超级管理员直接放行，策略表为空时 matcher 中的 eval 会报错
+			admin := IsAdmin(enforcer, sub)
+			isAllowed := admin
 			for _, code := range permCodes {
-				ok, policy, _ := enforcer.EnforceEx(userID, tenantID, code, "V")
-				if ok {
-					isAllowed = true
-					currentScope := policy[4]
-					finalScope = auth.GetGreaterScope(finalScope, currentScope)
+				if isAllowed {
 					break
 				}
+				if ok, _ := enforcer.Enforce(sub, dom, code, "V", attr); ok {
+					isAllowed = true
+				}
 			}
 
 			if !isAllowed {
 				return nil, errors.Forbidden("CASBIN", "forbidden")
 			}
-			newCtx := auth.WithDataScope(ctx, finalScope)
-			return handler(newCtx, req)
+
+			// 3. 数据范围：超级管理员为全部，其他用户合并各角色授予的范围
+			if admin {
+				return handler(auth.WithDataScope(ctx, auth.ScopeAll), req)
+			}
+			resolved, err := scopes.Resolve(ctx, info, CollectGrants(enforcer, sub, dom, permCodes, attr))
+			if err != nil {
+				return nil, err
+			}
+			if resolved.Scope == auth.ScopeCustom {
+				ctx = auth.WithCustomScope(ctx, resolved.DeptIDs, resolved.WithSelf)
+			} else {
+				ctx = auth.WithDataScope(ctx, resolved.Scope)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// conditionMatcher 校验单条带条件策略是否满足，请求中的 sub 为角色
+const conditionMatcher = "r.sub == p.sub && r.dom == p.dom && r.obj == p.obj && r.act == p.act && p.scope == '%s' && p.eft == 'allow' && eval(p.cond)"
+
+// IsAdmin 用户是否直接或经角色继承拥有超级管理员角色
+func IsAdmin(enforcer *casbin.SyncedEnforcer, sub string) bool {
+	roles, _ := enforcer.GetImplicitRolesForUser(sub, adminDomain)
+	return slices.Contains(roles, AdminRole)
+}
+
+// CollectGrants 收集用户每个角色（含继承的角色）在这些权限码上被授予的数据范围，
+// 拒绝策略及条件不满足的策略不计入；整体判定为拒绝的权限码（如其他角色拒绝）不授予任何范围
+func CollectGrants(enforcer *casbin.SyncedEnforcer, sub, dom string, permCodes []string, attr *Attributes) []provider.ScopeGrant {
+	var grants []provider.ScopeGrant
+	roles, _ := enforcer.GetImplicitRolesForUser(sub, dom)
+	for _, code := range permCodes {
+		if ok, _ := enforcer.Enforce(sub, dom, code, "V", attr); !ok {
+			continue
+		}
+		for _, role := range roles {
+			policies, _ := enforcer.GetFilteredPolicy(0, role, dom, code, "V")
+			for _, p := range policies {
+				if len(p) < 7 || p[6] == EffectDeny || !auth.IsValidScope(p[4]) {
+					continue
+				}
+				if p[5] != NoCondition {
+					ok, _ := enforcer.EnforceWithMatcher(fmt.Sprintf(conditionMatcher, p[4]), role, dom, code, "V", attr)
+					if !ok {
+						continue
+					}
+				}
+				grants = append(grants, provider.ScopeGrant{Role: role, Scope: p[4]})
+			}
 		}
 	}
+	return grants
 }
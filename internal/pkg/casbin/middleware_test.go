@@ -0,0 +1,88 @@
+package casbin_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/casbin/casbin/v3"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/data"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+// newEnforcer 内置模型、仅内存中的策略
+func newEnforcer(t *testing.T, policies, groupings [][]string) *casbin.SyncedEnforcer {
+	t.Helper()
+	m, err := data.NewCasbinModel(&conf.Data{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) > 0 {
+		if _, err := e.AddPolicies(policies); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(groupings) > 0 {
+		if _, err := e.AddGroupingPolicies(groupings); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e
+}
+
+func grantStrings(grants []provider.ScopeGrant) []string {
+	list := make([]string, 0, len(grants))
+	for _, g := range grants {
+		list = append(list, g.Role+"="+g.Scope)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestCollectGrants(t *testing.T) {
+	policy := func(role, code, scope, cond, eft string) []string {
+		return []string{role, "2", code, "V", scope, cond, eft}
+	}
+	e := newEnforcer(t, [][]string{
+		policy("editor", "user:list", auth.ScopeDeptSub, pkgCasbin.NoCondition, pkgCasbin.EffectAllow),
+		policy("viewer", "user:list", auth.ScopeAll, pkgCasbin.NoCondition, pkgCasbin.EffectAllow),
+		policy("editor", "user:export", auth.ScopeDept, pkgCasbin.NoCondition, pkgCasbin.EffectAllow),
+		policy("auditor", "user:export", auth.ScopeSelf, pkgCasbin.NoCondition, pkgCasbin.EffectDeny),
+		policy("viewer", "user:edit", auth.ScopeAll, "r.attr.Clock < 0", pkgCasbin.EffectAllow),
+	}, [][]string{
+		{"10", "editor", "2"},
+		{"10", "viewer", "2"},
+		{"20", "editor", "2"},
+		{"20", "auditor", "2"},
+	})
+	attr := &pkgCasbin.Attributes{Clock: 930}
+
+	tests := []struct {
+		name  string
+		sub   string
+		codes []string
+		want  []string
+	}{
+		{"union of roles", "10", []string{"user:list"}, []string{"editor=" + auth.ScopeDeptSub, "viewer=" + auth.ScopeAll}},
+		{"denied code skipped", "20", []string{"user:export"}, []string{}},
+		{"denied code does not affect others", "20", []string{"user:export", "user:list"}, []string{"editor=" + auth.ScopeDeptSub}},
+		{"condition not met", "10", []string{"user:edit"}, []string{}},
+		{"other tenant", "30", []string{"user:list"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := grantStrings(pkgCasbin.CollectGrants(e, tt.sub, "2", tt.codes, attr))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("grants = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
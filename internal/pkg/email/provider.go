@@ -10,7 +10,8 @@ import (
 var ProviderSet = wire.NewSet(NewEmailSender)
 
 func NewEmailSender(c *conf.Data, logger log.Logger) Sender {
-	if env.IsDev() {
+	// 非生产环境（开发、测试）使用 Mock
+	if env.IsDev() || env.IsTest() {
 		return NewMockSender(logger)
 	}
 	// 默认使用 SMTP 实现
@@ -0,0 +1,129 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Table 迁移版本记录表
+const Table = "schema_migrations"
+
+// lockName 迁移锁名称，同一数据库的所有节点共用
+const lockName = "bubble:schema_migrations"
+
+// lockTimeout 等待其他节点完成迁移的最长时间
+const lockTimeout = 10 * time.Minute
+
+// Dialect 数据库差异：版本表结构、占位符及咨询锁
+type Dialect interface {
+	CreateTableSQL() string
+	InsertSQL() string
+	DeleteSQL() string
+	// Lock 在连接上获取迁移锁，其他节点迁移期间阻塞等待
+	Lock(ctx context.Context, conn *sql.Conn) error
+	Unlock(ctx context.Context, conn *sql.Conn) error
+}
+
+// DialectOf 按 GORM 驱动名称返回数据库差异，未知驱动返回 nil
+func DialectOf(driver string) Dialect {
+	switch driver {
+	case "postgres":
+		return postgresDialect{}
+	case "mysql":
+		return mysqlDialect{}
+	}
+	return nil
+}
+
+// WithLock 持有迁移锁执行 fn，用于启动时与迁移及其他节点互斥的初始化（如同步接口权限）
+// dialect 为 nil（如 SQLite）时直接执行
+func WithLock(ctx context.Context, db *sql.DB, dialect Dialect, fn func() error) (err error) {
+	if dialect == nil {
+		return fn()
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := dialect.Lock(ctx, conn); err != nil {
+		return fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	defer func() {
+		if uerr := dialect.Unlock(context.WithoutCancel(ctx), conn); uerr != nil && err == nil {
+			err = fmt.Errorf("migrate: release lock: %w", uerr)
+		}
+	}()
+	return fn()
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) CreateTableSQL() string {
+	return `CREATE TABLE IF NOT EXISTS ` + Table + ` (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL
+)`
+}
+
+func (postgresDialect) InsertSQL() string {
+	return "INSERT INTO " + Table + " (version, name, checksum, applied_at) VALUES ($1, $2, $3, $4)"
+}
+
+func (postgresDialect) DeleteSQL() string {
+	return "DELETE FROM " + Table + " WHERE version = $1"
+}
+
+// Lock 会话级咨询锁，连接断开时自动释放
+func (postgresDialect) Lock(ctx context.Context, conn *sql.Conn) error {
+	ctx, cancel := context.WithTimeout(ctx, lockTimeout)
+	defer cancel()
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", lockName)
+	return err
+}
+
+func (postgresDialect) Unlock(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", lockName)
+	return err
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) CreateTableSQL() string {
+	return `CREATE TABLE IF NOT EXISTS ` + Table + ` (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    applied_at DATETIME(3) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`
+}
+
+func (mysqlDialect) InsertSQL() string {
+	return "INSERT INTO " + Table + " (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)"
+}
+
+func (mysqlDialect) DeleteSQL() string {
+	return "DELETE FROM " + Table + " WHERE version = ?"
+}
+
+// Lock 命名锁，连接断开时自动释放
+func (mysqlDialect) Lock(ctx context.Context, conn *sql.Conn) error {
+	var ok sql.NullInt64
+	err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int(lockTimeout.Seconds())).Scan(&ok)
+	if err != nil {
+		return err
+	}
+	if !ok.Valid || ok.Int64 != 1 {
+		return fmt.Errorf("timeout waiting for lock %s", lockName)
+	}
+	return nil
+}
+
+func (mysqlDialect) Unlock(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", lockName)
+	return err
+}
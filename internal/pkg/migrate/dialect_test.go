@@ -0,0 +1,54 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+// recordDialect 记录加锁、解锁顺序
+type recordDialect struct {
+	Dialect
+	calls *[]string
+}
+
+func (d recordDialect) Lock(context.Context, *sql.Conn) error {
+	*d.calls = append(*d.calls, "lock")
+	return nil
+}
+
+func (d recordDialect) Unlock(context.Context, *sql.Conn) error {
+	*d.calls = append(*d.calls, "unlock")
+	return nil
+}
+
+func TestWithLock(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "lock.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var calls []string
+	failed := errors.New("failed")
+	err = WithLock(context.Background(), db, recordDialect{calls: &calls}, func() error {
+		calls = append(calls, "fn")
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("err = %v", err)
+	}
+	if len(calls) != 3 || calls[0] != "lock" || calls[1] != "fn" || calls[2] != "unlock" {
+		t.Fatalf("calls = %v", calls)
+	}
+
+	// 不支持咨询锁的数据库直接执行
+	ran := false
+	if err := WithLock(context.Background(), db, nil, func() error { ran = true; return nil }); err != nil || !ran {
+		t.Fatalf("nil dialect: ran %v, %v", ran, err)
+	}
+}
@@ -0,0 +1,330 @@
+// Package migrate 版本化数据库迁移：按版本号顺序执行内嵌的 SQL 脚本，
+// 已执行的版本及脚本校验和记录在 schema_migrations 表，执行期间持有数据库咨询锁，避免多个节点并发迁移
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 迁移脚本文件名：<版本号>_<名称>.up.sql / <版本号>_<名称>.down.sql，如 0001_init.up.sql
+var fileRe = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var (
+	ErrChecksumMismatch = errors.New("migrate: applied migration has been modified")
+	ErrMissingFile      = errors.New("migrate: applied migration not found in migration files")
+	ErrNoDownScript     = errors.New("migrate: migration has no down script")
+	ErrUnknownVersion   = errors.New("migrate: unknown migration version")
+)
+
+// Migration 一个版本的迁移脚本
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // Up 脚本的 sha256，执行后不允许修改
+}
+
+// Status 迁移版本的执行状态
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	Modified  bool // 已执行的脚本被修改过
+	Missing   bool // 已执行但脚本文件不存在
+}
+
+// Load 读取目录下的迁移脚本，按版本号升序返回
+func Load(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		m := fileRe.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		version, _ := strconv.ParseInt(m[1], 10, 64)
+		content, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			return nil, fmt.Errorf("migrate: duplicate version %d (%s, %s)", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+
+	result := make([]*Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migrate: version %d has no up script", mig.Version)
+		}
+		sum := sha256.Sum256([]byte(mig.Up))
+		mig.Checksum = hex.EncodeToString(sum[:])
+		result = append(result, mig)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// Migrator 执行迁移
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []*Migration
+	log        *log.Helper
+}
+
+func New(db *sql.DB, dialect Dialect, migrations []*Migration, logger log.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    dialect,
+		migrations: migrations,
+		log:        log.NewHelper(log.With(logger, "module", "migrate")),
+	}
+}
+
+// applied 已执行的版本记录
+type applied struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Up 按顺序执行未执行的迁移，target 不为 0 时只执行到该版本，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context, target int64) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withConn(ctx, true, func(conn *sql.Conn) error {
+		records, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(records); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if target > 0 && mig.Version > target {
+				break
+			}
+			if _, ok := records[mig.Version]; ok {
+				continue
+			}
+			m.log.Infof("applying migration %d_%s", mig.Version, mig.Name)
+			err := m.exec(ctx, conn, mig.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, m.dialect.InsertSQL(), mig.Version, mig.Name, mig.Checksum, time.Now())
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrate: apply %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down 按版本倒序回滚最近执行的 steps 个迁移，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withConn(ctx, true, func(conn *sql.Conn) error {
+		records, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := records[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("%w: %d_%s", ErrNoDownScript, mig.Version, mig.Name)
+			}
+			m.log.Infof("rolling back migration %d_%s", mig.Version, mig.Name)
+			err := m.exec(ctx, conn, mig.Down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, m.dialect.DeleteSQL(), mig.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrate: roll back %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline 将 version 及之前的迁移标记为已执行但不执行脚本，用于接管已由 AutoMigrate 或手工建好的数据库
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]*Migration, error) {
+	if !m.known(version) {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	var done []*Migration
+	err := m.withConn(ctx, true, func(conn *sql.Conn) error {
+		records, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := records[mig.Version]; ok {
+				continue
+			}
+			if _, err := conn.ExecContext(ctx, m.dialect.InsertSQL(), mig.Version, mig.Name, mig.Checksum, time.Now()); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status 所有迁移的执行状态，包括已执行但脚本已不存在的版本
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	var result []*Status
+	err := m.withConn(ctx, false, func(conn *sql.Conn) error {
+		records, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			s := &Status{Version: mig.Version, Name: mig.Name}
+			if r, ok := records[mig.Version]; ok {
+				s.Applied = true
+				s.AppliedAt = r.appliedAt
+				s.Modified = r.checksum != mig.Checksum
+				delete(records, mig.Version)
+			}
+			result = append(result, s)
+		}
+		for version, r := range records {
+			result = append(result, &Status{Version: version, Name: r.name, Applied: true, AppliedAt: r.appliedAt, Missing: true})
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+		return nil
+	})
+	return result, err
+}
+
+// verify 已执行的迁移必须存在且未被修改
+func (m *Migrator) verify(records map[int64]applied) error {
+	files := make(map[int64]*Migration, len(m.migrations))
+	for _, mig := range m.migrations {
+		files[mig.Version] = mig
+	}
+	for version, r := range records {
+		mig, ok := files[version]
+		if !ok {
+			return fmt.Errorf("%w: %d_%s", ErrMissingFile, version, r.name)
+		}
+		if r.checksum != mig.Checksum {
+			return fmt.Errorf("%w: %d_%s", ErrChecksumMismatch, version, mig.Name)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) known(version int64) bool {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// withConn 在同一连接上执行 fn，lock 为 true 时持有迁移锁，并确保 schema_migrations 表存在
+func (m *Migrator) withConn(ctx context.Context, lock bool, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if lock {
+		if err := m.dialect.Lock(ctx, conn); err != nil {
+			return fmt.Errorf("migrate: acquire lock: %w", err)
+		}
+		defer func() {
+			if err := m.dialect.Unlock(context.WithoutCancel(ctx), conn); err != nil {
+				m.log.Errorf("release migration lock failed: %v", err)
+			}
+		}()
+	}
+
+	if _, err := conn.ExecContext(ctx, m.dialect.CreateTableSQL()); err != nil {
+		return fmt.Errorf("migrate: create %s: %w", Table, err)
+	}
+	return fn(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]applied, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM "+Table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make(map[int64]applied)
+	for rows.Next() {
+		var version int64
+		var r applied
+		if err := rows.Scan(&version, &r.name, &r.checksum, &r.appliedAt); err != nil {
+			return nil, err
+		}
+		records[version] = r
+	}
+	return records, rows.Err()
+}
+
+// exec 在事务中逐条执行脚本并更新版本记录
+// 注意：MySQL 的 DDL 会隐式提交事务，脚本执行到一半失败时需手动处理已生效的语句
+func (m *Migrator) exec(ctx context.Context, conn *sql.Conn, script string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range Split(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w\n%s", err, stmt)
+		}
+	}
+	if err := record(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
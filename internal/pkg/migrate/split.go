@@ -0,0 +1,45 @@
+package migrate
+
+import "strings"
+
+// Split 将脚本拆分为单条语句，忽略引号内及注释中的分号
+// 逐条执行可避免依赖驱动的多语句支持（如 MySQL 的 multiStatements）
+func Split(script string) []string {
+	var (
+		stmts []string
+		buf   strings.Builder
+		quote byte // 当前所在的引号，0 表示不在引号内
+	)
+	flush := func() {
+		if s := strings.TrimSpace(buf.String()); s != "" {
+			stmts = append(stmts, s)
+		}
+		buf.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case quote != 0:
+			buf.WriteByte(c)
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			buf.WriteByte(c)
+		case c == '-' && i+1 < len(script) && script[i+1] == '-':
+			// 单行注释，跳到行尾
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			buf.WriteByte('\n')
+		case c == ';':
+			flush()
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	flush()
+	return stmts
+}
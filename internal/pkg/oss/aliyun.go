@@ -58,6 +58,10 @@ func (s *aliyunStorage) Upload(ctx context.Context, key string, reader io.Reader
 	return key, nil
 }
 
+func (s *aliyunStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.bucket.GetObject(key, oss.WithContext(ctx))
+}
+
 func (s *aliyunStorage) Delete(ctx context.Context, key string) error {
 	return s.bucket.DeleteObject(key)
 }
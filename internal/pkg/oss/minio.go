@@ -52,6 +52,10 @@ func (s *minioStorage) Upload(ctx context.Context, key string, reader io.Reader,
 	return key, nil
 }
 
+func (s *minioStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.client.GetObject(ctx, s.conf.Bucket, key, minio.GetObjectOptions{})
+}
+
 func (s *minioStorage) Delete(ctx context.Context, key string) error {
 	return s.client.RemoveObject(ctx, s.conf.Bucket, key, minio.RemoveObjectOptions{})
 }
@@ -13,6 +13,8 @@ type Storage interface {
 	// size: 文件大小（如果未知传 -1，但某些 SDK 可能要求必须提供）
 	// isPrivate: 是否私有访问
 	Upload(ctx context.Context, key string, reader io.Reader, size int64, contentType string, isPrivate bool) (string, error)
+	// Download 读取文件内容，调用方负责关闭
+	Download(ctx context.Context, key string) (io.ReadCloser, error)
 	// Delete 删除文件
 	Delete(ctx context.Context, key string) error
 	// GenerateURL 获取可访问的 URL（处理公开/私有逻辑）
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -58,6 +59,24 @@ func (s *qiniuStorage) Upload(ctx context.Context, key string, reader io.Reader,
 	return key, nil
 }
 
+func (s *qiniuStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	// 七牛没有直接读取对象的接口，通过短时签名 URL 下载
+	u := s.GenerateURL(ctx, key, true, 5*time.Minute)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("download %s failed: %s", key, resp.Status)
+	}
+	return resp.Body, nil
+}
+
 func (s *qiniuStorage) Delete(ctx context.Context, key string) error {
 	bucketManager := storage.NewBucketManager(s.mac, s.cfg)
 	return bucketManager.Delete(s.conf.Bucket, key)
@@ -0,0 +1,191 @@
+// Package sheet 读写 CSV / XLSX 表格，用于数据导入导出
+package sheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 支持的表格格式
+const (
+	CSV  = "csv"
+	XLSX = "xlsx"
+)
+
+// utf8BOM 写入 CSV 开头，便于 Excel 直接打开；读取时去除
+const utf8BOM = "\xEF\xBB\xBF"
+
+var (
+	ErrFormatUnsupported = errors.New("sheet: unsupported format")
+	ErrTooManyRows       = errors.New("sheet: too many rows")
+)
+
+// FormatOf 根据文件名扩展名判断表格格式，不支持时返回空字符串
+func FormatOf(name string) string {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(name), ".")) {
+	case CSV:
+		return CSV
+	case XLSX:
+		return XLSX
+	}
+	return ""
+}
+
+// ContentType 表格格式对应的 MIME 类型
+func ContentType(format string) string {
+	if format == XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// Read 读取表格全部行（XLSX 只读取第一个工作表），包含表头，行数超过 maxRows 时返回 ErrTooManyRows
+func Read(r io.Reader, format string, maxRows int) ([][]string, error) {
+	switch format {
+	case CSV:
+		return readCSV(r, maxRows)
+	case XLSX:
+		return readXLSX(r, maxRows)
+	}
+	return nil, ErrFormatUnsupported
+}
+
+func readCSV(r io.Reader, maxRows int) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte(utf8BOM))))
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	var rows [][]string
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) >= maxRows {
+			return nil, ErrTooManyRows
+		}
+		rows = append(rows, row)
+	}
+}
+
+func readXLSX(r io.Reader, maxRows int) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	it, err := f.Rows(f.GetSheetName(0))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var rows [][]string
+	for it.Next() {
+		if len(rows) >= maxRows {
+			return nil, ErrTooManyRows
+		}
+		row, err := it.Columns()
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, it.Error()
+}
+
+// Writer 逐行写入表格，Close 后通过 Bytes 获取文件内容
+type Writer interface {
+	Write(row ...string) error
+	Close() error
+	Bytes() *bytes.Buffer
+}
+
+// NewWriter 创建指定格式的表格写入器
+func NewWriter(format string) (Writer, error) {
+	switch format {
+	case CSV:
+		w := &csvWriter{}
+		w.buf.WriteString(utf8BOM)
+		w.w = csv.NewWriter(&w.buf)
+		return w, nil
+	case XLSX:
+		f := excelize.NewFile()
+		sw, err := f.NewStreamWriter(f.GetSheetName(0))
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		return &xlsxWriter{f: f, sw: sw}, nil
+	}
+	return nil, ErrFormatUnsupported
+}
+
+type csvWriter struct {
+	buf bytes.Buffer
+	w   *csv.Writer
+}
+
+func (w *csvWriter) Write(row ...string) error {
+	// 防止单元格内容被表格软件当作公式执行
+	for i, v := range row {
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			row[i] = "'" + v
+		}
+	}
+	return w.w.Write(row)
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) Bytes() *bytes.Buffer { return &w.buf }
+
+// xlsxWriter 以字符串单元格写入，内容不会被当作公式
+type xlsxWriter struct {
+	f   *excelize.File
+	sw  *excelize.StreamWriter
+	n   int
+	buf bytes.Buffer
+}
+
+func (w *xlsxWriter) Write(row ...string) error {
+	w.n++
+	cell, err := excelize.CoordinatesToCellName(1, w.n)
+	if err != nil {
+		return err
+	}
+	values := make([]interface{}, len(row))
+	for i, v := range row {
+		values[i] = v
+	}
+	return w.sw.SetRow(cell, values)
+}
+
+func (w *xlsxWriter) Close() error {
+	defer w.f.Close()
+	if err := w.sw.Flush(); err != nil {
+		return err
+	}
+	if _, err := w.f.WriteTo(&w.buf); err != nil {
+		return fmt.Errorf("sheet: write xlsx: %w", err)
+	}
+	return nil
+}
+
+func (w *xlsxWriter) Bytes() *bytes.Buffer { return &w.buf }
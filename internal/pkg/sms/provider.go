@@ -11,8 +11,8 @@ import (
 var ProviderSet = wire.NewSet(NewSmsSender)
 
 func NewSmsSender(c *conf.Data, logger log.Logger) Sender {
-	// 1. 如果是开发环境，强制返回 Mock
-	if env.IsDev() {
+	// 1. 非生产环境（开发、测试）强制返回 Mock
+	if env.IsDev() || env.IsTest() {
 		return NewMockSender(logger)
 	}
 
@@ -0,0 +1,122 @@
+package tenant
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
+)
+
+// 解析规则
+const (
+	RuleHeader    = "header"
+	RuleSubdomain = "subdomain"
+	RuleField     = "field"
+)
+
+const defaultHeader = "X-Tenant-Code"
+
+var (
+	ErrTenantRequired    = errors.BadRequest("TENANT_REQUIRED", "无法识别租户")
+	ErrTenantCodeInvalid = errors.BadRequest("TENANT_CODE_INVALID", "租户编码不存在")
+)
+
+// CodeRequest 携带租户编码字段的请求（如登录请求中的 tenant_code）
+type CodeRequest interface {
+	GetTenantCode() string
+}
+
+// Lookup 根据租户编码查询租户 ID
+type Lookup func(code string) (int64, bool)
+
+// Resolver 从请求头、子域名或请求字段中解析租户，并注入 Context
+// 用于无需认证的公开接口，认证后的接口以令牌中的租户为准
+func Resolver(c *conf.App_Tenant_Resolver, lookup Lookup) middleware.Middleware {
+	order := []string{RuleHeader, RuleSubdomain, RuleField}
+	header := defaultHeader
+	var baseDomain, defaultCode string
+	if c != nil {
+		if len(c.Order) > 0 {
+			order = c.Order
+		}
+		if c.Header != "" {
+			header = c.Header
+		}
+		baseDomain = strings.ToLower(c.BaseDomain)
+		defaultCode = c.DefaultCode
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			code := ""
+			for _, rule := range order {
+				switch rule {
+				case RuleHeader:
+					code = fromHeader(ctx, header)
+				case RuleSubdomain:
+					code = fromSubdomain(ctx, baseDomain)
+				case RuleField:
+					if r, ok := req.(CodeRequest); ok {
+						code = r.GetTenantCode()
+					}
+				}
+				if code = strings.TrimSpace(code); code != "" {
+					break
+				}
+			}
+			if code == "" {
+				code = defaultCode
+			}
+			if code == "" {
+				return nil, ErrTenantRequired
+			}
+
+			tenantID, ok := lookup(code)
+			if !ok {
+				return nil, ErrTenantCodeInvalid
+			}
+			return handler(auth.WithTenantID(ctx, tenantID), req)
+		}
+	}
+}
+
+func fromHeader(ctx context.Context, header string) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	return tr.RequestHeader().Get(header)
+}
+
+// fromSubdomain 取主域名左侧紧邻的一级子域名，如 acme.admin.example.com -> acme
+func fromSubdomain(ctx context.Context, baseDomain string) string {
+	if baseDomain == "" {
+		return ""
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return ""
+	}
+	host := strings.ToLower(ht.Request().Host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	prefix, ok := strings.CutSuffix(host, "."+baseDomain)
+	if !ok || prefix == "" {
+		return ""
+	}
+	if i := strings.LastIndex(prefix, "."); i >= 0 {
+		prefix = prefix[i+1:]
+	}
+	return prefix
+}
@@ -69,7 +69,7 @@ func Translate(err error) string {
 			if debug.IsDebug() {
 				return fmt.Sprintf("%s校验失败: %s", field, reason)
 			}
-			return fmt.Sprintf("%s校验失败: %s", field)
+			return fmt.Sprintf("%s校验失败", field)
 		}
 	}
 
@@ -61,6 +61,12 @@ func (h *Hub) Unregister(uid string) {
 	}
 }
 
+// IsOnline 用户是否连接到本节点
+func (h *Hub) IsOnline(uid string) bool {
+	_, ok := h.clients.Load(uid)
+	return ok
+}
+
 func (h *Hub) SendToUser(uid string, msg []byte) {
 	if client, ok := h.clients.Load(uid); ok {
 		client.(*Client).Send <- msg
@@ -11,10 +11,22 @@ func NewCronServer(
 	c *conf.Server,
 	logger log.Logger,
 	hello *job.HelloJob,
+	tenantRefresh *job.TenantRefreshJob,
+	tenantExpireNotice *job.TenantExpireNoticeJob,
+	userRoleExpire *job.UserRoleExpireJob,
+	operLogCleanup *job.OperLogCleanupJob,
+	userJobTimeout *job.UserJobTimeoutJob,
+	recycleCleanup *job.RecycleCleanupJob,
 ) *cron.Server {
 	srv := cron.NewServer(logger)
 
 	srv.AddJob(hello)
+	srv.AddJob(tenantRefresh)
+	srv.AddJob(tenantExpireNotice)
+	srv.AddJob(userRoleExpire)
+	srv.AddJob(operLogCleanup)
+	srv.AddJob(userJobTimeout)
+	srv.AddJob(recycleCleanup)
 
 	return srv
 }
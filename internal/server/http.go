@@ -2,7 +2,7 @@ package server
 
 import (
 	"context"
-	"fmt"
+	stdhttp "net/http"
 	"strings"
 
 	"github.com/casbin/casbin/v3"
@@ -13,22 +13,38 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http/binding"
 	jwtv5 "github.com/golang-jwt/jwt/v5"
+	authzV1 "github.com/sober-studio/bubble-admin-go-kratos/api/authz/v1"
+	configV1 "github.com/sober-studio/bubble-admin-go-kratos/api/config/v1"
+	dictV1 "github.com/sober-studio/bubble-admin-go-kratos/api/dict/v1"
+	noticeV1 "github.com/sober-studio/bubble-admin-go-kratos/api/notice/v1"
+	operLogV1 "github.com/sober-studio/bubble-admin-go-kratos/api/operlog/v1"
 	passportV1 "github.com/sober-studio/bubble-admin-go-kratos/api/passport/v1"
 	publicV1 "github.com/sober-studio/bubble-admin-go-kratos/api/public/v1"
+	recycleV1 "github.com/sober-studio/bubble-admin-go-kratos/api/recycle/v1"
+	roleV1 "github.com/sober-studio/bubble-admin-go-kratos/api/role/v1"
+	tenantV1 "github.com/sober-studio/bubble-admin-go-kratos/api/tenant/v1"
+	userV1 "github.com/sober-studio/bubble-admin-go-kratos/api/user/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz/provider"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/audit"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/auth/model"
 	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/debug"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/render"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/tenant"
 	"github.com/sober-studio/bubble-admin-go-kratos/internal/service"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
+// 默认租户：单租户模式为 default 租户，多租户模式为 system 租户
+const defaultTenantID = int64(1)
+
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(
 	c *conf.Server,
@@ -40,15 +56,47 @@ func NewHTTPServer(
 	enforcer *casbin.SyncedEnforcer,
 	permissionProvider *provider.PermissionProvider,
 	packageProvider *provider.PackageProvider,
+	tenantProvider *provider.TenantProvider,
+	tenantSvc *service.TenantService,
+	dataScopeProvider *provider.DataScopeProvider,
+	roleSvc *service.RoleService,
+	authzSvc *service.AuthzService,
+	permissionUc *biz.PermissionUseCase,
+	operLogSvc *service.OperLogService,
+	userSvc *service.UserService,
+	recycleSvc *service.RecycleService,
+	dictSvc *service.DictService,
+	configSvc *service.ConfigService,
+	noticeSvc *service.NoticeService,
+	auditWriter audit.Writer,
 	logger log.Logger,
-) *http.Server {
+) (*http.Server, error) {
 
-	pathConfig := auth.PathAccessConfigWithPublicList(app.Auth.PublicPaths)
+	// 接口访问规则由 proto 注解声明，启动时校验并同步 API 权限
+	rules, err := auth.ScanRules(apiFiles()...)
+	if err != nil {
+		return nil, err
+	}
+	if err := syncApiPermissions(permissionUc, rules.Permissions); err != nil {
+		return nil, err
+	}
+	pathConfig := rules.Paths
+	// 配置中的额外公开路径（如前缀匹配）
+	for _, path := range app.Auth.PublicPaths {
+		pathConfig.PublicPaths[path] = struct{}{}
+	}
+	tenantResolver := tenant.Resolver(app.GetTenant().GetResolver(), tenantProvider.GetIDByCode)
+	trustedProxies, err := pkgCasbin.ParseTrustedProxies(c.GetHttp().GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
 
 	var opts = []http.ServerOption{
 		// 中间件配置
 		http.Middleware(
 			recovery.Recovery(),
+			// 客户端 IP，供策略条件及操作日志使用
+			pkgCasbin.ClientIPMiddleware(trustedProxies),
 			selector.Server(
 				// 1. JWT 认证中间件
 				jwt.Server(
@@ -69,18 +117,23 @@ func NewHTTPServer(
 				// 4. 租户上下文
 				func(handler middleware.Handler) middleware.Handler {
 					return func(ctx context.Context, req interface{}) (interface{}, error) {
-						// 默认租户：单租户模式为 default 租户，多租户模式为 system 租户
-						defaultTenantID := int64(1)
-						// systemTenantID := int64(1)
 						// 单租户模式：强制注入硬编码的租户 ID
 						if !app.EnableMultiTenant {
 							ctx = auth.WithTenantID(ctx, defaultTenantID)
 							return handler(ctx, req)
 						}
+						// 多租户模式：校验租户状态，宽限期内只允许读操作
+						readOnly, err := tenantProvider.Check(auth.GetTenantID(ctx))
+						if err != nil {
+							return nil, err
+						}
+						if readOnly && !isReadOnlyRequest(ctx) {
+							return nil, provider.ErrTenantReadOnly
+						}
 						return handler(ctx, req)
 					}
 				},
-				// 5. 租户套餐权限验证（只在多租户模式启用）
+				// 5. 租户套餐权限验证（只在多租户模式启用，仅需登录的接口除外）
 				selector.Server(func(handler middleware.Handler) middleware.Handler {
 					return func(ctx context.Context, req interface{}) (interface{}, error) {
 						tr, ok := transport.FromServerContext(ctx)
@@ -115,13 +168,33 @@ func NewHTTPServer(
 						return handler(ctx, req)
 					}
 				}).Match(func(ctx context.Context, operation string) bool {
-					return app.EnableMultiTenant
+					return app.EnableMultiTenant && !auth.IsAuthPath(ctx, operation, pathConfig)
+				}).Build(),
+				// 6. 权限校验（仅需登录的接口除外）
+				selector.Server(
+					pkgCasbin.Middleware(enforcer, permissionProvider, dataScopeProvider),
+				).Match(func(ctx context.Context, operation string) bool {
+					return !auth.IsAuthPath(ctx, operation, pathConfig)
 				}).Build(),
-				// 6. 权限校验
-				pkgCasbin.Middleware(enforcer, permissionProvider),
 			).Match(func(ctx context.Context, operation string) bool {
 				return !auth.IsPublicPath(ctx, operation, pathConfig)
 			}).Build(),
+			// 公开接口的租户上下文：多租户模式下从请求头、子域名或请求字段解析
+			selector.Server(
+				func(handler middleware.Handler) middleware.Handler {
+					resolve := tenantResolver(handler)
+					return func(ctx context.Context, req interface{}) (interface{}, error) {
+						if !app.EnableMultiTenant {
+							return handler(auth.WithTenantID(ctx, defaultTenantID), req)
+						}
+						return resolve(ctx, req)
+					}
+				},
+			).Match(func(ctx context.Context, operation string) bool {
+				return auth.IsPublicPath(ctx, operation, pathConfig)
+			}).Build(),
+			// 操作日志：放在最内层，以获取认证后的用户、租户信息
+			audit.Middleware(auditWriter, operationNames(rules.Permissions), permissionProvider),
 		),
 		http.Filter(debug.Filter),
 		http.RequestDecoder(MultipartRequestDecoder),
@@ -145,10 +218,140 @@ func NewHTTPServer(
 	// 注意：这里用 Handlers.HandleFunc 是绕过 Kratos 的 Proto 解析，直接处理原始 HTTP 请求
 	srv.HandleFunc("/ws", wsSvc.WSHandler)
 
-	passportV1.RegisterPassportHTTPServer(srv, passport)
-	publicV1.RegisterPublicHTTPServer(srv, public)
+	svcs := &httpServices{
+		passport: passport,
+		public:   public,
+		tenant:   tenantSvc,
+		role:     roleSvc,
+		authz:    authzSvc,
+		operLog:  operLogSvc,
+		user:     userSvc,
+		recycle:  recycleSvc,
+		dict:     dictSvc,
+		config:   configSvc,
+		notice:   noticeSvc,
+	}
+	for _, api := range httpAPIs {
+		api.register(srv, svcs)
+	}
+
+	return srv, nil
+}
+
+// httpServices 注册到 HTTP 服务的接口实现
+type httpServices struct {
+	passport *service.PassportService
+	public   *service.PublicService
+	tenant   *service.TenantService
+	role     *service.RoleService
+	authz    *service.AuthzService
+	operLog  *service.OperLogService
+	user     *service.UserService
+	recycle  *service.RecycleService
+	dict     *service.DictService
+	config   *service.ConfigService
+	notice   *service.NoticeService
+}
+
+// httpAPIs 注册到 HTTP 服务的接口：路由注册及其接口定义（扫描访问规则、同步 API 权限）
+// 路由与访问规则出自同一处，新增服务只需在此添加
+var httpAPIs = []struct {
+	file     protoreflect.FileDescriptor
+	register func(srv *http.Server, s *httpServices)
+}{
+	{passportV1.File_api_passport_v1_passport_proto, func(srv *http.Server, s *httpServices) {
+		passportV1.RegisterPassportHTTPServer(srv, s.passport)
+	}},
+	{publicV1.File_api_public_v1_public_proto, func(srv *http.Server, s *httpServices) {
+		publicV1.RegisterPublicHTTPServer(srv, s.public)
+	}},
+	{tenantV1.File_api_tenant_v1_tenant_proto, func(srv *http.Server, s *httpServices) {
+		tenantV1.RegisterTenantHTTPServer(srv, s.tenant)
+	}},
+	{roleV1.File_api_role_v1_role_proto, func(srv *http.Server, s *httpServices) {
+		roleV1.RegisterRoleHTTPServer(srv, s.role)
+	}},
+	{authzV1.File_api_authz_v1_authz_proto, func(srv *http.Server, s *httpServices) {
+		authzV1.RegisterAuthzHTTPServer(srv, s.authz)
+	}},
+	{operLogV1.File_api_operlog_v1_operlog_proto, func(srv *http.Server, s *httpServices) {
+		operLogV1.RegisterOperLogHTTPServer(srv, s.operLog)
+	}},
+	{userV1.File_api_user_v1_user_proto, func(srv *http.Server, s *httpServices) {
+		userV1.RegisterUserHTTPServer(srv, s.user)
+	}},
+	{recycleV1.File_api_recycle_v1_recycle_proto, func(srv *http.Server, s *httpServices) {
+		recycleV1.RegisterRecycleHTTPServer(srv, s.recycle)
+	}},
+	{dictV1.File_api_dict_v1_dict_proto, func(srv *http.Server, s *httpServices) {
+		dictV1.RegisterDictHTTPServer(srv, s.dict)
+	}},
+	{configV1.File_api_config_v1_config_proto, func(srv *http.Server, s *httpServices) {
+		configV1.RegisterConfigHTTPServer(srv, s.config)
+	}},
+	{noticeV1.File_api_notice_v1_notice_proto, func(srv *http.Server, s *httpServices) {
+		noticeV1.RegisterNoticeHTTPServer(srv, s.notice)
+	}},
+}
+
+// apiFiles 注册到 HTTP 服务的接口定义
+func apiFiles() []protoreflect.FileDescriptor {
+	files := make([]protoreflect.FileDescriptor, 0, len(httpAPIs))
+	for _, api := range httpAPIs {
+		files = append(files, api.file)
+	}
+	return files
+}
+
+// operationNames 接口 Operation 到操作名称的映射，用于操作日志
+func operationNames(perms []*auth.ApiPermission) map[string]string {
+	names := make(map[string]string, len(perms))
+	for _, p := range perms {
+		names[p.Operation] = p.Name
+	}
+	return names
+}
+
+// syncApiPermissions 将注解声明的权限同步到 sys_permission
+func syncApiPermissions(uc *biz.PermissionUseCase, perms []*auth.ApiPermission) error {
+	return uc.SyncApiPermissions(auth.WithSkipDataScope(context.Background()), toBizPermissions(perms))
+}
 
-	return srv
+// ApiPermissions 接口定义中声明的全部权限，用于初始化数据
+func ApiPermissions() ([]*biz.ApiPermission, error) {
+	rules, err := auth.ScanRules(apiFiles()...)
+	if err != nil {
+		return nil, err
+	}
+	return toBizPermissions(rules.Permissions), nil
+}
+
+func toBizPermissions(perms []*auth.ApiPermission) []*biz.ApiPermission {
+	list := make([]*biz.ApiPermission, 0, len(perms))
+	for _, p := range perms {
+		list = append(list, &biz.ApiPermission{Operation: p.Operation, Code: p.Code, Name: p.Name})
+	}
+	return list
+}
+
+// isReadOnlyRequest 判断是否为只读请求，退出登录始终放行
+func isReadOnlyRequest(ctx context.Context) bool {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return false
+	}
+	if tr.Operation() == passportV1.OperationPassportLogout {
+		return true
+	}
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return false
+	}
+	switch ht.Request().Method {
+	case stdhttp.MethodGet, stdhttp.MethodHead:
+		return true
+	}
+	return false
 }
 
 // MultipartRequestDecoder 识别 multipart/form-data 并解析非文件字段
@@ -163,9 +366,6 @@ func MultipartRequestDecoder(r *http.Request, v interface{}) error {
 			return err
 		}
 
-		// debug
-		fmt.Printf("Form Values: %+v\n", r.Form)
-
 		// 2. 直接传入 r
 		// Kratos 会自动从 r.Form 中提取数据并匹配到结构体 v
 		if err := binding.BindForm(r, v); err != nil {
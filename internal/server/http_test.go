@@ -0,0 +1,67 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// httpRules 接口定义中声明的 HTTP 路由数（含 additional_bindings）
+func httpRules(file protoreflect.FileDescriptor) int {
+	n := 0
+	services := file.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			rule, ok := proto.GetExtension(methods.Get(j).Options(), annotations.E_Http).(*annotations.HttpRule)
+			if ok && rule != nil {
+				n += 1 + len(rule.GetAdditionalBindings())
+			}
+		}
+	}
+	return n
+}
+
+// unregisteredAPIs 已定义但尚未接入 HTTP 服务的接口
+var unregisteredAPIs = map[string]bool{
+	"api/upload/v1/upload.proto": true, // UploadService 尚未实现 UploadFile
+}
+
+// TestHTTPAPIsComplete 声明了 HTTP 路由的接口定义都需注册，否则访问规则与路由不一致
+func TestHTTPAPIsComplete(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, f := range apiFiles() {
+		registered[f.Path()] = true
+	}
+	protoregistry.GlobalFiles.RangeFiles(func(f protoreflect.FileDescriptor) bool {
+		if strings.HasPrefix(f.Path(), "api/") && httpRules(f) > 0 && !registered[f.Path()] && !unregisteredAPIs[f.Path()] {
+			t.Errorf("%s declares HTTP routes but is not in httpAPIs", f.Path())
+		}
+		return true
+	})
+}
+
+// TestHTTPAPIsRegister 每项注册的路由与其接口定义一致
+func TestHTTPAPIsRegister(t *testing.T) {
+	for _, api := range httpAPIs {
+		t.Run(string(api.file.Path()), func(t *testing.T) {
+			srv := http.NewServer()
+			api.register(srv, &httpServices{})
+			routes := 0
+			if err := srv.WalkRoute(func(http.RouteInfo) error {
+				routes++
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if want := httpRules(api.file); routes != want {
+				t.Fatalf("registered %d routes, file declares %d", routes, want)
+			}
+		})
+	}
+}
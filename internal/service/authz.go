@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/authz/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	pkgCasbin "github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/casbin"
+)
+
+type AuthzService struct {
+	pb.UnimplementedAuthzServer
+	uc *biz.AuthzUseCase
+}
+
+func NewAuthzService(uc *biz.AuthzUseCase) *AuthzService {
+	return &AuthzService{uc: uc}
+}
+
+func (s *AuthzService) ReloadAll(ctx context.Context, _ *pb.ReloadAllRequest) (*pb.ReloadAllReply, error) {
+	if err := s.uc.ReloadAll(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ReloadAllReply{}, nil
+}
+
+func (s *AuthzService) CheckPermissions(ctx context.Context, req *pb.CheckPermissionsRequest) (*pb.CheckPermissionsReply, error) {
+	decisions, err := s.uc.Check(ctx, req.PermCodes, pkgCasbin.NewAttributes(ctx))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.CheckPermissionsReply{Decisions: make([]*pb.PermissionDecision, 0, len(decisions))}
+	for _, d := range decisions {
+		reply.Decisions = append(reply.Decisions, &pb.PermissionDecision{
+			PermCode:  d.PermCode,
+			Allowed:   d.Allowed,
+			Reason:    d.Reason,
+			DataScope: d.DataScope.Scope,
+			DeptIds:   d.DataScope.DeptIDs,
+			WithSelf:  d.DataScope.WithSelf,
+		})
+	}
+	return reply, nil
+}
+
+func (s *AuthzService) Explain(ctx context.Context, req *pb.ExplainRequest) (*pb.ExplainReply, error) {
+	// 以被解释的用户身份判定条件，IP 可模拟
+	attr := pkgCasbin.NewAttributes(ctx)
+	attr.UserID = req.UserId
+	if req.Ip != "" {
+		attr.IP = req.Ip
+	}
+
+	result, err := s.uc.Explain(ctx, req.TenantId, req.UserId, req.Operation, attr)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ExplainReply{
+		Allowed:   result.Allowed,
+		PermCodes: result.PermCodes,
+		Roles:     result.Roles,
+		Admin:     result.Admin,
+		Package: &pb.PackageExplain{
+			Checked:      result.Package.Checked,
+			Allowed:      result.Package.Allowed,
+			AllowedCodes: result.Package.AllowedCodes,
+		},
+	}
+	for _, c := range result.Codes {
+		code := &pb.CodeExplain{
+			PermCode: c.PermCode,
+			Allowed:  c.Allowed,
+		}
+		if len(c.Policy) > 0 {
+			code.Policy = "p, " + strings.Join(c.Policy, ", ")
+		}
+		for _, g := range c.Grants {
+			code.Grants = append(code.Grants, &pb.ScopeGrant{Role: g.Role, DataScope: g.Scope})
+		}
+		reply.Codes = append(reply.Codes, code)
+	}
+	return reply, nil
+}
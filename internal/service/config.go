@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/config/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type ConfigService struct {
+	pb.UnimplementedConfigServer
+	uc *biz.ConfigUseCase
+}
+
+func NewConfigService(uc *biz.ConfigUseCase) *ConfigService {
+	return &ConfigService{uc: uc}
+}
+
+func (s *ConfigService) ListConfigs(ctx context.Context, req *pb.ListConfigsRequest) (*pb.ListConfigsReply, error) {
+	list, total, err := s.uc.List(ctx, req.Keyword, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListConfigsReply{Total: total, List: make([]*pb.ConfigInfo, 0, len(list))}
+	for _, c := range list {
+		reply.List = append(reply.List, &pb.ConfigInfo{
+			Id:        c.ID,
+			TenantId:  c.TenantID,
+			Key:       c.Key,
+			Name:      c.Name,
+			ValueType: c.ValueType,
+			Value:     c.Value,
+			Remark:    c.Remark,
+			UpdatedBy: c.UpdatedBy,
+			UpdatedAt: c.UpdatedAt.Unix(),
+			Version:   c.Version,
+		})
+	}
+	return reply, nil
+}
+
+func (s *ConfigService) CreateConfig(ctx context.Context, req *pb.CreateConfigRequest) (*pb.CreateConfigReply, error) {
+	c := &biz.SysConfig{
+		Key:       req.Key,
+		Name:      req.Name,
+		ValueType: req.ValueType,
+		Value:     req.Value,
+		Remark:    req.Remark,
+	}
+	if err := s.uc.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return &pb.CreateConfigReply{Id: c.ID}, nil
+}
+
+func (s *ConfigService) UpdateConfig(ctx context.Context, req *pb.UpdateConfigRequest) (*pb.UpdateConfigReply, error) {
+	version, err := s.uc.Update(ctx, &biz.SysConfig{
+		ID:      req.Id,
+		Name:    req.Name,
+		Value:   req.Value,
+		Remark:  req.Remark,
+		Version: req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateConfigReply{Version: version}, nil
+}
+
+func (s *ConfigService) DeleteConfig(ctx context.Context, req *pb.DeleteConfigRequest) (*pb.DeleteConfigReply, error) {
+	if err := s.uc.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteConfigReply{}, nil
+}
+
+func (s *ConfigService) ListConfigLogs(ctx context.Context, req *pb.ListConfigLogsRequest) (*pb.ListConfigLogsReply, error) {
+	list, total, err := s.uc.ListLogs(ctx, req.Key, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListConfigLogsReply{Total: total, List: make([]*pb.ConfigLogInfo, 0, len(list))}
+	for _, l := range list {
+		reply.List = append(reply.List, &pb.ConfigLogInfo{
+			Id:        l.ID,
+			Key:       l.Key,
+			Action:    l.Action,
+			OldValue:  l.OldValue,
+			NewValue:  l.NewValue,
+			CreatedBy: l.CreatedBy,
+			CreatedAt: l.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/dict/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type DictService struct {
+	pb.UnimplementedDictServer
+	uc *biz.DictUseCase
+}
+
+func NewDictService(uc *biz.DictUseCase) *DictService {
+	return &DictService{uc: uc}
+}
+
+func (s *DictService) ListDictTypes(ctx context.Context, req *pb.ListDictTypesRequest) (*pb.ListDictTypesReply, error) {
+	list, total, err := s.uc.ListTypes(ctx, req.Keyword, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListDictTypesReply{Total: total, List: make([]*pb.DictTypeInfo, 0, len(list))}
+	for _, t := range list {
+		reply.List = append(reply.List, &pb.DictTypeInfo{
+			Id:        t.ID,
+			Code:      t.Code,
+			Name:      t.Name,
+			Remark:    t.Remark,
+			Enabled:   t.Enabled,
+			UpdatedAt: t.UpdatedAt.Unix(),
+			Version:   t.Version,
+		})
+	}
+	return reply, nil
+}
+
+func (s *DictService) CreateDictType(ctx context.Context, req *pb.CreateDictTypeRequest) (*pb.CreateDictTypeReply, error) {
+	t := &biz.DictType{
+		Code:    req.Code,
+		Name:    req.Name,
+		Remark:  req.Remark,
+		Enabled: req.Enabled == nil || *req.Enabled,
+	}
+	if err := s.uc.CreateType(ctx, t); err != nil {
+		return nil, err
+	}
+	return &pb.CreateDictTypeReply{Id: t.ID}, nil
+}
+
+func (s *DictService) UpdateDictType(ctx context.Context, req *pb.UpdateDictTypeRequest) (*pb.UpdateDictTypeReply, error) {
+	version, err := s.uc.UpdateType(ctx, &biz.DictType{
+		ID:      req.Id,
+		Name:    req.Name,
+		Remark:  req.Remark,
+		Enabled: req.Enabled,
+		Version: req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateDictTypeReply{Version: version}, nil
+}
+
+func (s *DictService) DeleteDictType(ctx context.Context, req *pb.DeleteDictTypeRequest) (*pb.DeleteDictTypeReply, error) {
+	if err := s.uc.DeleteType(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteDictTypeReply{}, nil
+}
+
+func (s *DictService) ListDictItems(ctx context.Context, req *pb.ListDictItemsRequest) (*pb.ListDictItemsReply, error) {
+	list, err := s.uc.ListItems(ctx, req.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListDictItemsReply{List: make([]*pb.DictItemInfo, 0, len(list))}
+	for _, item := range list {
+		reply.List = append(reply.List, &pb.DictItemInfo{
+			Id:       item.ID,
+			TenantId: item.TenantID,
+			TypeCode: item.TypeCode,
+			Label:    item.Label,
+			Value:    item.Value,
+			Sort:     item.Sort,
+			Color:    item.Color,
+			Enabled:  item.Enabled,
+			Remark:   item.Remark,
+			Version:  item.Version,
+		})
+	}
+	return reply, nil
+}
+
+func (s *DictService) CreateDictItem(ctx context.Context, req *pb.CreateDictItemRequest) (*pb.CreateDictItemReply, error) {
+	item := &biz.DictItem{
+		TypeCode: req.TypeCode,
+		Label:    req.Label,
+		Value:    req.Value,
+		Sort:     req.Sort,
+		Color:    req.Color,
+		Enabled:  req.Enabled == nil || *req.Enabled,
+		Remark:   req.Remark,
+	}
+	if err := s.uc.CreateItem(ctx, item); err != nil {
+		return nil, err
+	}
+	return &pb.CreateDictItemReply{Id: item.ID}, nil
+}
+
+func (s *DictService) UpdateDictItem(ctx context.Context, req *pb.UpdateDictItemRequest) (*pb.UpdateDictItemReply, error) {
+	version, err := s.uc.UpdateItem(ctx, &biz.DictItem{
+		ID:      req.Id,
+		Label:   req.Label,
+		Value:   req.Value,
+		Sort:    req.Sort,
+		Color:   req.Color,
+		Enabled: req.Enabled,
+		Remark:  req.Remark,
+		Version: req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateDictItemReply{Version: version}, nil
+}
+
+func (s *DictService) DeleteDictItem(ctx context.Context, req *pb.DeleteDictItemRequest) (*pb.DeleteDictItemReply, error) {
+	if err := s.uc.DeleteItem(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteDictItemReply{}, nil
+}
+
+func (s *DictService) GetDicts(ctx context.Context, req *pb.GetDictsRequest) (*pb.GetDictsReply, error) {
+	dicts, err := s.uc.GetDicts(ctx, req.Types)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetDictsReply{Dicts: make(map[string]*pb.DictOptions, len(dicts))}
+	for code, items := range dicts {
+		options := &pb.DictOptions{Items: make([]*pb.DictOption, 0, len(items))}
+		for _, item := range items {
+			options.Items = append(options.Items, &pb.DictOption{
+				Label: item.Label,
+				Value: item.Value,
+				Color: item.Color,
+			})
+		}
+		reply.Dicts[code] = options
+	}
+	return reply, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/notice/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type NoticeService struct {
+	pb.UnimplementedNoticeServer
+	uc *biz.NoticeUseCase
+}
+
+func NewNoticeService(uc *biz.NoticeUseCase) *NoticeService {
+	return &NoticeService{uc: uc}
+}
+
+func (s *NoticeService) CreateNotice(ctx context.Context, req *pb.CreateNoticeRequest) (*pb.CreateNoticeReply, error) {
+	n := &biz.Notice{
+		Title:      req.Title,
+		Content:    req.Content,
+		Level:      req.Level,
+		TargetType: req.TargetType,
+		TargetIDs:  req.TargetIds,
+	}
+	count, err := s.uc.Create(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateNoticeReply{Id: n.ID, Recipients: int32(count)}, nil
+}
+
+func (s *NoticeService) ListMyNotices(ctx context.Context, req *pb.ListMyNoticesRequest) (*pb.ListMyNoticesReply, error) {
+	list, total, err := s.uc.ListMine(ctx, req.UnreadOnly, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListMyNoticesReply{Total: total, List: make([]*pb.NoticeInfo, 0, len(list))}
+	for _, n := range list {
+		info := &pb.NoticeInfo{
+			Id:        n.ID,
+			Title:     n.Title,
+			Content:   n.Content,
+			Level:     n.Level,
+			Global:    n.TargetType == biz.NoticeTargetAll,
+			CreatedBy: n.CreatedBy,
+			CreatedAt: n.CreatedAt.Unix(),
+			Read:      n.ReadAt != nil,
+		}
+		if n.ReadAt != nil {
+			info.ReadAt = n.ReadAt.Unix()
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+func (s *NoticeService) GetUnreadCount(ctx context.Context, _ *pb.GetUnreadCountRequest) (*pb.GetUnreadCountReply, error) {
+	total, levels, err := s.uc.UnreadCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUnreadCountReply{Total: total, Levels: levels}, nil
+}
+
+func (s *NoticeService) MarkNoticesRead(ctx context.Context, req *pb.MarkNoticesReadRequest) (*pb.MarkNoticesReadReply, error) {
+	if err := s.uc.MarkRead(ctx, req.Ids); err != nil {
+		return nil, err
+	}
+	return &pb.MarkNoticesReadReply{}, nil
+}
+
+func (s *NoticeService) MarkAllNoticesRead(ctx context.Context, _ *pb.MarkAllNoticesReadRequest) (*pb.MarkAllNoticesReadReply, error) {
+	if err := s.uc.MarkAllRead(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.MarkAllNoticesReadReply{}, nil
+}
+
+func (s *NoticeService) GetNotifyPreference(ctx context.Context, _ *pb.GetNotifyPreferenceRequest) (*pb.GetNotifyPreferenceReply, error) {
+	channels, err := s.uc.GetNotifyChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetNotifyPreferenceReply{Channels: channels}, nil
+}
+
+func (s *NoticeService) UpdateNotifyPreference(ctx context.Context, req *pb.UpdateNotifyPreferenceRequest) (*pb.UpdateNotifyPreferenceReply, error) {
+	if err := s.uc.UpdateNotifyChannels(ctx, req.Channels); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateNotifyPreferenceReply{}, nil
+}
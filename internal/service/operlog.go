@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/operlog/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type OperLogService struct {
+	pb.UnimplementedOperLogServer
+	uc *biz.OperLogUseCase
+}
+
+func NewOperLogService(uc *biz.OperLogUseCase) *OperLogService {
+	return &OperLogService{uc: uc}
+}
+
+func (s *OperLogService) ListOperLogs(ctx context.Context, req *pb.ListOperLogsRequest) (*pb.ListOperLogsReply, error) {
+	list, total, err := s.uc.List(ctx, toOperLogFilter(req.Filter), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListOperLogsReply{Total: total, List: make([]*pb.OperLogInfo, 0, len(list))}
+	for _, l := range list {
+		reply.List = append(reply.List, &pb.OperLogInfo{
+			Id:        l.ID,
+			UserId:    l.UserID,
+			DeptId:    l.DeptID,
+			Ip:        l.IP,
+			Operation: l.Operation,
+			Name:      l.Name,
+			PermCode:  l.PermCode,
+			Method:    l.Method,
+			Path:      l.Path,
+			Request:   l.Request,
+			Code:      l.Code,
+			Reason:    l.Reason,
+			Latency:   l.Latency,
+			Diff:      l.Diff,
+			CreatedAt: l.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *OperLogService) ExportOperLogs(ctx context.Context, req *pb.ExportOperLogsRequest) (*pb.ExportOperLogsReply, error) {
+	url, err := s.uc.Export(ctx, toOperLogFilter(req.Filter))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportOperLogsReply{Url: url}, nil
+}
+
+func (s *OperLogService) VerifyOperLogs(ctx context.Context, req *pb.VerifyOperLogsRequest) (*pb.VerifyOperLogsReply, error) {
+	chains, err := s.uc.Verify(ctx, req.Node)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.VerifyOperLogsReply{Chains: make([]*pb.OperLogChain, 0, len(chains))}
+	for _, c := range chains {
+		reply.Chains = append(reply.Chains, &pb.OperLogChain{Node: c.Node, Checked: c.Checked, BrokenId: c.BrokenID, Missing: c.Missing})
+	}
+	return reply, nil
+}
+
+func toOperLogFilter(f *pb.OperLogFilter) *biz.OperLogFilter {
+	filter := &biz.OperLogFilter{
+		UserID:    f.GetUserId(),
+		Operation: f.GetOperation(),
+		Status:    f.GetStatus(),
+	}
+	if f.GetStartTime() > 0 {
+		filter.Start = time.Unix(f.GetStartTime(), 0)
+	}
+	if f.GetEndTime() > 0 {
+		filter.End = time.Unix(f.GetEndTime(), 0)
+	}
+	return filter
+}
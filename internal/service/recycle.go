@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/recycle/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type RecycleService struct {
+	pb.UnimplementedRecycleServer
+	uc *biz.RecycleUseCase
+}
+
+func NewRecycleService(uc *biz.RecycleUseCase) *RecycleService {
+	return &RecycleService{uc: uc}
+}
+
+func (s *RecycleService) ListRecycle(ctx context.Context, req *pb.ListRecycleRequest) (*pb.ListRecycleReply, error) {
+	list, total, err := s.uc.List(ctx, toRecycleKind(req.Kind), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListRecycleReply{Total: total, List: make([]*pb.RecycleInfo, 0, len(list))}
+	for _, r := range list {
+		reply.List = append(reply.List, &pb.RecycleInfo{
+			Id:            r.ID,
+			Name:          r.Name,
+			Code:          r.Code,
+			DeletedAt:     r.DeletedAt.Unix(),
+			DeletedBy:     r.DeletedBy,
+			DeletedByName: r.DeletedByName,
+		})
+	}
+	return reply, nil
+}
+
+func (s *RecycleService) RestoreRecycle(ctx context.Context, req *pb.RestoreRecycleRequest) (*pb.RestoreRecycleReply, error) {
+	restored, conflicts, err := s.uc.Restore(ctx, toRecycleKind(req.Kind), req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.RestoreRecycleReply{Restored: restored, Conflicts: make([]*pb.RestoreConflict, 0, len(conflicts))}
+	for _, c := range conflicts {
+		reply.Conflicts = append(reply.Conflicts, &pb.RestoreConflict{Id: c.ID, Reason: c.Reason})
+	}
+	return reply, nil
+}
+
+func (s *RecycleService) PurgeRecycle(ctx context.Context, req *pb.PurgeRecycleRequest) (*pb.PurgeRecycleReply, error) {
+	purged, err := s.uc.Purge(ctx, toRecycleKind(req.Kind), req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurgeRecycleReply{Purged: purged}, nil
+}
+
+func toRecycleKind(kind pb.RecycleKind) string {
+	switch kind {
+	case pb.RecycleKind_ROLE:
+		return biz.RecycleRole
+	case pb.RecycleKind_DEPT:
+		return biz.RecycleDept
+	case pb.RecycleKind_PERMISSION:
+		return biz.RecyclePermission
+	}
+	return biz.RecycleUser
+}
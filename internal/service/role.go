@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/role/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type RoleService struct {
+	pb.UnimplementedRoleServer
+	uc *biz.RoleUseCase
+}
+
+func NewRoleService(uc *biz.RoleUseCase) *RoleService {
+	return &RoleService{uc: uc}
+}
+
+func (s *RoleService) AssignRoleDepts(ctx context.Context, req *pb.AssignRoleDeptsRequest) (*pb.AssignRoleDeptsReply, error) {
+	if err := s.uc.AssignRoleDepts(ctx, req.RoleId, req.DeptIds); err != nil {
+		return nil, err
+	}
+	return &pb.AssignRoleDeptsReply{}, nil
+}
+
+func (s *RoleService) GrantRolePermissions(ctx context.Context, req *pb.GrantRolePermissionsRequest) (*pb.GrantRolePermissionsReply, error) {
+	grants := make([]*biz.RoleGrant, 0, len(req.Grants))
+	for _, g := range req.Grants {
+		grants = append(grants, &biz.RoleGrant{
+			PermCode:  g.PermCode,
+			DataScope: g.DataScope,
+			Condition: g.Condition,
+			Effect:    g.Effect,
+		})
+	}
+	if err := s.uc.GrantRolePermissions(ctx, req.RoleId, grants); err != nil {
+		return nil, err
+	}
+	return &pb.GrantRolePermissionsReply{}, nil
+}
+
+func (s *RoleService) AssignUserRoles(ctx context.Context, req *pb.AssignUserRolesRequest) (*pb.AssignUserRolesReply, error) {
+	var expireAt *time.Time
+	if req.ExpireAt > 0 {
+		t := time.Unix(req.ExpireAt, 0)
+		expireAt = &t
+	}
+	if err := s.uc.AssignUserRoles(ctx, req.UserId, req.RoleIds, expireAt); err != nil {
+		return nil, err
+	}
+	return &pb.AssignUserRolesReply{}, nil
+}
+
+func (s *RoleService) SetRoleParents(ctx context.Context, req *pb.SetRoleParentsRequest) (*pb.SetRoleParentsReply, error) {
+	if err := s.uc.SetRoleParents(ctx, req.RoleId, req.ParentIds); err != nil {
+		return nil, err
+	}
+	return &pb.SetRoleParentsReply{}, nil
+}
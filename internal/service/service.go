@@ -9,4 +9,13 @@ var ProviderSet = wire.NewSet(
 	NewPassportService,
 	NewChatService,
 	NewWebsocketService,
+	NewTenantService,
+	NewRoleService,
+	NewAuthzService,
+	NewOperLogService,
+	NewUserService,
+	NewRecycleService,
+	NewDictService,
+	NewConfigService,
+	NewNoticeService,
 )
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/tenant/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+)
+
+type TenantService struct {
+	pb.UnimplementedTenantServer
+	uc *biz.TenantUseCase
+}
+
+func NewTenantService(uc *biz.TenantUseCase) *TenantService {
+	return &TenantService{uc: uc}
+}
+
+func (s *TenantService) GetTenant(ctx context.Context, req *pb.GetTenantRequest) (*pb.TenantInfo, error) {
+	t, err := s.uc.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.TenantInfo{
+		Id:        t.ID,
+		Code:      t.Code,
+		Name:      t.Name,
+		PackageId: t.PackageID,
+		Status:    int32(t.Status),
+		UpdatedBy: t.UpdatedBy,
+		UpdatedAt: t.UpdatedAt.Unix(),
+		Version:   t.Version,
+	}
+	if !t.ExpireTime.IsZero() {
+		info.ExpireAt = t.ExpireTime.Unix()
+	}
+	return info, nil
+}
+
+func (s *TenantService) UpdateTenantStatus(ctx context.Context, req *pb.UpdateTenantStatusRequest) (*pb.UpdateTenantStatusReply, error) {
+	version, err := s.uc.UpdateStatus(ctx, req.Id, int16(req.Status), req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateTenantStatusReply{Version: version}, nil
+}
+
+func (s *TenantService) RenewTenant(ctx context.Context, req *pb.RenewTenantRequest) (*pb.RenewTenantReply, error) {
+	version, err := s.uc.Renew(ctx, req.Id, time.Unix(req.ExpireAt, 0), req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenewTenantReply{Version: version}, nil
+}
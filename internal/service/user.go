@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	pb "github.com/sober-studio/bubble-admin-go-kratos/api/user/v1"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-admin-go-kratos/internal/pkg/sheet"
+)
+
+type UserService struct {
+	pb.UnimplementedUserServer
+	uc *biz.UserJobUseCase
+}
+
+func NewUserService(uc *biz.UserJobUseCase) *UserService {
+	return &UserService{uc: uc}
+}
+
+func (s *UserService) ImportUsers(ctx context.Context, req *pb.ImportUsersRequest) (*pb.ImportUsersReply, error) {
+	ht, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return nil, errors.BadRequest("TRANSPORT_ERROR", "only support http")
+	}
+	file, header, err := ht.FormFile("file")
+	if err != nil {
+		return nil, errors.BadRequest("FILE_MISSING", "file is required")
+	}
+	defer file.Close()
+
+	jobID, err := s.uc.Import(ctx, &biz.UploadFileInput{
+		Name:        header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Size:        header.Size,
+		Content:     file,
+	}, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportUsersReply{JobId: jobID}, nil
+}
+
+func (s *UserService) ExportUsers(ctx context.Context, req *pb.ExportUsersRequest) (*pb.ExportUsersReply, error) {
+	filter := &biz.UserFilter{
+		Keyword: strings.TrimSpace(req.Keyword),
+		DeptID:  req.DeptId,
+		Status:  req.Status,
+	}
+	format := sheet.CSV
+	if req.Format == pb.FileFormat_XLSX {
+		format = sheet.XLSX
+	}
+	jobID, err := s.uc.Export(ctx, filter, format)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportUsersReply{JobId: jobID}, nil
+}
+
+func (s *UserService) GetUserJob(ctx context.Context, req *pb.GetUserJobRequest) (*pb.UserJobInfo, error) {
+	job, err := s.uc.GetJob(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.UserJobInfo{
+		Id:        job.ID,
+		Type:      int32(job.Type),
+		Status:    int32(job.Status),
+		DryRun:    job.DryRun,
+		Total:     int32(job.Total),
+		Succeeded: int32(job.Succeeded),
+		Failed:    int32(job.Failed),
+		Message:   job.Message,
+		ResultUrl: job.ResultURL,
+		CreatedAt: job.CreatedAt.Unix(),
+	}
+	if !job.FinishedAt.IsZero() {
+		info.FinishedAt = job.FinishedAt.Unix()
+	}
+	return info, nil
+}